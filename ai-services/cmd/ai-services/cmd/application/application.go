@@ -25,11 +25,24 @@ func init() {
 	ApplicationCmd.AddCommand(image.ImageCmd)
 	ApplicationCmd.AddCommand(stopCmd)
 	ApplicationCmd.AddCommand(startCmd)
+	ApplicationCmd.AddCommand(restartCmd)
 	ApplicationCmd.AddCommand(infoCmd)
+	ApplicationCmd.AddCommand(describeCmd)
+	ApplicationCmd.AddCommand(eventsCmd)
+	ApplicationCmd.AddCommand(topCmd)
+	ApplicationCmd.AddCommand(duCmd)
 	ApplicationCmd.AddCommand(logsCmd)
+	ApplicationCmd.AddCommand(waitCmd)
 	ApplicationCmd.AddCommand(model.ModelCmd)
+	ApplicationCmd.AddCommand(prefetchCmd)
 	ApplicationCmd.PersistentFlags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool image to use for downloading the model(only for the development purpose)")
 	ApplicationCmd.PersistentFlags().BoolVar(&hiddenTemplates, "hidden", false, "Show hidden templates")
+	ApplicationCmd.PersistentFlags().StringVar(&vars.Namespace, "namespace", vars.Namespace,
+		"Namespace to isolate the applications deployed by different users on the same host(supported for podman runtime only).\n"+
+			"The pods and the host directory of an application are named '<namespace>--<app>' within a namespace")
+	ApplicationCmd.PersistentFlags().StringVar(&vars.AdvertiseIP, "advertise-ip", vars.AdvertiseIP,
+		"Host IP to use in the URLs of the applications instead of the detected one, eg:- on hosts with several interfaces\n"+
+			"(supported for podman runtime only, can also be set via AI_SERVICES_ADVERTISE_IP env)")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("tool-image")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("hidden")
 }
@@ -2,12 +2,20 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	appBootstrap "github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
@@ -15,19 +23,31 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	"github.com/project-ai-services/ai-services/internal/pkg/update"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+const (
+	defaultMaxStartupRestarts = 3
+	defaultDeployConcurrency  = 4
+)
+
 // Variables for flags placeholder.
 var (
 	// common flags.
 	templateName string
 	rawArgParams []string
 	argParams    map[string]string
+	expandEnv    bool
+	noValidate   bool
+	revalidate   bool
 
 	// podman flags.
 	skipModelDownload     bool
@@ -36,6 +56,32 @@ var (
 	valuesFiles           []string
 	rawArgImagePullPolicy string
 	imagePullPolicy       image.ImagePullPolicy
+	rawArgStartPeriods    []string
+	startPeriods          map[string]time.Duration
+	maxStartupRestarts    int
+	fromFile              string
+	createOutput          string
+	rawArgLabels          []string
+	labels                map[string]string
+	allowReducedSpyre     bool
+	deployConcurrency     int
+	fromLayer             int
+	onlyLayer             int
+	pauseBetweenLayers    bool
+	layerDelay            time.Duration
+	annotationsFromFile   string
+	annotationOverrides   map[string]map[string]string
+	envFilePath           string
+	envFileContainers     []string
+	envFileVars           map[string]string
+	modelDownloadTimeout  time.Duration
+	modelDownloadTotal    time.Duration
+	modelDir              string
+	quietModels           bool
+	outputDir             string
+	dryRun                bool
+	force                 bool
+	strict                bool
 
 	// openshift flags.
 	timeout time.Duration
@@ -62,12 +108,12 @@ var createCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		appName := args[0]
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		if err := doBootstrapValidate(); err != nil {
+		if err := validateEnvironment(ctx); err != nil {
 			return err
 		}
 
@@ -78,22 +124,69 @@ var createCmd = &cobra.Command{
 			return fmt.Errorf("failed to create application instance: %w", err)
 		}
 
+		notifyUpdate := update.CheckInBackground(version.GetVersion())
+
 		opts := appTypes.CreateOptions{
-			Name:              appName,
-			TemplateName:      templateName,
-			SkipModelDownload: skipModelDownload,
-			SkipImageDownload: skipImageDownload,
-			ArgParams:         argParams,
-			ValuesFiles:       valuesFiles,
-			ImagePullPolicy:   imagePullPolicy,
-			Timeout:           timeout,
+			Name:               appName,
+			TemplateName:       templateName,
+			SkipModelDownload:  skipModelDownload,
+			SkipImageDownload:  skipImageDownload,
+			ArgParams:          argParams,
+			ValuesFiles:        valuesFiles,
+			ImagePullPolicy:    imagePullPolicy,
+			StartPeriods:       startPeriods,
+			FromFile:           fromFile,
+			MaxStartupRestarts: maxStartupRestarts,
+			OutputFormat:       createOutput,
+			Labels:             labels,
+			AllowReducedSpyre:  allowReducedSpyre,
+			DeployConcurrency:  deployConcurrency,
+			FromLayer:          fromLayer,
+			OnlyLayer:          onlyLayer,
+			PauseBetweenLayers: pauseBetweenLayers,
+			LayerDelay:         layerDelay,
+			Timeout:            timeout,
+
+			ModelDownloadTimeout:      modelDownloadTimeout,
+			ModelDownloadTotalTimeout: modelDownloadTotal,
+			ModelDir:                  modelDir,
+			QuietModels:               quietModels,
+
+			AnnotationOverrides: annotationOverrides,
+
+			EnvFile:           envFileVars,
+			EnvFileContainers: envFileContainers,
+
+			OutputDir: outputDir,
+			DryRun:    dryRun,
+			Force:     force,
+
+			Strict: strict,
+		}
+
+		if err := app.Create(ctx, opts); err != nil {
+			return err
 		}
 
-		return app.Create(ctx, opts)
+		notifyUpdate()
+
+		return nil
 	},
 }
 
-func doBootstrapValidate() error {
+// validateEnvironment runs the bootstrap validation, or only makes sure the runtime is reachable with --no-validate.
+func validateEnvironment(ctx context.Context) error {
+	if noValidate {
+		return verifyRuntimeReady()
+	}
+
+	return bootstrapValidate(ctx, strict)
+}
+
+// bootstrapValidate runs the bootstrap validation, a variable so that the tests can assert whether it is skipped.
+var bootstrapValidate = doBootstrapValidate
+
+func doBootstrapValidate(ctx context.Context, strict bool) error {
 	skip := helpers.ParseSkipChecks(skipChecks)
 	if len(skip) > 0 {
 		logger.Warningf("Skipping validation checks (skipped: %v)\n", skipChecks)
@@ -102,13 +195,32 @@ func doBootstrapValidate() error {
 	// Create bootstrap instance based on runtime
 	factory := bootstrap.NewBootstrapFactory(vars.RuntimeFactory.GetRuntimeType())
 
-	if err := factory.Validate(skip); err != nil {
+	if err := factory.ValidateCached(ctx, skip, strict, bootstrap.DefaultRuleTimeout, version.GetVersion(), revalidate); err != nil {
 		return fmt.Errorf("bootstrap validation failed: %w", err)
 	}
 
 	return nil
 }
 
+// podmanHealthCheck checks that podman is reachable, a variable so that the tests can run without podman.
+var podmanHealthCheck = validators.PodmanHealthCheck
+
+// verifyRuntimeReady makes sure the runtime is reachable when the validation is skipped via --no-validate,
+// so that an environment which is not ready fails upfront rather than midway through the create.
+func verifyRuntimeReady() error {
+	logger.Warningln("Skipping the bootstrap validation, the environment is assumed to be ready")
+
+	if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+		return nil
+	}
+
+	if err := podmanHealthCheck(); err != nil {
+		return fmt.Errorf("podman is not ready, run 'ai-services bootstrap' or create without --%s: %w", appFlags.Create.NoValidate, err)
+	}
+
+	return nil
+}
+
 func init() {
 	initCommonFlags()
 	initPodmanFlags()
@@ -118,9 +230,17 @@ func init() {
 func initCommonFlags() {
 	skipCheckDesc := appBootstrap.BuildSkipFlagDescription()
 	createCmd.Flags().StringSliceVar(&skipChecks, appFlags.Create.SkipValidation, []string{}, skipCheckDesc)
-
-	createCmd.Flags().StringVarP(&templateName, appFlags.Create.Template, "t", "", "Application template to use (required)")
-	_ = createCmd.MarkFlagRequired(appFlags.Create.Template)
+	createCmd.Flags().BoolVar(&noValidate, appFlags.Create.NoValidate, false,
+		"Skip the bootstrap validation entirely, assuming the environment is ready, eg:- for the iterative creates on an already bootstrapped host\n\n"+
+			"For podman runtime, the create still fails upfront if podman is not reachable.\n")
+	createCmd.Flags().BoolVar(&revalidate, appFlags.Create.Revalidate, false,
+		"Run the bootstrap validation even if the host passed it recently\n\n"+
+			"A successful validation is reused for 24h by the following creates, as long as the host and the CLI version\n"+
+			"are unchanged. Note: The validation is only reused for podman runtime.\n")
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.NoValidate, appFlags.Create.SkipValidation)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.NoValidate, appFlags.Create.Revalidate)
+
+	createCmd.Flags().StringVarP(&templateName, appFlags.Create.Template, "t", "", "Application template to use, matched case-insensitively (required unless --from-file is given)")
 
 	createCmd.Flags().StringSliceVar(
 		&rawArgParams,
@@ -135,15 +255,34 @@ func initCommonFlags() {
 			"- When both --values and --params are provided, --params overrides --values\n",
 	)
 
+	createCmd.Flags().BoolVar(
+		&expandEnv,
+		appFlags.Create.ExpandEnv,
+		false,
+		"Expand ${VAR} references in the --params values using the environment (e.g., --params ui.port='${UI_PORT}').\n"+
+			"Use '$$' for a literal '$'. Undefined variables are reported as an error.\n",
+	)
+
 	createCmd.Flags().StringArrayVarP(
 		&valuesFiles,
 		appFlags.Create.Values,
 		"f",
 		[]string{},
-		"Specify values files to override default template values.\n\n"+
+		"Specify values files (YAML or JSON) to override default template values.\n\n"+
 			"Usage:\n"+
-			"- Can be provided multiple times; files are applied in order and later files override earlier ones\n",
+			"- Can be provided multiple times; files are applied in order and later files override earlier ones\n"+
+			"- Nested values are merged, keys may also be dotted paths (e.g., ui.port: 3000)\n"+
+			"- Keys not declared by the template values are reported with a warning\n"+
+			"- Also available as --params-file\n",
 	)
+	createCmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		// --params-file is an alias of --values
+		if name == appFlags.Create.ParamsFile {
+			name = appFlags.Create.Values
+		}
+
+		return pflag.NormalizedName(name)
+	})
 }
 
 func initPodmanFlags() {
@@ -164,16 +303,247 @@ func initPodmanFlags() {
 		appFlags.Create.SkipModelDownload,
 		false,
 		"Skip model download during application creation\n\n"+
-			"Use this if local models already exist at /var/lib/ai-services/models/\n"+
+			"Use this if local models already exist at /var/lib/ai-services/models/ or at --model-dir\n"+
 			"Recommended for air-gapped networks\n\n"+
 			"Warning:\n"+
 			"- If set to true and models are missing → command will fail\n"+
 			"- If left false in air-gapped environments → download attempt will fail\n"+
 			"Note: Supported for podman runtime only.\n",
 	)
+	createCmd.Flags().DurationVar(
+		&modelDownloadTimeout,
+		appFlags.Create.ModelDownloadTimeout,
+		0,
+		"Maximum time to download a single model, including its retries (e.g., 30m). 0 means no limit\n"+
+			"A retry resumes the partially downloaded model instead of starting over.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+	createCmd.Flags().DurationVar(
+		&modelDownloadTotal,
+		appFlags.Create.ModelDownloadTotalTimeout,
+		0,
+		"Maximum time to download all the models of the application (e.g., 2h). 0 means no limit\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+	createCmd.Flags().StringVar(
+		&modelDir,
+		appFlags.Create.ModelDir,
+		vars.ModelDirectory,
+		"Directory the models are downloaded to and mounted into the pods from\n\n"+
+			"Use this to point at a pre-populated directory, e.g. a mounted share in air-gapped networks,\n"+
+			"together with --skip-model-download. The directory must exist and be readable.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+	createCmd.Flags().BoolVar(
+		&quietModels,
+		appFlags.Create.QuietModels,
+		false,
+		"Collapse the model download into a single progress line instead of the output of each model download\n\n"+
+			"The output of a failed download is still shown in its error.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
 
 	initializeImagePullPolicyFlag()
 
+	createCmd.Flags().StringArrayVar(
+		&rawArgStartPeriods,
+		appFlags.Create.StartPeriod,
+		[]string{},
+		"Override the healthcheck start period of a pod, which drives its readiness timeout.\n\n"+
+			"Format:\n"+
+			"- <pod-name>=<duration>\n"+
+			"- Can be provided multiple times: --start-period app--vllm-server=30m --start-period app--milvus=5m\n\n"+
+			"Pods which are not specified keep the start period defined in the template.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().IntVar(
+		&maxStartupRestarts,
+		appFlags.Create.MaxStartupRestarts,
+		defaultMaxStartupRestarts,
+		"Fail the readiness check early once a container restarted more than the given times during startup,\n"+
+			"printing its last log lines instead of waiting for the full readiness timeout.\n"+
+			"Use a negative value to disable the crash-loop detection.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().IntVar(
+		&deployConcurrency,
+		appFlags.Create.DeployConcurrency,
+		defaultDeployConcurrency,
+		"Maximum number of pods of a layer deployed concurrently.\n"+
+			"The layers are still deployed one after the other, in the order declared by the template.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().IntVar(
+		&fromLayer,
+		appFlags.Create.FromLayer,
+		0,
+		"Resume the deploy at the given layer (1 based) of the application template.\n"+
+			"The pods of the earlier layers must already be deployed and running.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().IntVar(
+		&onlyLayer,
+		appFlags.Create.OnlyLayer,
+		0,
+		"(Re)deploy just the given layer (1 based) of the application template.\n"+
+			"Existing pods of the layer are removed and deployed again from the template,\n"+
+			"the pods of the earlier layers must already be deployed and running.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().BoolVar(
+		&pauseBetweenLayers,
+		appFlags.Create.PauseBetweenLayers,
+		false,
+		"Pause after each layer passed readiness, printing the state of the pods and waiting for Enter\n"+
+			"before deploying the next layer, e.g. to inspect the intermediate state while authoring a template.\n"+
+			"It is a no-op when stdin is not a terminal, unless --layer-delay is set.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().DurationVar(
+		&layerDelay,
+		appFlags.Create.LayerDelay,
+		0,
+		"Pause for the given duration (e.g. 30s) after each layer passed readiness, instead of waiting for Enter.\n"+
+			"Unlike --pause-between-layers alone, it also pauses in non-interactive mode.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().StringVar(
+		&annotationsFromFile,
+		appFlags.Create.AnnotationsFromFile,
+		"",
+		"YAML file overriding the annotations of the pods rendered from the template, e.g. to experiment with\n"+
+			"the Spyre cards, ports and resources without editing the template.\n\n"+
+			"Format:\n"+
+			"  <pod>:\n"+
+			"    ai-services.io/ports: \"3001:3000\"\n"+
+			"  <pod>.<container>:\n"+
+			"    spyre-cards: 2\n"+
+			"    memory: 8Gi\n"+
+			"    cpu: 4\n\n"+
+			"Pods are named without the '<app>--' prefix, and only the Spyre, ports and resources annotations can be overridden.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().StringVar(
+		&envFilePath,
+		appFlags.Create.EnvFile,
+		"",
+		"File of KEY=VALUE lines set as environment variables on all the containers, e.g. for feature flags\n"+
+			"and endpoints differing per environment. Blank lines and lines starting with '#' are ignored,\n"+
+			"and values are taken as is, without processing quotes or '$' references.\n\n"+
+			"Precedence, from lowest to highest:\n"+
+			"  - the env of the template, including the values rendered from --params and --values\n"+
+			"  - the env file\n"+
+			"  - the env computed from the pod annotations, e.g. the Spyre PCI addresses, which cannot be overridden\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().StringSliceVar(
+		&envFileContainers,
+		appFlags.Create.EnvFileContainer,
+		nil,
+		"Restrict the --env-file variables to the given containers (can be repeated or comma-separated),\n"+
+			"instead of setting them on all the containers.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().StringArrayVar(
+		&rawArgLabels,
+		appFlags.Create.Label,
+		[]string{},
+		"Attach a custom label to the pods of the application, e.g. for team or ticket bookkeeping.\n\n"+
+			"Format:\n"+
+			"- <key>=<value>\n"+
+			"- Can be provided multiple times: --label team=search --label env=dev\n\n"+
+			"The labels are shown by 'info' and 'ps -o json'. Keys with the ai-services.io/ prefix are reserved.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().BoolVar(
+		&allowReducedSpyre,
+		appFlags.Create.AllowReducedSpyre,
+		false,
+		"Deploy with fewer Spyre cards than declared by the template, if not enough cards are free.\n\n"+
+			"Every container needing Spyre cards gets at least one card, and the reduced card count\n"+
+			"is passed to the container through the AIU_WORLD_SIZE env, so that it can adapt.\n"+
+			"Intended for dev/test on card-constrained LPARs, the reduced allocation is reported in the summary.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().StringVarP(
+		&createOutput,
+		appFlags.Create.Output,
+		"o",
+		"",
+		"Output format of the summary printed at the end of create (e.g., json).\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	createCmd.Flags().StringVar(
+		&fromFile,
+		appFlags.Create.FromFile,
+		"",
+		"Deploy a pod manifest (YAML) directly instead of an application template.\n\n"+
+			"The manifest skips templating but still goes through the Spyre card allocation,\n"+
+			"env annotation processing, labeling and readiness checks of the templated pods.\n"+
+			"Missing ai-services labels are injected with the given application name.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	// either a template or a manifest file is needed to deploy from
+	createCmd.MarkFlagsOneRequired(appFlags.Create.Template, appFlags.Create.FromFile)
+	helpers.RegisterTemplateCompletion(appFlags.Create.Template, createCmd)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.Template, appFlags.Create.FromFile)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.FromLayer, appFlags.Create.OnlyLayer)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.FromFile, appFlags.Create.FromLayer)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.FromFile, appFlags.Create.OnlyLayer)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.FromFile, appFlags.Create.AnnotationsFromFile)
+
+	createCmd.Flags().StringVar(
+		&outputDir,
+		appFlags.Create.OutputDir,
+		"",
+		"Directory to write the rendered manifest of each pod to, as '<pod>.yaml', before it is deployed\n\n"+
+			"The manifests are rendered with the resolved params and env, e.g. for audit or to reproduce a bug.\n"+
+			"Secrets such as passwords and tokens are redacted.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+	createCmd.Flags().BoolVar(
+		&dryRun,
+		appFlags.Create.DryRun,
+		false,
+		"Render the manifests to --output-dir without deploying them\n\n"+
+			"The validations still run, while the SMT level, the images and the models are left untouched.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+	createCmd.Flags().BoolVar(
+		&force,
+		appFlags.Create.Force,
+		false,
+		"Change the SMT level required by the template even if other running applications require a different one\n\n"+
+			"The SMT level is a host-global setting, hence the change may disrupt these applications.\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+	createCmd.Flags().BoolVar(
+		&strict,
+		appFlags.Create.Strict,
+		false,
+		"Treat the warnings as errors, eg:- to enforce the best practices in production\n\n"+
+			"Fails the create on the warnings of the validation checks (e.g. numa misaligned), on a reduced Spyre allocation\n"+
+			"and on an SMT change conflicting with the running applications, even with --force.\n",
+	)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.FromFile, appFlags.Create.OutputDir)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.FromFile, appFlags.Create.DryRun)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.DryRun, appFlags.Create.PauseBetweenLayers)
+	createCmd.MarkFlagsMutuallyExclusive(appFlags.Create.DryRun, appFlags.Create.LayerDelay)
+
 	// deprecated flags
 	deprecatedPodmanFlags()
 }
@@ -219,15 +589,39 @@ func buildFlagValidator() *flagvalidator.FlagValidator {
 	// Register common flags with their validation functions
 	builder.
 		AddCommonFlag(appFlags.Create.SkipValidation, nil).
+		AddCommonFlag(appFlags.Create.NoValidate, nil).
+		AddCommonFlag(appFlags.Create.Revalidate, nil).
 		AddCommonFlag(appFlags.Create.Template, validateTemplateFlag).
 		AddCommonFlag(appFlags.Create.Params, validateParamsFlag).
+		AddCommonFlag(appFlags.Create.ExpandEnv, nil).
 		AddCommonFlag(appFlags.Create.Values, validateValuesFlag)
 
 	// Register Podman-specific flags
 	builder.
 		AddPodmanFlag(appFlags.Create.SkipImageDownload, nil).
 		AddPodmanFlag(appFlags.Create.SkipModelDownload, nil).
-		AddPodmanFlag(appFlags.Create.ImagePullPolicy, validateImagePullPolicyFlag)
+		AddPodmanFlag(appFlags.Create.ImagePullPolicy, validateImagePullPolicyFlag).
+		AddPodmanFlag(appFlags.Create.StartPeriod, validateStartPeriodFlag).
+		AddPodmanFlag(appFlags.Create.FromFile, validateFromFileFlag).
+		AddPodmanFlag(appFlags.Create.MaxStartupRestarts, nil).
+		AddPodmanFlag(appFlags.Create.Output, validateOutputFlag).
+		AddPodmanFlag(appFlags.Create.Label, validateLabelFlag).
+		AddPodmanFlag(appFlags.Create.AllowReducedSpyre, nil).
+		AddPodmanFlag(appFlags.Create.DeployConcurrency, validateDeployConcurrencyFlag).
+		AddPodmanFlag(appFlags.Create.FromLayer, validateLayerFlag(appFlags.Create.FromLayer, &fromLayer)).
+		AddPodmanFlag(appFlags.Create.OnlyLayer, validateLayerFlag(appFlags.Create.OnlyLayer, &onlyLayer)).
+		AddPodmanFlag(appFlags.Create.PauseBetweenLayers, nil).
+		AddPodmanFlag(appFlags.Create.LayerDelay, validateLayerDelayFlag).
+		AddPodmanFlag(appFlags.Create.AnnotationsFromFile, validateAnnotationsFromFileFlag).
+		AddPodmanFlag(appFlags.Create.EnvFile, validateEnvFileFlag).
+		AddPodmanFlag(appFlags.Create.EnvFileContainer, validateEnvFileFlag).
+		AddPodmanFlag(appFlags.Create.ModelDownloadTimeout, validateModelDownloadTimeoutFlags).
+		AddPodmanFlag(appFlags.Create.ModelDownloadTotalTimeout, validateModelDownloadTimeoutFlags).
+		AddPodmanFlag(appFlags.Create.ModelDir, validateModelDirFlag).
+		AddPodmanFlag(appFlags.Create.QuietModels, nil).
+		AddPodmanFlag(appFlags.Create.OutputDir, validateOutputDirFlag).
+		AddPodmanFlag(appFlags.Create.DryRun, validateDryRunFlag).
+		AddPodmanFlag(appFlags.Create.Force, nil)
 
 	// Register OpenShift-specific flags
 	builder.
@@ -239,11 +633,11 @@ func buildFlagValidator() *flagvalidator.FlagValidator {
 // validateTemplateFlag validates the template flag.
 func validateTemplateFlag(cmd *cobra.Command) error {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
-	if err := validators.ValidateAppTemplateExist(tp, templateName); err != nil {
-		return err
-	}
 
-	return nil
+	var err error
+	templateName, err = templates.ResolveTemplate(tp, templateName)
+
+	return err
 }
 
 // validateParamsFlag validates the params flag.
@@ -258,6 +652,12 @@ func validateParamsFlag(cmd *cobra.Command) error {
 		return fmt.Errorf("invalid format: %w", err)
 	}
 
+	if expandEnv {
+		if err := utils.ExpandEnv(argParams); err != nil {
+			return fmt.Errorf("failed to expand params: %w", err)
+		}
+	}
+
 	// Validate params against template values
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 	_, err = tp.LoadValues(templateName, valuesFiles, argParams)
@@ -276,6 +676,64 @@ func validateValuesFlag(cmd *cobra.Command) error {
 		}
 	}
 
+	if templateName == "" {
+		return nil
+	}
+
+	// Validate the values files against template values, the undeclared keys are tolerated as they always were
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	undeclared, err := templates.UndeclaredValues(tp, templateName, valuesFiles)
+	if err != nil {
+		return fmt.Errorf("failed to load values: %w", err)
+	}
+	for _, vf := range valuesFiles {
+		if keys := undeclared[vf]; len(keys) > 0 {
+			logger.Warningf("values file '%s' sets parameters not declared by template '%s', they are ignored: %s\n",
+				vf, templateName, strings.Join(keys, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateLabelFlag validates the label flag.
+func validateLabelFlag(cmd *cobra.Command) error {
+	var err error
+	labels, err = utils.ParseKeyValues(rawArgLabels)
+	if err != nil {
+		return fmt.Errorf("invalid format: %w", err)
+	}
+
+	for key, val := range labels {
+		if strings.HasPrefix(key, constants.LabelKeyPrefix) {
+			return fmt.Errorf("invalid label key '%s': the '%s' prefix is reserved", key, constants.LabelKeyPrefix)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid label key '%s': %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(val); len(errs) > 0 {
+			return fmt.Errorf("invalid label value '%s' for key '%s': %s", val, key, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
+
+// validateOutputFlag validates the output flag.
+func validateOutputFlag(cmd *cobra.Command) error {
+	if strings.ToLower(createOutput) != "json" {
+		return fmt.Errorf("unsupported output format: %s, supported formats are: json", createOutput)
+	}
+
+	return nil
+}
+
+// validateFromFileFlag validates the from-file flag.
+func validateFromFileFlag(cmd *cobra.Command) error {
+	if !utils.FileExists(fromFile) {
+		return fmt.Errorf("file '%s' does not exist", fromFile)
+	}
+
 	return nil
 }
 
@@ -292,4 +750,160 @@ func validateImagePullPolicyFlag(cmd *cobra.Command) error {
 	return nil
 }
 
+// validateDeployConcurrencyFlag validates the deploy-concurrency flag.
+func validateDeployConcurrencyFlag(cmd *cobra.Command) error {
+	if deployConcurrency < 1 {
+		return fmt.Errorf("invalid value for --%s: %d, must be greater than 0", appFlags.Create.DeployConcurrency, deployConcurrency)
+	}
+
+	return nil
+}
+
+// validateLayerFlag returns a validator for the from-layer and only-layer flags.
+func validateLayerFlag(flagName string, layer *int) func(cmd *cobra.Command) error {
+	return func(cmd *cobra.Command) error {
+		if *layer < 1 {
+			return fmt.Errorf("invalid value for --%s: %d, must be greater than 0", flagName, *layer)
+		}
+
+		return nil
+	}
+}
+
+// validateModelDownloadTimeoutFlags validates the model-download-timeout and model-download-total-timeout flags.
+func validateModelDownloadTimeoutFlags(cmd *cobra.Command) error {
+	if modelDownloadTimeout < 0 || modelDownloadTotal < 0 {
+		return fmt.Errorf("--%s and --%s must not be negative", appFlags.Create.ModelDownloadTimeout, appFlags.Create.ModelDownloadTotalTimeout)
+	}
+
+	return nil
+}
+
+// validateModelDirFlag validates the model-dir flag, the directory must exist and be readable.
+func validateModelDirFlag(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed(appFlags.Create.ModelDir) {
+		return nil
+	}
+
+	// the directory is mounted as a hostPath, hence it must be absolute
+	absDir, err := filepath.Abs(modelDir)
+	if err != nil {
+		return fmt.Errorf("invalid value for --%s: %w", appFlags.Create.ModelDir, err)
+	}
+
+	info, err := os.Stat(absDir)
+	if err != nil {
+		return fmt.Errorf("invalid value for --%s: %w", appFlags.Create.ModelDir, err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("invalid value for --%s: '%s' is not a directory", appFlags.Create.ModelDir, absDir)
+	}
+
+	dir, err := os.Open(absDir)
+	if err != nil {
+		return fmt.Errorf("invalid value for --%s: directory '%s' is not readable: %w", appFlags.Create.ModelDir, absDir, err)
+	}
+	defer dir.Close()
+
+	if _, err := dir.Readdirnames(1); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid value for --%s: directory '%s' is not readable: %w", appFlags.Create.ModelDir, absDir, err)
+	}
+
+	modelDir = absDir
+
+	return nil
+}
+
+// validateOutputDirFlag validates the output-dir flag, the directory is created on demand if it does not exist.
+func validateOutputDirFlag(cmd *cobra.Command) error {
+	if outputDir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(outputDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value for --%s: %w", appFlags.Create.OutputDir, err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("invalid value for --%s: '%s' is not a directory", appFlags.Create.OutputDir, outputDir)
+	}
+
+	return nil
+}
+
+// validateDryRunFlag validates the dry-run flag, the rendered manifests are the only outcome of a dry run.
+func validateDryRunFlag(cmd *cobra.Command) error {
+	if dryRun && outputDir == "" {
+		return fmt.Errorf("--%s requires --%s", appFlags.Create.DryRun, appFlags.Create.OutputDir)
+	}
+
+	return nil
+}
+
+// validateAnnotationsFromFileFlag loads and validates the annotations-from-file flag.
+func validateAnnotationsFromFileFlag(cmd *cobra.Command) error {
+	if annotationsFromFile == "" {
+		return nil
+	}
+
+	var err error
+	annotationOverrides, err = specs.LoadAnnotationOverrides(annotationsFromFile)
+
+	return err
+}
+
+// validateEnvFileFlag loads and validates the env-file flag, along with the containers it is restricted to.
+func validateEnvFileFlag(cmd *cobra.Command) error {
+	if envFilePath == "" {
+		if len(envFileContainers) > 0 {
+			return fmt.Errorf("--%s requires --%s", appFlags.Create.EnvFileContainer, appFlags.Create.EnvFile)
+		}
+
+		return nil
+	}
+
+	var err error
+	envFileVars, err = utils.LoadEnvFile(envFilePath)
+
+	return err
+}
+
+// validateLayerDelayFlag validates the layer-delay flag.
+func validateLayerDelayFlag(cmd *cobra.Command) error {
+	if layerDelay < 0 {
+		return fmt.Errorf("invalid value for --%s: %s, must not be negative", appFlags.Create.LayerDelay, layerDelay)
+	}
+
+	return nil
+}
+
+// validateStartPeriodFlag validates the start-period flag.
+func validateStartPeriodFlag(cmd *cobra.Command) error {
+	pairs, err := utils.ParseKeyValues(rawArgStartPeriods)
+	if err != nil {
+		return fmt.Errorf("invalid format: %w", err)
+	}
+
+	startPeriods = make(map[string]time.Duration, len(pairs))
+	for podName, rawDuration := range pairs {
+		duration, err := time.ParseDuration(rawDuration)
+		if err != nil {
+			return fmt.Errorf("invalid duration for pod '%s': %w", podName, err)
+		}
+
+		if duration <= 0 {
+			return fmt.Errorf("invalid duration for pod '%s': must be greater than 0", podName)
+		}
+
+		startPeriods[podName] = duration
+	}
+
+	return nil
+}
+
 // Made with Bob
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestValidateEnvironment asserts that --no-validate skips the bootstrap validation, only checking that podman is
+// reachable, and that the validation still runs by default.
+func TestValidateEnvironment(t *testing.T) {
+	errUnreachable := errors.New("podman socket not found")
+
+	tests := []struct {
+		name          string
+		runtime       types.RuntimeType
+		noValidate    bool
+		healthErr     error
+		wantValidated bool
+		wantChecked   bool
+		wantErr       error
+	}{
+		{name: "default", runtime: types.RuntimeTypePodman, wantValidated: true},
+		{name: "no validate", runtime: types.RuntimeTypePodman, noValidate: true, wantChecked: true},
+		{name: "no validate podman unreachable", runtime: types.RuntimeTypePodman, noValidate: true, healthErr: errUnreachable, wantChecked: true, wantErr: errUnreachable},
+		{name: "no validate openshift", runtime: types.RuntimeTypeOpenShift, noValidate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, validate, healthCheck, skip := vars.RuntimeFactory, bootstrapValidate, podmanHealthCheck, noValidate
+			t.Cleanup(func() {
+				vars.RuntimeFactory, bootstrapValidate, podmanHealthCheck, noValidate = factory, validate, healthCheck, skip
+			})
+
+			validated, checked := false, false
+			vars.RuntimeFactory = runtime.NewRuntimeFactory(tt.runtime)
+			noValidate = tt.noValidate
+			bootstrapValidate = func(context.Context, bool) error {
+				validated = true
+
+				return nil
+			}
+			podmanHealthCheck = func() error {
+				checked = true
+
+				return tt.healthErr
+			}
+
+			err := validateEnvironment(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validateEnvironment() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "--"+appFlags.Create.NoValidate) {
+				t.Errorf("validateEnvironment() error = %v, want it to point at --%s", err, appFlags.Create.NoValidate)
+			}
+			if validated != tt.wantValidated {
+				t.Errorf("bootstrap validation run = %v, want %v", validated, tt.wantValidated)
+			}
+			if checked != tt.wantChecked {
+				t.Errorf("podman health check run = %v, want %v", checked, tt.wantChecked)
+			}
+		})
+	}
+}
+
+// TestNoValidateExclusive asserts that --no-validate cannot be combined with --skip-validation.
+func TestNoValidateExclusive(t *testing.T) {
+	flags := createCmd.Flags()
+	t.Cleanup(func() {
+		noValidate, skipChecks = false, []string{}
+		for _, name := range []string{appFlags.Create.NoValidate, appFlags.Create.SkipValidation} {
+			flags.Lookup(name).Changed = false
+		}
+	})
+
+	if err := flags.Parse([]string{"--" + appFlags.Create.NoValidate, "--" + appFlags.Create.SkipValidation, "numa"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if err := createCmd.ValidateFlagGroups(); err == nil {
+		t.Errorf("ValidateFlagGroups() error = nil, want --%s and --%s to be mutually exclusive", appFlags.Create.NoValidate, appFlags.Create.SkipValidation)
+	}
+}
+
+// TestModelDirFlag asserts that --model-dir is resolved to an absolute directory, which must exist and be readable,
+// and that the default model directory is kept when it is not given.
+func TestModelDirFlag(t *testing.T) {
+	base := t.TempDir()
+	t.Chdir(base)
+	if err := os.Mkdir(filepath.Join(base, "models"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(base, "models.tar"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{name: "default", want: vars.ModelDirectory},
+		{name: "absolute", args: []string{"--model-dir", filepath.Join(base, "models")}, want: filepath.Join(base, "models")},
+		{name: "relative", args: []string{"--model-dir", "models"}, want: filepath.Join(base, "models")},
+		{name: "missing", args: []string{"--model-dir", "missing"}, wantErr: "no such file or directory"},
+		{name: "not a directory", args: []string{"--model-dir", "models.tar"}, wantErr: "is not a directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := createCmd.Flags()
+			t.Cleanup(func() {
+				modelDir = vars.ModelDirectory
+				flags.Lookup(appFlags.Create.ModelDir).Changed = false
+			})
+
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			err := validateModelDirFlag(createCmd)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), "--"+appFlags.Create.ModelDir) {
+					t.Errorf("validateModelDirFlag() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateModelDirFlag() error = %v", err)
+			}
+			if modelDir != tt.want {
+				t.Errorf("model directory = %q, want %q", modelDir, tt.want)
+			}
+		})
+	}
+}
@@ -56,7 +56,7 @@ Arguments
 }
 
 func init() {
-	deleteCmd.Flags().BoolVar(&skipCleanup, "skip-cleanup", false, "Skip deleting application data (default=false)")
+	deleteCmd.Flags().BoolVar(&skipCleanup, "skip-cleanup", false, "Skip deleting application data, including the host paths declared by the templates (default=false)")
 	deleteCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
 	deleteCmd.Flags().DurationVar(
 		&timeout,
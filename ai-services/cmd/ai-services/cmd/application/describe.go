@@ -0,0 +1,93 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	defaultDescribeEvents = 10
+)
+
+var (
+	describeOutput string
+	describeEvents int
+)
+
+var describeCmd = &cobra.Command{
+	Use:   "describe [name]",
+	Short: "Shows a diagnostic report of the application",
+	Long: `Shows a single diagnostic report of the application combining its info (template, version and URLs),
+its pods (status, ports and restarts) and the most recent events of its pods.
+
+Arguments
+  [name]: Application name (required)
+`,
+	Example: `  # Describe an application
+  ai-services application describe my-app
+
+  # Describe an application with the last 50 events as JSON
+  ai-services application describe my-app --events 50 -o json`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if describeOutput != "" && strings.ToLower(describeOutput) != "json" {
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", describeOutput)
+		}
+
+		if describeEvents < 0 {
+			return fmt.Errorf("--events must not be negative")
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		desc, err := app.Describe(appTypes.DescribeOptions{
+			Name:   applicationName,
+			Events: describeEvents,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to describe application: %w", err)
+		}
+
+		if strings.ToLower(describeOutput) == "json" {
+			data, err := json.MarshalIndent(desc, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal application description: %w", err)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+			return err
+		}
+
+		common.PrintDescription(desc)
+
+		return nil
+	},
+}
+
+func init() {
+	describeCmd.Flags().StringVarP(&describeOutput, "output", "o", "", "Output format (e.g., json)")
+	describeCmd.Flags().IntVar(&describeEvents, "events", defaultDescribeEvents, "Number of most recent events to show")
+}
@@ -0,0 +1,108 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var duOutput string
+
+var duCmd = &cobra.Command{
+	Use:   "du [name]",
+	Short: "Shows the disk usage of an application",
+	Long: `Shows the disk space consumed by an application, broken down into:
+  - the container images of its template present on the host
+  - its host directories under ` + "/var/lib/ai-services/applications/<name>" + `
+  - the model files it references, which are shared by all the applications
+
+Images used by other applications and the models are marked as shared. The exclusive size
+is the space reclaimed by deleting the application along with its images.
+
+Arguments
+  [name]: Application name (required)
+
+Note: Supported for podman runtime only.`,
+	Example: `  # Show the disk usage of an application
+  ai-services application du my-app
+
+  # Show the disk usage as JSON
+  ai-services application du my-app -o json`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if duOutput != "" && strings.ToLower(duOutput) != "json" {
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", duOutput)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		if rt := vars.RuntimeFactory.GetRuntimeType(); rt != types.RuntimeTypePodman {
+			return fmt.Errorf("du is only supported for %s runtime (current runtime: %s)", types.RuntimeTypePodman, rt)
+		}
+
+		runtimeClient, err := vars.RuntimeFactory.Create(vars.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to create runtime client: %w", err)
+		}
+
+		usage, err := common.DiskUsage(runtimeClient, args[0])
+		if err != nil {
+			return fmt.Errorf("failed to compute the disk usage: %w", err)
+		}
+
+		if strings.ToLower(duOutput) == "json" {
+			data, err := json.MarshalIndent(usage, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal the disk usage: %w", err)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+			return err
+		}
+
+		printDiskUsage(usage)
+
+		return nil
+	},
+}
+
+func init() {
+	duCmd.Flags().StringVarP(&duOutput, "output", "o", "", "Output format (e.g., json)")
+}
+
+func printDiskUsage(usage *appTypes.DiskUsage) {
+	printer := utils.NewTableWriter()
+	printer.SetHeaders("TYPE", "NAME", "SIZE", "SHARED")
+	for _, group := range []struct {
+		kind    string
+		entries []appTypes.DiskUsageEntry
+	}{
+		{"image", usage.Images},
+		{"host path", usage.HostPaths},
+		{"model", usage.Models},
+	} {
+		for _, entry := range group.entries {
+			shared := "no"
+			if entry.Shared {
+				shared = "yes"
+			}
+			printer.AppendRow(group.kind, entry.Name, utils.HumanSize(entry.Size), shared)
+		}
+	}
+	printer.CloseTableWriter()
+
+	logger.Infof("Total: %s, exclusive to the application: %s\n", utils.HumanSize(usage.Total), utils.HumanSize(usage.Exclusive))
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var eventsFollow bool
+
+var eventsCmd = &cobra.Command{
+	Use:   "events [name]",
+	Short: "Shows the events of the application",
+	Long: `Shows the events of the pods of the application and their containers, oldest first.
+With --follow the new events are shown as they occur, until interrupted.
+Note: --follow is supported for podman runtime only.
+
+Arguments
+  [name]: Application name (required)
+`,
+	Example: `  # Show the events of an application
+  ai-services application events my-app
+
+  # Follow the events of an application
+  ai-services application events my-app --follow`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := app.Events(ctx, appTypes.EventsOptions{
+			Name:   applicationName,
+			Follow: eventsFollow,
+		}); err != nil {
+			return fmt.Errorf("failed to fetch the application events: %w", err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	eventsCmd.Flags().BoolVarP(&eventsFollow, "follow", "f", false, "Follow the new events as they occur")
+}
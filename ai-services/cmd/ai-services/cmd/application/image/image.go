@@ -2,6 +2,8 @@ package image
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 )
 
 var templateName string
@@ -19,6 +21,11 @@ var ImageCmd = &cobra.Command{
 func init() {
 	ImageCmd.AddCommand(listCmd)
 	ImageCmd.AddCommand(pullCmd)
-	ImageCmd.PersistentFlags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
-	_ = ImageCmd.MarkPersistentFlagRequired("template")
+	ImageCmd.AddCommand(rmCmd)
+
+	for _, cmd := range []*cobra.Command{listCmd, pullCmd} {
+		cmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
+		_ = cmd.MarkFlagRequired("template")
+	}
+	helpers.RegisterTemplateCompletion("template", listCmd, pullCmd)
 }
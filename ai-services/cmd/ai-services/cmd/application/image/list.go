@@ -1,18 +1,39 @@
 package image
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
+var listOutput string
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List container images for a given application template",
-	Long:  ``,
-	Args:  cobra.MaximumNArgs(0),
+	Long: `List container images for a given application template.
+
+For podman runtime, the size, creation date and digest of the images pulled on the host are shown,
+along with the deployed applications using them.`,
+	Args: cobra.MaximumNArgs(0),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch strings.ToLower(listOutput) {
+		case "", "json":
+		default:
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", listOutput)
+		}
+
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -21,16 +42,87 @@ var listCmd = &cobra.Command{
 	},
 }
 
+func init() {
+	listCmd.Flags().StringVarP(&listOutput, "output", "o", "", "Output format (e.g., json)")
+}
+
 func list(templateName string) error {
+	// resolve the canonical template name, so that it is displayed regardless of the case used
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	templateName, err := templates.ResolveTemplate(tp, templateName)
+	if err != nil {
+		return fmt.Errorf("error listing images: %w", err)
+	}
+
 	images, err := image.ListImages(templateName, "")
 	if err != nil {
 		return fmt.Errorf("error listing images: %w", err)
 	}
 
+	details, detailed, err := describeImages(images)
+	if err != nil {
+		return fmt.Errorf("error listing images: %w", err)
+	}
+
+	if strings.ToLower(listOutput) == "json" {
+		data, err := json.MarshalIndent(details, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the images: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+
+		return nil
+	}
+
 	logger.Infof("Container images for application template '%s' are:\n", templateName)
-	for _, image := range images {
-		logger.Infoln("- " + image)
+	if !detailed {
+		for _, d := range details {
+			logger.Infoln("- " + d.Image)
+		}
+
+		return nil
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+	printer.SetHeaders("IMAGE", "SIZE", "CREATED", "DIGEST", "USED BY")
+	for _, d := range details {
+		if !d.Present {
+			printer.AppendRow(d.Image, "-", "-", "not pulled", "-")
+
+			continue
+		}
+		printer.AppendRow(d.Image, utils.HumanSize(d.Size), utils.TimeAgo(d.Created), d.Digest, joinOrDash(d.UsedBy))
 	}
 
 	return nil
 }
+
+// describeImages returns the state of the images on the host for podman runtime and reports whether it could be fetched.
+// Only the names are returned for openshift as the images are pulled by the cluster nodes, or if podman is not reachable.
+func describeImages(images []string) ([]image.ImageDetails, bool, error) {
+	if vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypePodman {
+		runtimeClient, err := vars.RuntimeFactory.Create(vars.Namespace)
+		if err == nil {
+			details, err := image.DescribeImages(runtimeClient, images)
+
+			return details, err == nil, err
+		}
+		logger.Warningf("unable to fetch the image details from the host: %v\n", err)
+	}
+
+	details := make([]image.ImageDetails, 0, len(images))
+	for _, name := range images {
+		details = append(details, image.ImageDetails{Image: name, UsedBy: []string{}})
+	}
+
+	return details, false, nil
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -1,44 +1,139 @@
 package image
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
-	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/registry"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
 var pullCmd = &cobra.Command{
 	Use:   "pull",
 	Short: "Pulls all container images for a given application template",
-	Long:  ``,
-	Args:  cobra.MaximumNArgs(0),
+	Long: `Pulls all container images for a given application template.
+
+Registry credentials are resolved in the below order of precedence and used to login before pulling:
+  1. REGISTRY_USERNAME and REGISTRY_PASSWORD env (scoped to REGISTRY_URL, if set)
+  2. credentials file set via REGISTRY_CREDENTIALS_FILE env (default: ` + registry.DefaultCredentialsFile + `)
+  3. existing login done using 'ai-services registry login'
+
+All the images are attempted even if some of them fail to pull, and a summary of the pulled and failed images
+is reported at the end. The command fails if any of the images failed to pull.
+Use --fail-fast to stop pulling the remaining images after the first failure.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch strings.ToLower(pullOutput) {
+		case "", "json":
+		default:
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", pullOutput)
+		}
+
+		return nil
+	},
+	Args: cobra.MaximumNArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		return pull(templateName)
+		if rt := vars.RuntimeFactory.GetRuntimeType(); rt != types.RuntimeTypePodman {
+			return fmt.Errorf("image pull is only supported for %s runtime (current runtime: %s), images are pulled by the cluster on %s",
+				types.RuntimeTypePodman, rt, types.RuntimeTypeOpenShift)
+		}
+
+		if parallel < 1 {
+			return fmt.Errorf("invalid value for --parallel: %d, must be greater than 0", parallel)
+		}
+
+		return pull(cmd.Context(), templateName, parallel, pullFailFast)
 	},
 }
 
-func pull(template string) error {
+// defaultParallelPulls is the number of parallel pulls used when --parallel is set without a value.
+const defaultParallelPulls = image.DefaultPullWorkers
+
+var (
+	parallel     int
+	pullFailFast bool
+	pullOutput   string
+)
+
+func init() {
+	pullCmd.Flags().IntVar(&parallel, "parallel", 1, fmt.Sprintf("Number of images to pull in parallel (defaults to %d when set without a value)", defaultParallelPulls))
+	pullCmd.Flags().Lookup("parallel").NoOptDefVal = strconv.Itoa(defaultParallelPulls)
+	pullCmd.Flags().BoolVar(&pullFailFast, "fail-fast", false, "Stop pulling the remaining images after the first failure")
+	pullCmd.Flags().StringVarP(&pullOutput, "output", "o", "", "Output format of the pull summary (e.g., json)")
+}
+
+func pull(ctx context.Context, template string, workers int, failFast bool) error {
 	images, err := image.ListImages(template, "")
 	if err != nil {
 		return fmt.Errorf("error listing images: %w", err)
 	}
 
 	logger.Infof("Downloading the images for the application... ")
-	runtimeClient, err := podman.NewPodmanClient()
+	runtimeClient, err := vars.RuntimeFactory.Create(vars.Namespace)
 	if err != nil {
-		return fmt.Errorf("failed to connect to podman: %w", err)
+		return fmt.Errorf("failed to create runtime client: %w", err)
+	}
+
+	results, pullErr := image.PullImages(ctx, runtimeClient, images, workers, failFast)
+	if results == nil && pullErr != nil {
+		return fmt.Errorf("failed to pull the image: %w", pullErr)
 	}
 
-	for _, image := range images {
-		if err := runtimeClient.PullImage(image); err != nil {
-			return fmt.Errorf("failed to pull the image: %w", err)
+	if err := printPullSummary(results); err != nil {
+		return err
+	}
+
+	if pullErr != nil {
+		return fmt.Errorf("failed to pull %d of %d images", countFailedPulls(results), len(results))
+	}
+
+	return nil
+}
+
+// printPullSummary reports the outcome of each image, as json on stdout if requested.
+func printPullSummary(results []image.PullResult) error {
+	if strings.ToLower(pullOutput) == "json" {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the pull summary: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+
+		return nil
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+	printer.SetHeaders("IMAGE", "STATUS", "ERROR")
+	for _, result := range results {
+		errMsg := "-"
+		if result.Error != "" {
+			errMsg = result.Error
 		}
+		printer.AppendRow(result.Image, string(result.Status), errMsg)
 	}
 
 	return nil
 }
+
+func countFailedPulls(results []image.PullResult) int {
+	failed := 0
+	for _, result := range results {
+		if result.Status == image.PullStatusFailed {
+			failed++
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,99 @@
+package image
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rmForce   bool
+	rmAutoYes bool
+	rmOutput  string
+)
+
+var rmCmd = &cobra.Command{
+	Use:   "rm <image>",
+	Short: "Removes a container image",
+	Long: `Removes a single container image from the host, e.g. a stale image left behind by an older application version.
+
+Arguments
+  <image>: Image name or ID`,
+	Example: `  # Remove an image
+  ai-services application image rm icr.io/ai-services/vllm:1.0.0
+
+  # Remove an image used by stopped containers, without confirmation
+  ai-services application image rm icr.io/ai-services/vllm:1.0.0 --force --yes`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch strings.ToLower(rmOutput) {
+		case "", "json":
+		default:
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", rmOutput)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		return remove(args[0])
+	},
+}
+
+func init() {
+	rmCmd.Flags().BoolVarP(&rmForce, "force", "f", false, "Force removal of the image, also removing the containers using it")
+	rmCmd.Flags().BoolVarP(&rmAutoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+	rmCmd.Flags().StringVarP(&rmOutput, "output", "o", "", "Output format (e.g., json)")
+}
+
+func remove(image string) error {
+	if !rmAutoYes {
+		confirmed, err := utils.ConfirmAction(fmt.Sprintf("Are you sure you want to remove the image '%s'? ", image))
+		if err != nil {
+			return fmt.Errorf("failed to take user input: %w", err)
+		}
+
+		if !confirmed {
+			logger.Infoln("Image removal cancelled")
+
+			return nil
+		}
+	}
+
+	runtimeClient, err := vars.RuntimeFactory.Create(vars.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to create runtime client: %w", err)
+	}
+
+	report, err := runtimeClient.RemoveImage(image, rmForce)
+	if err != nil {
+		return err
+	}
+
+	if strings.ToLower(rmOutput) == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the image remove report: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+
+		return nil
+	}
+
+	for _, untagged := range report.Untagged {
+		logger.Infoln("Untagged: " + untagged)
+	}
+	for _, deleted := range report.Deleted {
+		logger.Infoln("Deleted: " + deleted)
+	}
+
+	return nil
+}
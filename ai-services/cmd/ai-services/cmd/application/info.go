@@ -1,23 +1,75 @@
 package application
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/template"
+	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+var (
+	infoFormat  string
+	infoTmpl    *template.Template
+	infoRefresh time.Duration
+)
+
+func init() {
+	infoCmd.Flags().StringVar(&infoFormat, "format", "",
+		"Pretty-print the application info using a Go template (e.g., '{{.Template}} {{.Version}}')")
+	infoCmd.Flags().DurationVar(&infoRefresh, "refresh", 0,
+		"Re-query and print the health of the pods on the given interval until Ctrl+C (e.g., 5s).\n"+
+			"On a terminal it is redrawn in place, otherwise the snapshots are appended")
+}
+
 var infoCmd = &cobra.Command{
 	Use:   "info [name]",
 	Short: "Application info",
 	Long: `Displays the information about the running application
 		Arguments
 		- [name]: Application name (Required)
+
+		The --format flag accepts a Go template which is executed against the application info.
+		Available fields: .Name, .Template, .Version, .Pods (each with .Name, .ID, .Status, .Containers)
+		Available functions: join, upper, lower
 	`,
+	Example: `  # Print the template and version of an application
+  ai-services application info my-app --format '{{.Template}} {{.Version}}'
+
+  # Print the pod names of an application
+  ai-services application info my-app --format '{{range .Pods}}{{.Name}} {{end}}'
+
+  # Follow the health of the pods of an application during an incident
+  ai-services application info my-app --refresh 5s`,
 	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if infoRefresh < 0 {
+			return fmt.Errorf("--refresh must not be negative")
+		}
+
+		if infoFormat == "" {
+			return nil
+		}
+
+		if infoRefresh > 0 {
+			return fmt.Errorf("--format and --refresh flags cannot be used together")
+		}
+
+		var err error
+		infoTmpl, err = utils.ParseFormat(infoFormat)
+
+		return err
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// fetch application name
 		applicationName := args[0]
@@ -35,9 +87,39 @@ var infoCmd = &cobra.Command{
 		}
 
 		opts := appTypes.InfoOptions{
-			Name: applicationName,
+			Name:   applicationName,
+			Format: infoTmpl,
+		}
+
+		if infoRefresh > 0 {
+			return refreshInfo(cmd.Context(), app, opts)
 		}
 
 		return app.Info(opts)
 	},
 }
+
+// refreshInfo prints the health of the pods of the application every --refresh until interrupted.
+// On a terminal the health is redrawn in place, otherwise the snapshots are appended.
+func refreshInfo(ctx context.Context, app application.Application, opts appTypes.InfoOptions) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	opts.Refresh = true
+	opts.Redraw = term.IsTerminal(int(os.Stderr.Fd()))
+
+	ticker := time.NewTicker(infoRefresh)
+	defer ticker.Stop()
+
+	for {
+		if err := app.Info(opts); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
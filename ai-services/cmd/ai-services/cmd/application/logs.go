@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
@@ -12,25 +15,51 @@ import (
 var (
 	podName           string
 	containerNameOrID string
+	logTimestamps     bool
+	logJSON           bool
+	logJSONFields     []string
+	logTailAll        bool
+	logMaxBytes       int
 )
 
 var logsCmd = &cobra.Command{
 	Use: "logs [name]",
 	Long: `Displays logs from an application pod
+
+When --pod is omitted, the primary pod declared by the application template is used.
+If there is no obvious default, the pod can be chosen interactively.
+
+With --json, log lines which are JSON objects are pretty-printed and can be filtered by
+their fields using --json-field, eg:- --json-field level=error. Non-JSON lines pass through untouched.
+
+With --container all, the logs of every container of the pod, including the sidecars, are followed
+with each line prefixed by the name of its container.
+
+With --tail-all, the logs of all the containers of the application are dumped without following them,
+keeping only the most recent --max-bytes of each container and marking the truncated ones. The dump can be
+narrowed using --pod and --container, and redirected to a file to be attached to a bug report, eg:-
+  ai-services application logs my-app --tail-all > my-app-logs.txt
+
 Arguments
 [name]: Application name (required)`,
 	Args: cobra.ExactArgs(1),
-	PreRunE: func(cmd *cobra.Command, args []string) error {
-		if podName == "" {
-			return fmt.Errorf("pod name must be specified using --pod flag")
-		}
-
-		return nil
-	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// fetch application name
 		applicationName := args[0]
 
+		jsonFields, err := utils.ParseKeyValues(logJSONFields)
+		if err != nil {
+			return fmt.Errorf("invalid --json-field: %w", err)
+		}
+
+		if cmd.Flags().Changed("max-bytes") && !logTailAll {
+			return fmt.Errorf("--max-bytes can only be used with --tail-all")
+		}
+
+		if logMaxBytes <= 0 {
+			return fmt.Errorf("--max-bytes must be greater than 0")
+		}
+
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
@@ -44,8 +73,14 @@ Arguments
 		}
 
 		opts := appTypes.LogsOptions{
+			Name:              applicationName,
 			PodName:           podName,
 			ContainerNameOrID: containerNameOrID,
+			Timestamps:        logTimestamps,
+			JSON:              logJSON || len(jsonFields) > 0,
+			JSONFields:        jsonFields,
+			TailAll:           logTailAll,
+			MaxBytes:          logMaxBytes,
 		}
 
 		return app.Logs(opts)
@@ -53,7 +88,18 @@ Arguments
 }
 
 func init() {
-	logsCmd.Flags().StringVar(&podName, "pod", "", "Pod name to show logs from (required)")
-	logsCmd.Flags().StringVar(&containerNameOrID, "container", "", "Container logs to show logs from (Optional)")
-	_ = logsCmd.MarkFlagRequired("pod")
+	logsCmd.Flags().StringVar(&podName, "pod", "", "Pod name to show logs from (defaults to the template's primary pod)")
+	_ = logsCmd.RegisterFlagCompletionFunc("pod", helpers.CompletePodNames)
+	logsCmd.Flags().StringVar(&containerNameOrID, "container", "",
+		fmt.Sprintf("Container logs to show logs from (Optional), '%s' follows every container of the pod with per-container prefixes", common.AllContainers))
+	logsCmd.Flags().BoolVar(&logTimestamps, "timestamps", false, "Prefix each log line with its RFC3339 timestamp")
+	logsCmd.Flags().BoolVar(&logJSON, "json", false, "Pretty-print log lines which are JSON objects")
+	logsCmd.Flags().StringArrayVar(&logJSONFields, "json-field", nil,
+		"Only show JSON log lines whose field matches the value, in key=value form (implies --json, can be repeated)")
+	logsCmd.Flags().BoolVar(&logTailAll, "tail-all", false, "Dump the logs of all the application containers without following them")
+	logsCmd.Flags().IntVar(&logMaxBytes, "max-bytes", common.DefaultDumpMaxBytes,
+		"Maximum bytes of the most recent logs dumped per container with --tail-all")
+	logsCmd.MarkFlagsMutuallyExclusive("tail-all", "json")
+	logsCmd.MarkFlagsMutuallyExclusive("tail-all", "json-field")
+	logsCmd.MarkFlagsMutuallyExclusive("tail-all", "timestamps")
 }
@@ -2,6 +2,9 @@ package model
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
@@ -9,6 +12,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	onlyModels           []string
+	downloadTimeout      time.Duration
+	downloadTotalTimeout time.Duration
+)
+
 var downloadCmd = &cobra.Command{
 	Use:   "download",
 	Short: "Download models for a given application template",
@@ -17,7 +26,6 @@ var downloadCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
-		hiddenTemplates, _ = cmd.Flags().GetBool("hidden")
 
 		return download(cmd)
 	},
@@ -26,23 +34,54 @@ var downloadCmd = &cobra.Command{
 func init() {
 	downloadCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name(Required)")
 	_ = downloadCmd.MarkFlagRequired("template")
+	helpers.RegisterTemplateCompletion("template", downloadCmd)
 	downloadCmd.Flags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool container image used for downloading the model (for development purposes only)")
 	_ = downloadCmd.Flags().MarkHidden("tool-image")
 	downloadCmd.Flags().StringVar(&vars.ModelDirectory, "dir", vars.ModelDirectory, "Directory to download the model files")
+	downloadCmd.Flags().StringArrayVar(&onlyModels, "only", []string{},
+		"Download only the given model of the template, can be provided multiple times (e.g. --only ibm-granite/granite-3.3-8b-instruct)")
+	downloadCmd.Flags().DurationVar(&downloadTimeout, "model-download-timeout", 0,
+		"Maximum time to download a single model, including its retries (e.g. 30m). 0 means no limit")
+	downloadCmd.Flags().DurationVar(&downloadTotalTimeout, "model-download-total-timeout", 0,
+		"Maximum time to download all the models (e.g. 2h). 0 means no limit")
 }
 
 func download(cmd *cobra.Command) error {
-	models, err := models(templateName)
+	template, models, err := models(templateName)
 	if err != nil {
 		return err
 	}
-	logger.Infoln("Downloaded Models in application template" + templateName + ":")
-	for _, model := range models {
-		err := helpers.DownloadModel(model, vars.ModelDirectory)
-		if err != nil {
-			return fmt.Errorf("failed to download model: %w", err)
+
+	models, err = selectModels(template, models, onlyModels)
+	if err != nil {
+		return err
+	}
+	if downloadTimeout < 0 || downloadTotalTimeout < 0 {
+		return fmt.Errorf("--model-download-timeout and --model-download-total-timeout must not be negative")
+	}
+
+	logger.Infoln("Downloaded Models in application template" + template + ":")
+
+	return helpers.DownloadModels(cmd.Context(), models, vars.ModelDirectory,
+		helpers.ModelDownloadOptions{Timeout: downloadTimeout, TotalTimeout: downloadTotalTimeout}, nil)
+}
+
+// selectModels returns the subset of the template models given via --only, all the models are returned if none are given.
+func selectModels(template string, models, only []string) ([]string, error) {
+	if len(only) == 0 {
+		return models, nil
+	}
+
+	selected := make([]string, 0, len(only))
+	for _, model := range only {
+		if !slices.Contains(models, model) {
+			return nil, fmt.Errorf("model '%s' is not part of the application template %s, available models: %s",
+				model, template, strings.Join(models, ", "))
+		}
+		if !slices.Contains(selected, model) {
+			selected = append(selected, model)
 		}
 	}
 
-	return nil
+	return selected, nil
 }
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSelectModels(t *testing.T) {
+	models := []string{"ibm-granite/granite-3.3-8b-instruct", "ibm-granite/granite-embedding-278m-multilingual"}
+
+	tests := []struct {
+		name    string
+		only    []string
+		want    []string
+		wantErr string
+	}{
+		{name: "all", want: models},
+		{name: "subset", only: []string{models[1]}, want: []string{models[1]}},
+		{name: "duplicates", only: []string{models[0], models[0]}, want: []string{models[0]}},
+		{name: "given order", only: []string{models[1], models[0]}, want: []string{models[1], models[0]}},
+		{
+			name: "unknown model", only: []string{models[0], "ibm-granite/granite-4"},
+			wantErr: "model 'ibm-granite/granite-4' is not part of the application template rag, available models: " + strings.Join(models, ", "),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectModels("rag", models, tt.only)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("selectModels() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectModels() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("selectModels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
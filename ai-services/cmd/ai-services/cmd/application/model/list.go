@@ -1,42 +1,167 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
-var templateName string
+var (
+	templateName string
+	allTemplates bool
+	listOutput   string
+)
+
+// templateModels describes the models of an application template in the -o json output.
+type templateModels struct {
+	Template string   `json:"template"`
+	Models   []string `json:"models"`
+}
+
+// allTemplatesModel is a model of the --all-templates output, along with the templates requiring it.
+type allTemplatesModel struct {
+	helpers.TemplateModel
+
+	// Size is the estimated download size in bytes, 0 if unknown.
+	Size       int64                   `json:"size"`
+	SizeSource helpers.ModelSizeSource `json:"sizeSource"`
+}
+
+// allTemplatesModels is the --all-templates output.
+type allTemplatesModels struct {
+	Models []allTemplatesModel `json:"models"`
+	// TotalSize is the estimated download size of all the models in bytes, excluding the ones of unknown size.
+	TotalSize    int64 `json:"totalSize"`
+	UnknownSizes int   `json:"unknownSizes"`
+}
 
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List models for a given application template",
-	Long:  ``,
-	Args:  cobra.MaximumNArgs(0),
+	Long: `List models for a given application template.
+
+With --all-templates, the union of the models of every application template is listed instead, along with the
+templates requiring each model and the total estimated download size, e.g. to pre-stage all the models for an
+air-gapped host in one pass. The size of a model already downloaded into the model directory is used as is, else
+it is queried from the Hugging Face hub unless --offline is set.`,
+	Example: `  # List the models of the rag template
+  ai-services application model list --template rag
+
+  # List the models of all the templates with their estimated download size
+  ai-services application model list --all-templates -o json`,
+	Args: cobra.MaximumNArgs(0),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch strings.ToLower(listOutput) {
+		case "", "json":
+			return nil
+		default:
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", listOutput)
+		}
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
-		hiddenTemplates, _ = cmd.Flags().GetBool("hidden")
+
+		if allTemplates {
+			hidden, _ := cmd.Flags().GetBool("hidden")
+
+			return listAllTemplates(cmd, hidden)
+		}
 
 		return list(cmd)
 	},
 }
 
 func init() {
-	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
-	_ = listCmd.MarkFlagRequired("template")
+	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required unless --all-templates is set)")
+	helpers.RegisterTemplateCompletion("template", listCmd)
+	listCmd.Flags().BoolVar(&allTemplates, "all-templates", false,
+		"List the models of all the application templates, along with the templates requiring them and their estimated download size")
+	listCmd.MarkFlagsOneRequired("template", "all-templates")
+	listCmd.MarkFlagsMutuallyExclusive("template", "all-templates")
+	listCmd.Flags().StringVarP(&listOutput, "output", "o", "", "Output format (e.g., json)")
 }
 
 func list(cmd *cobra.Command) error {
-	models, err := models(templateName)
+	template, models, err := models(templateName)
 	if err != nil {
 		return fmt.Errorf("failed to list the models, err: %w", err)
 	}
-	logger.Infoln("Models in application template " + templateName + ":")
+
+	if strings.ToLower(listOutput) == "json" {
+		return printJSON(templateModels{Template: template, Models: models})
+	}
+
+	logger.Infoln("Models in application template " + template + ":")
 	for _, model := range models {
 		logger.Infoln("- " + model)
 	}
 
 	return nil
 }
+
+func listAllTemplates(cmd *cobra.Command, hidden bool) error {
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	templateNames, err := tp.ListApplications(hidden)
+	if err != nil {
+		return fmt.Errorf("failed to list application templates: %w", err)
+	}
+	sort.Strings(templateNames)
+
+	models, err := helpers.ListTemplatesModels(templateNames)
+	if err != nil {
+		return fmt.Errorf("failed to list the models, err: %w", err)
+	}
+
+	out := allTemplatesModels{Models: make([]allTemplatesModel, 0, len(models))}
+	for _, model := range models {
+		size, source := helpers.EstimateModelSize(cmd.Context(), vars.ModelDirectory, model.Model)
+		if source == helpers.ModelSizeSourceUnknown {
+			out.UnknownSizes++
+		}
+		out.TotalSize += size
+		out.Models = append(out.Models, allTemplatesModel{TemplateModel: model, Size: size, SizeSource: source})
+	}
+
+	if strings.ToLower(listOutput) == "json" {
+		return printJSON(out)
+	}
+
+	printer := utils.NewTableWriter()
+	printer.SetHeaders("MODEL", "TEMPLATES", "SIZE")
+	for _, model := range out.Models {
+		size := "unknown"
+		if model.SizeSource != helpers.ModelSizeSourceUnknown {
+			size = utils.HumanSize(model.Size)
+		}
+		printer.AppendRow(model.Model, strings.Join(model.Templates, ", "), size)
+	}
+	printer.CloseTableWriter()
+
+	summary := fmt.Sprintf("Total estimated download size of %d models: %s", len(out.Models), utils.HumanSize(out.TotalSize))
+	if out.UnknownSizes > 0 {
+		summary += fmt.Sprintf(", excluding %d models of unknown size", out.UnknownSizes)
+	}
+	logger.Infoln(summary)
+
+	return nil
+}
+
+func printJSON(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the models: %w", err)
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
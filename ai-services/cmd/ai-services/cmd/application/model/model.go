@@ -1,9 +1,6 @@
 package model
 
 import (
-	"fmt"
-	"slices"
-
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/spf13/cobra"
@@ -19,23 +16,22 @@ var ModelCmd = &cobra.Command{
 	},
 }
 
-var hiddenTemplates bool
-
 func init() {
 	ModelCmd.AddCommand(listCmd)
 	ModelCmd.AddCommand(downloadCmd)
+	ModelCmd.AddCommand(verifyCmd)
 }
 
-func models(template string) ([]string, error) {
+// models returns the canonical template name along with its models.
+// The template name is matched case-insensitively, hence the canonical name must be used for display.
+func models(template string) (string, []string, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
-	apps, err := tp.ListApplications(hiddenTemplates)
+	template, err := templates.ResolveTemplate(tp, template)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list the applications, err: %w", err)
+		return "", nil, err
 	}
 
-	if !slices.Contains(apps, template) {
-		return nil, fmt.Errorf("application template %s does not exist", template)
-	}
+	models, err := helpers.ListModels(template, "")
 
-	return helpers.ListModels(template, "")
+	return template, models, err
 }
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifyDir    string
+	verifyOutput string
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the downloaded models of a given application template",
+	Long: `Verifies that every model required by the application template exists on disk and matches
+the checksums recorded while it got downloaded, without accessing the network.
+
+Run it before 'ai-services application create --skip-model-download', e.g. in air-gapped environments.
+The command fails if any model is missing, partially downloaded or corrupt.`,
+	Example: `  # Verify the models of the rag template
+  ai-services application model verify --template rag
+
+  # Verify the models copied to a mounted share
+  ai-services application model verify --template rag --dir /mnt/models -o json`,
+	Args: cobra.MaximumNArgs(0),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch strings.ToLower(verifyOutput) {
+		case "", "json":
+			return nil
+		default:
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", verifyOutput)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		return verify()
+	},
+}
+
+func init() {
+	verifyCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
+	_ = verifyCmd.MarkFlagRequired("template")
+	helpers.RegisterTemplateCompletion("template", verifyCmd)
+	verifyCmd.Flags().StringVar(&verifyDir, "dir", vars.ModelDirectory, "Directory holding the model files")
+	verifyCmd.Flags().StringVarP(&verifyOutput, "output", "o", "", "Output format of the verification (e.g., json)")
+}
+
+func verify() error {
+	template, models, err := models(templateName)
+	if err != nil {
+		return err
+	}
+
+	results := make([]helpers.ModelVerification, 0, len(models))
+	for _, model := range models {
+		logger.Infof("Verifying model %s...\n", model, logger.VerbosityLevelDebug)
+		results = append(results, helpers.VerifyModel(verifyDir, model))
+	}
+
+	if err := printVerification(results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Failed() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d models of application template %s failed the verification", failed, len(results), template)
+	}
+
+	return nil
+}
+
+func printVerification(results []helpers.ModelVerification) error {
+	if strings.ToLower(verifyOutput) == "json" {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the verification: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+
+		return nil
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+	printer.SetHeaders("MODEL", "STATUS", "SIZE", "DETAIL")
+	for _, result := range results {
+		printer.AppendRow(result.Model, string(result.Status), utils.HumanSize(result.Size), result.Detail)
+	}
+
+	return nil
+}
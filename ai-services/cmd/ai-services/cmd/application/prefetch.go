@@ -0,0 +1,230 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// modelPrefetchStatus is the outcome of prefetching a single model.
+type modelPrefetchStatus string
+
+const (
+	modelPrefetchDownloaded modelPrefetchStatus = "downloaded"
+	modelPrefetchFailed     modelPrefetchStatus = "failed"
+)
+
+var (
+	prefetchTemplate string
+	prefetchModels   bool
+	prefetchImages   bool
+	prefetchModelDir string
+	prefetchOutput   string
+)
+
+// modelPrefetchResult reports the outcome of prefetching a model.
+type modelPrefetchResult struct {
+	Model  string              `json:"model"`
+	Status modelPrefetchStatus `json:"status"`
+	Error  string              `json:"error,omitempty"`
+}
+
+// prefetchSummary is the -o json output of prefetch, the artifacts which were not requested are left out.
+type prefetchSummary struct {
+	Template string                `json:"template"`
+	Images   []image.PullResult    `json:"images,omitempty"`
+	Models   []modelPrefetchResult `json:"models,omitempty"`
+}
+
+var prefetchCmd = &cobra.Command{
+	Use:   "prefetch",
+	Short: "Stages the models and the images of an application template without deploying it",
+	Long: `Downloads the models and pulls the container images required by an application template, without deploying
+anything, so that a connected machine can stage the artifacts of an air-gapped host.
+
+Both the models and the images are fetched unless --models or --images is given to fetch only them, e.g. to stage in
+phases. All the artifacts are attempted even if some of them fail, and a summary is reported at the end. The command
+fails if any of them failed.
+
+The images are pulled using the same registry credentials as 'ai-services application image pull'.
+Note: The images can only be prefetched for podman runtime, as they are pulled by the cluster on openshift.`,
+	Example: `  # Stage the models and the images of the rag template
+  ai-services application prefetch --template rag
+
+  # Only stage the models into a mounted share and print a summary
+  ai-services application prefetch --template rag --models --dir /mnt/models -o json`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if prefetchOutput != "" && strings.ToLower(prefetchOutput) != "json" {
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", prefetchOutput)
+		}
+
+		// neither given means both
+		if !prefetchModels && !prefetchImages {
+			prefetchModels, prefetchImages = true, true
+		}
+
+		if rt := vars.RuntimeFactory.GetRuntimeType(); prefetchImages && rt != types.RuntimeTypePodman {
+			return fmt.Errorf("images can only be prefetched for %s runtime (current runtime: %s), use --models to only prefetch the models",
+				types.RuntimeTypePodman, rt)
+		}
+
+		tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+		var err error
+		prefetchTemplate, err = templates.ResolveTemplate(tp, prefetchTemplate)
+
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		summary, err := prefetchArtifacts(cmd)
+		if printErr := printPrefetchSummary(summary); printErr != nil {
+			return printErr
+		}
+
+		return err
+	},
+}
+
+// fetchImages and fetchModels prefetch the artifacts of the template, variables so that the tests can assert
+// which ones are prefetched without pulling or downloading anything.
+var (
+	fetchImages = prefetchTemplateImages
+	fetchModels = prefetchTemplateModels
+)
+
+// prefetchArtifacts prefetches the requested artifacts of the template, a failure of one kind does not stop the other.
+func prefetchArtifacts(cmd *cobra.Command) (prefetchSummary, error) {
+	summary := prefetchSummary{Template: prefetchTemplate}
+	var errs []error
+
+	if prefetchImages {
+		results, err := fetchImages(cmd, prefetchTemplate)
+		summary.Images = results
+		errs = append(errs, err)
+	}
+
+	if prefetchModels {
+		results, err := fetchModels(cmd, prefetchTemplate)
+		summary.Models = results
+		errs = append(errs, err)
+	}
+
+	return summary, errors.Join(errs...)
+}
+
+func init() {
+	prefetchCmd.Flags().StringVarP(&prefetchTemplate, "template", "t", "", "Application template name (Required)")
+	_ = prefetchCmd.MarkFlagRequired("template")
+	helpers.RegisterTemplateCompletion("template", prefetchCmd)
+	prefetchCmd.Flags().BoolVar(&prefetchModels, "models", false, "Prefetch the models of the template (both models and images are prefetched if none is given)")
+	prefetchCmd.Flags().BoolVar(&prefetchImages, "images", false, "Prefetch the container images of the template (both models and images are prefetched if none is given)")
+	prefetchCmd.Flags().StringVar(&prefetchModelDir, "dir", vars.ModelDirectory, "Directory to download the model files")
+	prefetchCmd.Flags().StringVarP(&prefetchOutput, "output", "o", "", "Output format of the prefetch summary (e.g., json)")
+}
+
+// prefetchTemplateImages pulls the images of the template, the failed pulls are reported in the results.
+func prefetchTemplateImages(cmd *cobra.Command, template string) ([]image.PullResult, error) {
+	images, err := image.ListImages(template, "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+
+	runtimeClient, err := vars.RuntimeFactory.Create(vars.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create runtime client: %w", err)
+	}
+
+	logger.Infof("Pulling %d images of application template %s...\n", len(images), template)
+	results, pullErr := image.PullImages(cmd.Context(), runtimeClient, images, 1, false)
+	if results == nil && pullErr != nil {
+		return nil, fmt.Errorf("failed to pull the images: %w", pullErr)
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == image.PullStatusFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("failed to pull %d of %d images", failed, len(results))
+	}
+
+	return results, nil
+}
+
+// prefetchTemplateModels downloads the models of the template one after the other, a failed model does not stop
+// the download of the others. The output of the downloads is captured in json mode, so that it does not mix with the summary.
+func prefetchTemplateModels(cmd *cobra.Command, template string) ([]modelPrefetchResult, error) {
+	models, err := helpers.ListModels(template, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the models: %w", err)
+	}
+
+	opts := helpers.ModelDownloadOptions{Quiet: strings.ToLower(prefetchOutput) == "json"}
+	results := make([]modelPrefetchResult, 0, len(models))
+	failed := 0
+	for _, model := range models {
+		result := modelPrefetchResult{Model: model, Status: modelPrefetchDownloaded}
+		if err := helpers.DownloadModels(cmd.Context(), []string{model}, prefetchModelDir, opts, nil); err != nil {
+			result.Status = modelPrefetchFailed
+			result.Error = err.Error()
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	if failed > 0 {
+		return results, fmt.Errorf("failed to download %d of %d models", failed, len(results))
+	}
+
+	return results, nil
+}
+
+// printPrefetchSummary reports the outcome of each artifact, as json on stdout if requested.
+func printPrefetchSummary(summary prefetchSummary) error {
+	if strings.ToLower(prefetchOutput) == "json" {
+		data, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the prefetch summary: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+
+		return nil
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+	printer.SetHeaders("KIND", "NAME", "STATUS", "ERROR")
+	for _, result := range summary.Images {
+		printer.AppendRow("image", result.Image, string(result.Status), orDash(result.Error))
+	}
+	for _, result := range summary.Models {
+		printer.AppendRow("model", result.Model, string(result.Status), orDash(result.Error))
+	}
+
+	return nil
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
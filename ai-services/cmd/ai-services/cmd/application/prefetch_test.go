@@ -0,0 +1,88 @@
+package application
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestPrefetchSelection asserts that --models and --images limit the prefetch to the given artifacts, both being
+// prefetched if none is given, and that a failure of one kind does not stop the other.
+func TestPrefetchSelection(t *testing.T) {
+	errPull := errors.New("failed to pull 1 of 1 images")
+
+	tests := []struct {
+		name       string
+		runtime    types.RuntimeType
+		models     bool
+		images     bool
+		pullErr    error
+		want       []string
+		wantErr    error
+		wantPreErr bool
+	}{
+		{name: "both by default", runtime: types.RuntimeTypePodman, want: []string{"images", "models"}},
+		{name: "both", runtime: types.RuntimeTypePodman, models: true, images: true, want: []string{"images", "models"}},
+		{name: "models only", runtime: types.RuntimeTypePodman, models: true, want: []string{"models"}},
+		{name: "images only", runtime: types.RuntimeTypePodman, images: true, want: []string{"images"}},
+		{name: "failed images", runtime: types.RuntimeTypePodman, pullErr: errPull, want: []string{"images", "models"}, wantErr: errPull},
+		{name: "models on openshift", runtime: types.RuntimeTypeOpenShift, models: true, want: []string{"models"}},
+		{name: "images on openshift", runtime: types.RuntimeTypeOpenShift, wantPreErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, images, models := vars.RuntimeFactory, fetchImages, fetchModels
+			t.Cleanup(func() {
+				vars.RuntimeFactory, fetchImages, fetchModels = factory, images, models
+				prefetchTemplate, prefetchModels, prefetchImages = "", false, false
+			})
+
+			var fetched []string
+			fetchImages = func(cmd *cobra.Command, template string) ([]image.PullResult, error) {
+				fetched = append(fetched, "images")
+
+				return []image.PullResult{{Image: template + "-image", Status: image.PullStatusPulled}}, tt.pullErr
+			}
+			fetchModels = func(_ *cobra.Command, template string) ([]modelPrefetchResult, error) {
+				fetched = append(fetched, "models")
+
+				return []modelPrefetchResult{{Model: template + "-model", Status: modelPrefetchDownloaded}}, nil
+			}
+			vars.RuntimeFactory = runtime.NewRuntimeFactory(tt.runtime)
+			prefetchTemplate, prefetchModels, prefetchImages = "RAG", tt.models, tt.images
+
+			if err := prefetchCmd.PreRunE(prefetchCmd, nil); (err != nil) != tt.wantPreErr {
+				t.Fatalf("PreRunE() error = %v, want error %v", err, tt.wantPreErr)
+			}
+			if tt.wantPreErr {
+				return
+			}
+
+			summary, err := prefetchArtifacts(prefetchCmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("prefetchArtifacts() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(fetched, tt.want) {
+				t.Errorf("prefetchArtifacts() fetched %v, want %v", fetched, tt.want)
+			}
+			// the artifacts which were not requested are left out of the summary
+			if got := len(summary.Images) > 0; got != slices.Contains(tt.want, "images") {
+				t.Errorf("summary images = %v, want them only if requested", summary.Images)
+			}
+			if got := len(summary.Models) > 0; got != slices.Contains(tt.want, "models") {
+				t.Errorf("summary models = %v, want them only if requested", summary.Models)
+			}
+			if summary.Template != "rag" {
+				t.Errorf("summary template = %q, want the resolved template %q", summary.Template, "rag")
+			}
+		})
+	}
+}
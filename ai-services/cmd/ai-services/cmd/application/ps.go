@@ -1,16 +1,35 @@
 package application
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"text/template"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
-var output string
+const defaultWatchInterval = 2 * time.Second
+
+var (
+	output     string
+	psFormat   string
+	psPodTmpl  *template.Template
+	psNoTrunc  bool
+	psSince    time.Duration
+	psUntil    time.Duration
+	psWatch    bool
+	psInterval time.Duration
+)
 
 func init() {
 	psCmd.Flags().StringVarP(
@@ -18,12 +37,49 @@ func init() {
 		"output",
 		"o",
 		"",
-		"Output format (e.g., wide)",
+		"Output format (e.g., wide, json)",
+	)
+	psCmd.Flags().StringVar(
+		&psFormat,
+		"format",
+		"",
+		"Pretty-print pods using a Go template (e.g., '{{.PodName}} {{.Status}}')",
+	)
+	psCmd.Flags().BoolVar(
+		&psNoTrunc,
+		"no-trunc",
+		false,
+		"Do not truncate the pod IDs, e.g. to use them with podman commands (implies wide output)",
+	)
+	psCmd.Flags().DurationVar(
+		&psSince,
+		"since",
+		0,
+		"Only list the pods created within the given duration (e.g., 1h, 30m)",
+	)
+	psCmd.Flags().DurationVar(
+		&psUntil,
+		"until",
+		0,
+		"Only list the pods created more than the given duration ago (e.g., 24h)",
+	)
+	psCmd.Flags().BoolVarP(
+		&psWatch,
+		"watch",
+		"w",
+		false,
+		"Refresh the list periodically until Ctrl+C, with -o json a snapshot is streamed per line (NDJSON)",
+	)
+	psCmd.Flags().DurationVar(
+		&psInterval,
+		"interval",
+		defaultWatchInterval,
+		"Refresh interval of --watch",
 	)
 }
 
 func isOutputWide() bool {
-	return strings.ToLower(output) == "wide"
+	return strings.ToLower(output) == "wide" || (psNoTrunc && output == "")
 }
 
 var psCmd = &cobra.Command{
@@ -33,8 +89,55 @@ var psCmd = &cobra.Command{
 Lists information about a specific application if the name is provided
 Arguments
   [name]: Application name (optional)
+
+The --format flag accepts a Go template which is executed for each pod.
+Available fields: .ApplicationName, .PodID, .PodName, .Status, .Restarts, .Created, .Ports, .Containers, .Labels
+Available functions: join, upper, lower
 `,
+	Example: `  # List the pods created within the last hour
+  ai-services application ps --since 1h
+
+  # Monitor the pods of an application while it is being created
+  ai-services application ps my-app --watch
+
+  # List the pod names and their status
+  ai-services application ps --format '{{.PodName}} {{.Status}}'
+
+  # List the exposed ports of the pods of an application
+  ai-services application ps my-app --format '{{.PodName}}: {{join .Ports ","}}'`,
 	Args: cobra.MaximumNArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch strings.ToLower(output) {
+		case "", "wide", "json":
+		default:
+			return fmt.Errorf("unsupported output format: %s, supported formats are: wide, json", output)
+		}
+
+		if psSince < 0 || psUntil < 0 {
+			return fmt.Errorf("--since and --until must not be negative")
+		}
+
+		if psSince > 0 && psUntil > 0 && psUntil >= psSince {
+			return fmt.Errorf("--until (%s) must be shorter than --since (%s), otherwise no pod can match", psUntil, psSince)
+		}
+
+		if psInterval <= 0 {
+			return fmt.Errorf("--interval must be greater than 0")
+		}
+
+		if psFormat == "" {
+			return nil
+		}
+
+		if output != "" {
+			return fmt.Errorf("--format and --output flags cannot be used together")
+		}
+
+		var err error
+		psPodTmpl, err = utils.ParseFormat(psFormat)
+
+		return err
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -56,6 +159,15 @@ Arguments
 		opts := appTypes.ListOptions{
 			ApplicationName: applicationName,
 			OutputWide:      isOutputWide(),
+			OutputJSON:      strings.ToLower(output) == "json",
+			Format:          psPodTmpl,
+			NoTrunc:         psNoTrunc,
+			Since:           psSince,
+			Until:           psUntil,
+		}
+
+		if psWatch {
+			return watchApplication(cmd.Context(), app, opts)
 		}
 
 		_, err = app.List(opts)
@@ -66,3 +178,28 @@ Arguments
 		return nil
 	},
 }
+
+// watchApplication lists the pods every --interval until interrupted.
+// On a terminal the list is redrawn in place, otherwise the snapshots are appended.
+func watchApplication(ctx context.Context, app application.Application, opts appTypes.ListOptions) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	opts.JSONLines = opts.OutputJSON
+	opts.Redraw = !opts.OutputJSON && term.IsTerminal(int(os.Stderr.Fd()))
+
+	ticker := time.NewTicker(psInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := app.List(opts); err != nil {
+			return fmt.Errorf("failed to fetch application: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	restartPodNames    []string
+	restartOnUnhealthy bool
+	restartTimeout     time.Duration
+)
+
+var restartCmd = &cobra.Command{
+	Use:   "restart [name]",
+	Short: "Restarts the application pods",
+	Long: `Restarts the pods of an application (or the selected pods) and waits for them to be healthy again,
+as 'ai-services application wait' would.
+
+With --on-unhealthy, only the pods with a container currently reporting unhealthy are restarted, leaving the
+healthy pods running, e.g. to heal a partially degraded application without disrupting the working pods.
+It is a no-op if no pod is unhealthy.
+
+Arguments
+  [name]: Application name (required)
+
+Note: Supported for podman runtime only.
+`,
+	Example: `  # Restart all the pods of the application
+  ai-services application restart my-app
+
+  # Only restart the unhealthy pods, without prompting, and wait up to 20 minutes for them to recover
+  ai-services application restart my-app --on-unhealthy --yes --timeout 20m`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if restartTimeout <= 0 {
+			return fmt.Errorf("--timeout must be greater than 0")
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		opts := appTypes.RestartOptions{
+			Name:        applicationName,
+			PodNames:    restartPodNames,
+			AutoYes:     autoYes,
+			OnUnhealthy: restartOnUnhealthy,
+			Timeout:     restartTimeout,
+		}
+
+		return app.Restart(opts)
+	},
+}
+
+func init() {
+	restartCmd.Flags().StringSliceVar(&restartPodNames, "pod", []string{}, "Specific pod name(s) to restart (optional)\nCan be specified multiple times: --pod pod1 --pod pod2\nOr comma-separated: --pod pod1,pod2")
+	_ = restartCmd.RegisterFlagCompletionFunc("pod", helpers.CompletePodNames)
+	restartCmd.Flags().BoolVar(&restartOnUnhealthy, "on-unhealthy", false, "Only restart the pods with a container reporting unhealthy, leaving the healthy ones running")
+	restartCmd.Flags().DurationVar(&restartTimeout, "timeout", defaultWaitTimeout, "Maximum time to wait for the restarted pods to be healthy (e.g. 10s, 2m, 1h)")
+	restartCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+}
@@ -1,20 +1,56 @@
 package application
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"os"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+var templatesOutput string
+
+// templateInfo describes an application template in the -o json output.
+type templateInfo struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Version     string          `json:"version"`
+	Params      []templateParam `json:"params"`
+	Models      []string        `json:"models"`
+	SMTLevel    *int            `json:"smtLevel"`
+}
+
+// templateParam is a supported parameter of an application template.
+type templateParam struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+func init() {
+	templatesCmd.Flags().StringVarP(&templatesOutput, "output", "o", "", "Output format (e.g., json)")
+}
+
 var templatesCmd = &cobra.Command{
 	Use:   "templates",
 	Short: "Lists the offered application templates and their supported parameters",
 	Long:  `Retrieves information about the offered application templates and their supported parameters`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if templatesOutput != "" && strings.ToLower(templatesOutput) != "json" {
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", templatesOutput)
+		}
+
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -26,15 +62,19 @@ var templatesCmd = &cobra.Command{
 			return fmt.Errorf("failed to list application templates: %w", err)
 		}
 
+		// sort appTemplateNames alphabetically
+		sort.Strings(appTemplateNames)
+
+		if strings.ToLower(templatesOutput) == "json" {
+			return printTemplatesJSON(tp, appTemplateNames)
+		}
+
 		if len(appTemplateNames) == 0 {
 			logger.Infoln("No application templates found.")
 
 			return nil
 		}
 
-		// sort appTemplateNames alphabetically
-		sort.Strings(appTemplateNames)
-
 		logger.Infoln("Available application templates:")
 		for _, name := range appTemplateNames {
 			appTemplatesParametersWithDescription, err := tp.ListApplicationTemplateValues(name)
@@ -69,3 +109,52 @@ var templatesCmd = &cobra.Command{
 		return nil
 	},
 }
+
+// printTemplatesJSON prints the given application templates as a JSON array, sourced from their metadata.
+func printTemplatesJSON(tp templates.Template, appTemplateNames []string) error {
+	infos := make([]templateInfo, 0, len(appTemplateNames))
+	for _, name := range appTemplateNames {
+		metadata, err := tp.LoadMetadata(name, false)
+		if err != nil {
+			return fmt.Errorf("failed to load application metadata of %s: %w", name, err)
+		}
+
+		values, err := tp.ListApplicationTemplateValues(name)
+		if err != nil {
+			return fmt.Errorf("failed to list application template values of %s: %w", name, err)
+		}
+
+		info := templateInfo{
+			Name:        name,
+			Description: metadata.Description,
+			Version:     metadata.Version,
+			Params:      make([]templateParam, 0, len(values)),
+			Models:      []string{},
+			SMTLevel:    metadata.SMTLevel,
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			info.Params = append(info.Params, templateParam{Key: key, Description: values[key]})
+		}
+
+		// the models are declared by the pod templates, which only exist for the podman runtime
+		if vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypePodman {
+			models, err := helpers.ListModels(name, "")
+			if err != nil {
+				return fmt.Errorf("failed to list models of %s: %w", name, err)
+			}
+			slices.Sort(models)
+			info.Models = models
+		}
+
+		infos = append(infos, info)
+	}
+
+	data, err := json.MarshalIndent(infos, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal templates: %w", err)
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var topSpyre bool
+
+var topCmd = &cobra.Command{
+	Use:   "top [name]",
+	Short: "Shows the resource usage of the application",
+	Long: `Shows the CPU and memory usage of the running containers of the application.
+With --spyre the Spyre cards allocated to the containers are shown instead, along with their driver and NUMA node.
+Note: Supported for podman runtime only.
+
+Arguments
+  [name]: Application name (required)
+`,
+	Example: `  # Show the CPU and memory usage of an application
+  ai-services application top my-app
+
+  # Show the Spyre cards allocated to an application
+  ai-services application top my-app --spyre`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		if err := app.Top(appTypes.TopOptions{Name: applicationName, Spyre: topSpyre}); err != nil {
+			return fmt.Errorf("failed to fetch the application resource usage: %w", err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	topCmd.Flags().BoolVar(&topSpyre, "spyre", false, "Show the Spyre cards allocated to the containers")
+}
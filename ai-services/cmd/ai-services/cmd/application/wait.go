@@ -0,0 +1,95 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	defaultWaitTimeout = 10 * time.Minute
+)
+
+var (
+	waitPodNames []string
+	waitFor      string
+	waitTimeout  time.Duration
+	maxRestarts  int
+)
+
+var waitCmd = &cobra.Command{
+	Use:   "wait [name]",
+	Short: "Waits for the application to reach the given condition",
+	Long: `Blocks until all the pods of the application (or the selected pods) reach the given condition.
+Exits with a non-zero status if the condition is not met within the timeout,
+or if the containers of a pod restart more than --max-restarts times while waiting.
+
+Arguments
+  [name]: Application name (required)
+
+Conditions
+  healthy: all the containers are running and passing their health checks (default)
+  running: all the containers are running
+  exited:  all the containers have exited
+`,
+	Example: `  # Wait for the application to be healthy
+  ai-services application wait my-app
+
+  # Wait up to 30 minutes for a specific pod to be running
+  ai-services application wait my-app --pod my-app--vllm-server --for running --timeout 30m`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !appTypes.WaitCondition(strings.ToLower(waitFor)).Valid() {
+			return fmt.Errorf("invalid --for condition: %s, supported conditions are: %s, %s, %s", waitFor,
+				appTypes.WaitConditionHealthy, appTypes.WaitConditionRunning, appTypes.WaitConditionExited)
+		}
+
+		if maxRestarts < 0 {
+			return fmt.Errorf("--max-restarts must not be negative")
+		}
+
+		if waitTimeout <= 0 {
+			return fmt.Errorf("--timeout must be greater than 0")
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		opts := appTypes.WaitOptions{
+			Name:        applicationName,
+			PodNames:    waitPodNames,
+			Condition:   appTypes.WaitCondition(strings.ToLower(waitFor)),
+			Timeout:     waitTimeout,
+			MaxRestarts: maxRestarts,
+		}
+
+		return app.Wait(opts)
+	},
+}
+
+func init() {
+	waitCmd.Flags().StringSliceVar(&waitPodNames, "pod", []string{}, "Specific pod name(s) to wait for (optional)\nCan be specified multiple times: --pod pod1 --pod pod2\nOr comma-separated: --pod pod1,pod2")
+	waitCmd.Flags().StringVar(&waitFor, "for", string(appTypes.WaitConditionHealthy), "Condition to wait for. Supported values: healthy, running, exited")
+	waitCmd.Flags().DurationVar(&waitTimeout, "timeout", defaultWaitTimeout, "Maximum time to wait for the condition (e.g. 10s, 2m, 1h)")
+	waitCmd.Flags().IntVar(&maxRestarts, "max-restarts", 0, "Maximum number of container restarts allowed per pod while waiting")
+}
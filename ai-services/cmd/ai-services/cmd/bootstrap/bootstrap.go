@@ -1,23 +1,39 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	bootstrapTypes "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
 // BootstrapCmd represents the bootstrap command.
 func BootstrapCmd() *cobra.Command {
+	var output string
+
 	bootstrapCmd := &cobra.Command{
 		Use:     "bootstrap",
 		Short:   "Initializes AI Services infrastructure",
 		Long:    bootstrapDescription(),
 		Example: bootstrapExample(),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && strings.ToLower(output) != "json" {
+				return fmt.Errorf("unsupported output format: %s, supported formats are: json", output)
+			}
+
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 
@@ -29,20 +45,41 @@ func BootstrapCmd() *cobra.Command {
 				return fmt.Errorf("failed to create bootstrap instance: %w", err)
 			}
 
-			if configureErr := bootstrapInstance.Configure(); configureErr != nil {
+			ctx, stop := interruptibleContext(cmd)
+			defer stop()
+
+			configureSummary, configureErr := bootstrapInstance.Configure(ctx)
+			if configureErr != nil {
 				return fmt.Errorf("failed to bootstrap the LPAR: %w", configureErr)
 			}
 
-			if err := factory.Validate(nil); err != nil {
+			validationSummary, err := factory.ValidateWithSummary(ctx, nil, false, false, bootstrap.DefaultRuleTimeout)
+			if strings.ToLower(output) == "json" {
+				// the summary is printed for a failed validation too, so that automation learns about the failed checks
+				summary := bootstrapTypes.BootstrapSummary{
+					Configure:       configureSummary,
+					Validate:        validationSummary,
+					ReloginRequired: reloginRequired(rt),
+				}
+				if err := printJSON(cmd, summary); err != nil {
+					return err
+				}
+			}
+			if err != nil {
 				return fmt.Errorf("failed to bootstrap the LPAR: %w", err)
 			}
+			factory.RecordValidation(version.GetVersion(), nil, false)
+
+			if strings.ToLower(output) == "json" {
+				return nil
+			}
 
 			if rt == types.RuntimeTypePodman {
 				logger.Infoln("LPAR bootstrapped successfully")
-				logger.Infoln("----------------------------------------------------------------------------")
-				style := lipgloss.NewStyle().Foreground(lipgloss.Color("#32BD27"))
-				message := style.Render("Re-login to the shell to reflect necessary permissions assigned to vfio cards")
-				logger.Infoln(message)
+				if reloginRequired(rt) {
+					logger.Infoln("----------------------------------------------------------------------------")
+					logger.Infoln(utils.Colorize("Re-login to the shell to reflect necessary permissions assigned to vfio cards", "#32BD27"))
+				}
 			}
 
 			return nil
@@ -50,8 +87,18 @@ func BootstrapCmd() *cobra.Command {
 	}
 
 	// subcommands
+	bootstrapCmd.Flags().StringVarP(&output, "output", "o", "",
+		"Output format for the summary of the configuration and the validation, along with whether a re-login is required (e.g., json)")
+
 	bootstrapCmd.AddCommand(validateCmd())
 	bootstrapCmd.AddCommand(configureCmd())
+	bootstrapCmd.AddCommand(rulesCmd())
+
+	bootstrapCmd.PersistentFlags().StringVar(&vars.MinRHELVersion, "min-rhel-version", vars.MinRHELVersion,
+		"Minimum RHEL version required by the rhel validation check(can also be set via AI_SERVICES_MIN_RHEL_VERSION env)")
+	bootstrapCmd.PersistentFlags().BoolVar(&vars.SkipSpyre, "skip-spyre", vars.SkipSpyre,
+		"Bootstrap a CPU-only LPAR: skip the spyre card configuration and only warn about missing spyre cards in validation.\n"+
+			"Note: Supported for podman runtime only (can also be set via AI_SERVICES_SKIP_SPYRE env)")
 
 	return bootstrapCmd
 }
@@ -63,6 +110,9 @@ func bootstrapExample() string {
   # Configure the infrastructure
   ai-services bootstrap configure
 
+  # Bootstrap and print a summary for automation, including whether a re-login is required
+  ai-services bootstrap -o json
+
   # Get help on a specific subcommand
   ai-services bootstrap validate --help`
 }
@@ -92,3 +142,14 @@ Validate - Checks below system prerequisites:
 - For Openshift:
 %s`, podmanList, openshiftList)
 }
+
+// reloginRequired reports whether the user has to log in again after the bootstrap, for the permissions
+// assigned to the vfio cards to apply to the shell.
+func reloginRequired(rt types.RuntimeType) bool {
+	return rt == types.RuntimeTypePodman && !vars.SkipSpyre
+}
+
+// interruptibleContext returns the context of the command, which is cancelled once the user interrupts it, e.g. with Ctrl-C.
+func interruptibleContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+}
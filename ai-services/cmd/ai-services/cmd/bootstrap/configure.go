@@ -1,27 +1,53 @@
 package bootstrap
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
 // configureCmd represents the validate subcommand of bootstrap.
 func configureCmd() *cobra.Command {
+	var (
+		output          string
+		check           bool
+		reloadModules   bool
+		noReloadModules bool
+	)
+
 	cmd := &cobra.Command{
-		Use:    "configure",
-		Short:  "Configures the LPAR environment",
-		Long:   `Configure and initialize the LPAR.`,
+		Use:   "configure",
+		Short: "Configures the LPAR environment",
+		Long: `Configure and initialize the LPAR.
+
+Use -o json to print a summary of the changes applied, which can be used to detect no-op runs.
+Use --check to print the plan of changes without executing any privileged command.`,
 		Hidden: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && strings.ToLower(output) != "json" {
+				return fmt.Errorf("unsupported output format: %s, supported formats are: json", output)
+			}
+
+			switch {
+			case reloadModules:
+				vars.ReloadModules = vars.ReloadModulesAlways
+			case noReloadModules:
+				vars.ReloadModules = vars.ReloadModulesNever
+			}
+
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Once precheck passes, silence usage for any *later* internal errors.
 			cmd.SilenceUsage = true
 
-			logger.Infoln("Running bootstrap configuration...")
-
 			// Create bootstrap instance based on runtime
 			factory := bootstrap.NewBootstrapFactory(vars.RuntimeFactory.GetRuntimeType())
 			bootstrapInstance, err := factory.Create()
@@ -29,15 +55,76 @@ func configureCmd() *cobra.Command {
 				return fmt.Errorf("failed to create bootstrap instance: %w", err)
 			}
 
-			if err := bootstrapInstance.Configure(); err != nil {
+			ctx, stop := interruptibleContext(cmd)
+			defer stop()
+
+			if check {
+				return runConfigureCheck(ctx, cmd, bootstrapInstance, output)
+			}
+
+			logger.Infoln("Running bootstrap configuration...")
+
+			summary, err := bootstrapInstance.Configure(ctx)
+			if err != nil {
 				return fmt.Errorf("bootstrap configuration failed: %w", err)
 			}
 
 			logger.Infof("Bootstrap configuration completed successfully.")
 
+			if strings.ToLower(output) == "json" {
+				return printJSON(cmd, summary)
+			}
+
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format for the summary of applied changes (e.g., json)")
+	cmd.Flags().BoolVar(&check, "check", false, "Print the changes configure would apply, without applying them")
+	cmd.Flags().BoolVar(&check, "dry-run", false, "Alias for --check")
+	cmd.Flags().BoolVar(&reloadModules, "reload-modules", false,
+		"Always reload the vfio kernel modules, instead of only if not all spyre cards are bound to vfio-pci")
+	cmd.Flags().BoolVar(&noReloadModules, "no-reload-modules", false,
+		"Never reload the vfio kernel modules, eg:- when the state of the cards is known to be right")
+	cmd.MarkFlagsMutuallyExclusive("reload-modules", "no-reload-modules")
+
 	return cmd
 }
+
+func runConfigureCheck(ctx context.Context, cmd *cobra.Command, bootstrapInstance bootstrap.Bootstrap, output string) error {
+	logger.Infoln("Evaluating bootstrap configuration...")
+
+	plan, err := bootstrapInstance.Check(ctx)
+	if err != nil {
+		return fmt.Errorf("bootstrap configuration check failed: %w", err)
+	}
+
+	if strings.ToLower(output) == "json" {
+		if err := printJSON(cmd, plan); err != nil {
+			return err
+		}
+	} else {
+		printer := utils.NewTableWriter()
+		printer.SetHeaders("STEP", "ACTION", "DETAIL")
+		for _, step := range plan.Steps {
+			printer.AppendRow(step.Name, string(step.Action), step.Detail)
+		}
+		printer.CloseTableWriter()
+	}
+
+	if undetermined := plan.Undetermined(); len(undetermined) > 0 {
+		return fmt.Errorf("unable to determine the changes required for %d configure step(s)", len(undetermined))
+	}
+
+	return nil
+}
+
+func printJSON(cmd *cobra.Command, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal output: %w", err)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/spf13/cobra"
+)
+
+// ruleInfo is the serializable view of a registered validation rule.
+type ruleInfo struct {
+	Runtime     string `json:"runtime"`
+	Name        string `json:"name"`
+	Level       string `json:"level"`
+	Description string `json:"description"`
+	Message     string `json:"message"`
+	Hint        string `json:"hint"`
+}
+
+// rulesCmd represents the rules subcommand of bootstrap.
+func rulesCmd() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "rules",
+		Short: "Lists the registered validation rules",
+		Long: `Lists all the validation rules registered for each runtime along with their level, message and hint.
+The rule names listed here can be passed to the --skip-validation flag.`,
+		Example: `  # List the validation rules
+  ai-services bootstrap rules
+
+  # Dump the validation rules as JSON
+  ai-services bootstrap rules -o json`,
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && strings.ToLower(output) != "json" {
+				return fmt.Errorf("unsupported output format: %s, supported formats are: json", output)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			rules := collectRuleInfo()
+
+			if strings.ToLower(output) == "json" {
+				return printJSON(cmd, rules)
+			}
+
+			printer := utils.NewTableWriter()
+			defer printer.CloseTableWriter()
+
+			printer.SetHeaders("RUNTIME", "NAME", "LEVEL", "DESCRIPTION")
+			for _, rule := range rules {
+				printer.AppendRow(rule.Runtime, rule.Name, rule.Level, rule.Description)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format (e.g., json)")
+
+	return cmd
+}
+
+// collectRuleInfo returns the rules of all the registries in their registration order.
+func collectRuleInfo() []ruleInfo {
+	registries := []struct {
+		runtime  types.RuntimeType
+		registry *validators.ValidationRegistry
+	}{
+		{types.RuntimeTypePodman, validators.PodmanRegistry},
+		{types.RuntimeTypeOpenShift, validators.OpenshiftRegistry},
+	}
+
+	rules := []ruleInfo{}
+	for _, r := range registries {
+		for _, rule := range r.registry.Rules() {
+			rules = append(rules, ruleInfo{
+				Runtime:     r.runtime.String(),
+				Name:        rule.Name(),
+				Level:       rule.Level().String(),
+				Description: rule.Description(),
+				Message:     rule.Message(),
+				Hint:        rule.Hint(),
+			})
+		}
+	}
+
+	return rules
+}
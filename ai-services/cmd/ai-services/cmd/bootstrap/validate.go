@@ -3,7 +3,9 @@ package bootstrap
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
@@ -26,7 +28,13 @@ const troubleshootingGuide = "https://www.ibm.com/docs/aiservices?topic=services
 
 // validateCmd represents the validate subcommand of bootstrap.
 func validateCmd() *cobra.Command {
-	var skipChecks []string
+	var (
+		skipChecks  []string
+		sequential  bool
+		strict      bool
+		ruleTimeout time.Duration
+		output      string
+	)
 
 	cmd := &cobra.Command{
 		Use:     "validate",
@@ -34,6 +42,13 @@ func validateCmd() *cobra.Command {
 		Long:    validateDescription(),
 		Example: validateExample(),
 		Hidden:  true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && strings.ToLower(output) != "json" {
+				return fmt.Errorf("unsupported output format: %s, supported formats are: json", output)
+			}
+
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Once precheck passes, silence usage for any *later* internal errors.
 			cmd.SilenceUsage = true
@@ -45,12 +60,24 @@ func validateCmd() *cobra.Command {
 				logger.Warningln("Skipping validation checks: " + strings.Join(skipChecks, ", "))
 			}
 
+			ctx, stop := interruptibleContext(cmd)
+			defer stop()
+
 			factory := bootstrap.NewBootstrapFactory(vars.RuntimeFactory.GetRuntimeType())
-			if err := factory.Validate(skip); err != nil {
+			summary, err := factory.ValidateWithSummary(ctx, skip, sequential, strict, ruleTimeout)
+			// the summary is printed for a failed validation too, so that automation learns about the failed checks
+			if strings.ToLower(output) == "json" && summary != nil {
+				if err := printJSON(cmd, summary); err != nil {
+					return err
+				}
+			}
+			if err != nil {
 				logger.Infof("Please refer to troubleshooting guide for more information: %s", troubleshootingGuide)
 
 				return fmt.Errorf("bootstrap validation failed: %w", err)
 			}
+			// the following creates reuse the successful validation of the unchanged host
+			factory.RecordValidation(version.GetVersion(), skip, strict)
 
 			return nil
 		},
@@ -58,6 +85,14 @@ func validateCmd() *cobra.Command {
 
 	skipCheckDesc := BuildSkipFlagDescription()
 	cmd.Flags().StringSliceVar(&skipChecks, "skip-validation", []string{}, skipCheckDesc)
+	cmd.Flags().BoolVar(&sequential, "sequential", false,
+		"Run the validation checks one after the other instead of in parallel, eg:- to troubleshoot a check")
+	cmd.Flags().BoolVar(&strict, "strict", false,
+		"Treat the warnings as errors, eg:- to enforce the best practices such as the numa alignment in production")
+	cmd.Flags().DurationVar(&ruleTimeout, "rule-timeout", bootstrap.DefaultRuleTimeout,
+		"Maximum time a single validation check may take, eg:- 30s. A check exceeding it is reported as failed,\n"+
+			"or as a warning for the warning level checks. 0 means no limit")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format for the summary of the validation (e.g., json)")
 
 	return cmd
 }
@@ -85,6 +120,12 @@ func validateExample() string {
   # Skip multiple checks
   ai-services bootstrap validate --skip-validation rhn,power
   
+  # Run the checks one after the other
+  ai-services bootstrap validate --sequential
+
+  # Fail on the warnings as well
+  ai-services bootstrap validate --strict
+
   # Run with verbose output
   ai-services bootstrap validate --verbose`
 }
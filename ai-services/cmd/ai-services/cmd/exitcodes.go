@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+)
+
+// Exit codes of the CLI, so that scripts and CI can branch on the category of the failure.
+const (
+	exitOK               = 0
+	exitFailure          = 1
+	exitValidationFailed = 2
+	exitRuntimeError     = 3
+	exitReadinessTimeout = 4
+	exitInsufficientCard = 5
+)
+
+// exitCodesHelp documents the exit codes in the help of the root command.
+const exitCodesHelp = `
+Exit codes:
+  0  success
+  1  general failure, including the expiry of --timeout
+  2  bootstrap validation failed
+  3  container runtime unreachable
+  4  pods or containers not ready in time
+  5  insufficient Spyre cards`
+
+// exitCode maps the error returned by a command to the exit code of the CLI.
+// The categories are checked from the most to the least specific, as an error can wrap several of them.
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	var (
+		validationErr *errdefs.ValidationError
+		spyreErr      *errdefs.SpyreError
+		runtimeErr    *errdefs.RuntimeError
+	)
+
+	switch {
+	case errors.As(err, &validationErr):
+		return exitValidationFailed
+	case errors.As(err, &spyreErr):
+		return exitInsufficientCard
+	case errors.Is(err, errdefs.ErrReadinessTimeout):
+		return exitReadinessTimeout
+	case errors.As(err, &runtimeErr) && runtimeErr.Op == errdefs.OpConnect:
+		return exitRuntimeError
+	default:
+		return exitFailure
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestExitCode(t *testing.T) {
+	// a container which never turns healthy, so that the readiness wait times out
+	r := fake.New()
+	r.AddPod(types.Pod{ID: "app--vllm-id", Name: "app--vllm"}, types.Container{ID: "vllm", Name: "app--vllm-server", Status: "running", Health: "starting"})
+	readinessErr := helpers.WaitForContainerReadiness(context.Background(), r, "app--vllm-server", 10*time.Millisecond, -1)
+
+	// a socket which does not exist, connected to once rather than retried until the socket timeout
+	t.Setenv("CONTAINER_HOST", "unix:///nonexistent/podman.sock")
+	socketTimeout := podman.SocketTimeout
+	podman.SocketTimeout = 0
+	t.Cleanup(func() { podman.SocketTimeout = socketTimeout })
+	_, connectErr := runtime.CreateRuntime(types.RuntimeTypePodman, "")
+
+	validationErr := &errdefs.ValidationError{Rule: "spyre", Err: errors.New("no Spyre cards found")}
+	spyreErr := &errdefs.SpyreError{Required: 4, Available: 2, Err: errors.New("insufficient Spyre cards")}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "success", want: exitOK},
+		{name: "general failure", err: errors.New("failed to parse the templates"), want: exitFailure},
+		{
+			name: "validation failed",
+			err:  fmt.Errorf("bootstrap failed: %w", &errdefs.ValidationFailures{Failures: []*errdefs.ValidationError{validationErr}}),
+			want: exitValidationFailed,
+		},
+		{name: "validation of the Spyre cards failed", err: errors.Join(validationErr, spyreErr), want: exitValidationFailed},
+		{name: "runtime unreachable", err: fmt.Errorf("stop failed: %w", connectErr), want: exitRuntimeError},
+		{name: "runtime operation failed", err: &errdefs.RuntimeError{Op: "deploy", Pod: "app--vllm", Err: errors.New("image not known")}, want: exitFailure},
+		{name: "readiness timeout", err: fmt.Errorf("wait failed: %w", readinessErr), want: exitReadinessTimeout},
+		{name: "pod readiness timeout", err: &errdefs.RuntimeError{Op: "deploy", Pod: "app--vllm", Err: readinessErr}, want: exitReadinessTimeout},
+		{name: "insufficient Spyre cards", err: fmt.Errorf("create failed: %w", spyreErr), want: exitInsufficientCard},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/registry"
+)
+
+// loginCmd represents the login subcommand of registry.
+func loginCmd() *cobra.Command {
+	var (
+		username      string
+		passwordStdin bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "login <registry>",
+		Short: "Login to a container registry",
+		Long: `Logs into the given container registry using podman, so that the application images can be pulled.
+
+The password is never accepted as an argument, as it can leak via the process list.
+It is either read from stdin using --password-stdin or prompted interactively.`,
+		Example: `  # Interactive login
+  ai-services registry login icr.io --username iamapikey
+
+  # Non-interactive (CI): read the password from stdin
+  printf '%s\n' "$API_KEY" | ai-services registry login icr.io --username iamapikey --password-stdin`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			password, err := readPassword(cmd, passwordStdin)
+			if err != nil {
+				return err
+			}
+
+			if err := registry.Login(args[0], username, password); err != nil {
+				return err
+			}
+
+			logger.Infof("Login succeeded for registry: %s\n", args[0])
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&username, "username", "u", "", "Username for the registry (Required)")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from stdin")
+	_ = cmd.MarkFlagRequired("username")
+
+	return cmd
+}
+
+func readPassword(cmd *cobra.Command, fromStdin bool) (string, error) {
+	var password string
+	if fromStdin {
+		b, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("read stdin: %w", err)
+		}
+		password = strings.TrimSpace(string(b))
+	} else {
+		fmt.Fprint(os.Stderr, "Password: ")
+		b, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("read password: %w", err)
+		}
+		password = strings.TrimSpace(string(b))
+	}
+
+	if password == "" {
+		return "", errors.New("empty password")
+	}
+
+	return password, nil
+}
@@ -0,0 +1,21 @@
+package registry
+
+import "github.com/spf13/cobra"
+
+// RegistryCmd returns the cobra command for managing the container registry logins.
+func RegistryCmd() *cobra.Command {
+	registryCmd := &cobra.Command{
+		Use:   "registry",
+		Short: "Manage container registry logins",
+		Long: `The registry command helps you login to the container registries hosting the application images
+and check the status of the existing logins.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	registryCmd.AddCommand(loginCmd())
+	registryCmd.AddCommand(statusCmd())
+
+	return registryCmd
+}
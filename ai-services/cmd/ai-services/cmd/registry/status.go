@@ -0,0 +1,62 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/registry"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// statusCmd represents the status subcommand of registry.
+func statusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [registry...]",
+		Short: "Shows the registries with valid logins",
+		Long: `Shows the login status for the given registries.
+If no registry is provided, all the registries having credentials stored in the podman auth files are shown.`,
+		Example: `  # Show all the registry logins
+  ai-services registry status
+
+  # Show the login status of a specific registry
+  ai-services registry status icr.io`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			registries := args
+			if len(registries) == 0 {
+				var err error
+				registries, err = registry.AuthenticatedRegistries()
+				if err != nil {
+					return fmt.Errorf("failed to list registry logins: %w", err)
+				}
+			}
+
+			if len(registries) == 0 {
+				logger.Infoln("No registry logins found")
+
+				return nil
+			}
+
+			printer := utils.NewTableWriter()
+			defer printer.CloseTableWriter()
+
+			printer.SetHeaders("REGISTRY", "STATUS", "USERNAME")
+			for _, r := range registries {
+				user, err := registry.LoginUser(r)
+				if err != nil {
+					printer.AppendRow(r, "not logged in", "-")
+
+					continue
+				}
+				printer.AppendRow(r, "logged in", user)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
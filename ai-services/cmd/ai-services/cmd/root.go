@@ -1,34 +1,99 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/registry"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/update"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 var (
 	// Global runtime type flag.
 	runtimeType string
+	// Global log format flag.
+	logFormat string
+	// Global log file flags.
+	logFile          string
+	logFileMaxSizeMB int
+	// Global no color flag.
+	noColor bool
+	// Global ascii markers flag.
+	asciiOutput bool
+	// Global metrics address flag.
+	metricsAddr string
+	// Global working directory flag.
+	workDir string
+	// commandCtx carries the deadline of the global command-timeout flag.
+	commandCtx    context.Context
+	cancelCommand context.CancelFunc = func() {}
 )
 
+// commandTimeoutGracePeriod is the time given to the command to wind down after the timeout before it is forcefully exited.
+const commandTimeoutGracePeriod = 10 * time.Second
+
 // RootCmd represents the base command when called without any subcommands.
 var RootCmd = &cobra.Command{
 	Use:     "ai-services",
 	Short:   "AI Services CLI",
-	Long:    `A CLI tool for managing AI Services infrastructure.`,
+	Long:    `A CLI tool for managing AI Services infrastructure.` + "\n" + exitCodesHelp,
 	Version: version.GetVersion(),
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
+
+		// change the directory first, so that all the relative paths given to the command resolve against it
+		if workDir != "" {
+			if err := os.Chdir(workDir); err != nil {
+				return fmt.Errorf("failed to change to the working directory: %w", err)
+			}
+		}
+
+		if err := logger.SetFormat(logFormat); err != nil {
+			return err
+		}
+
+		if noColor {
+			utils.DisableColor()
+		}
+
+		if asciiOutput {
+			spinner.UseASCIIMarkers()
+		}
+
+		if logFile != "" {
+			if err := logger.SetLogFile(logFile, logFileMaxSizeMB); err != nil {
+				return fmt.Errorf("failed to set log file: %w", err)
+			}
+		}
+
+		if metricsAddr != "" {
+			if err := metrics.Start(metricsAddr); err != nil {
+				return err
+			}
+		}
+
+		if vars.CommandTimeout > 0 {
+			startCommandTimeout(cmd)
+		}
+
 		// Ensures logs flush after each command run
 		logger.Infoln("Logger initialized (PersistentPreRun)", logger.VerbosityLevelDebug)
 
@@ -38,6 +103,10 @@ var RootCmd = &cobra.Command{
 			return fmt.Errorf("invalid runtime type: %s (must be 'podman' or 'openshift')", runtimeType)
 		}
 
+		if err := helpers.ValidateNamespace(vars.Namespace); err != nil {
+			return err
+		}
+
 		vars.RuntimeFactory = runtime.NewRuntimeFactory(rt)
 		logger.Infof("Using runtime: %s\n", rt, logger.VerbosityLevelDebug)
 
@@ -49,12 +118,43 @@ var RootCmd = &cobra.Command{
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	defer logger.Flush()
+	defer metrics.Stop()
+	defer cancelCommand()
 	err := RootCmd.Execute()
+	if commandTimedOut() {
+		exitTimedOut()
+	}
 	if err != nil {
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 }
 
+// startCommandTimeout bounds the command by the global timeout. The deadline is propagated to the runtime clients
+// and downloads through the command context, and a command which does not wind down in time is forcefully exited.
+func startCommandTimeout(cmd *cobra.Command) {
+	commandCtx, cancelCommand = context.WithTimeout(cmd.Context(), vars.CommandTimeout)
+	cmd.SetContext(commandCtx)
+	runtime.SetContext(commandCtx)
+
+	context.AfterFunc(commandCtx, func() {
+		if !commandTimedOut() {
+			return
+		}
+		time.Sleep(commandTimeoutGracePeriod)
+		exitTimedOut()
+	})
+}
+
+func commandTimedOut() bool {
+	return commandCtx != nil && errors.Is(commandCtx.Err(), context.DeadlineExceeded)
+}
+
+func exitTimedOut() {
+	logger.Errorf("command timed out after %s\n", vars.CommandTimeout)
+	logger.Flush()
+	os.Exit(exitFailure)
+}
+
 func init() {
 	logger.Init()
 	RootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
@@ -67,8 +167,58 @@ func init() {
 		fmt.Sprintf("Container runtime to use (options: %s, %s).", types.RuntimeTypePodman, types.RuntimeTypeOpenShift),
 	)
 
+	RootCmd.PersistentFlags().StringVar(
+		&logFormat,
+		"log-format",
+		string(logger.FormatText),
+		fmt.Sprintf("Format of the log records written to stderr (options: %s, %s).", logger.FormatText, logger.FormatJSON),
+	)
+
+	RootCmd.PersistentFlags().StringVar(
+		&logFile,
+		"log-file",
+		logger.DefaultLogFile(),
+		fmt.Sprintf("Path of the file to which the logs are written in addition to the console.\n"+
+			"Defaults to '%s' under the directory set via %s env, if set.", logger.DefaultLogFileName, logger.EnvLogDir),
+	)
+	RootCmd.PersistentFlags().IntVar(
+		&logFileMaxSizeMB,
+		"log-file-max-size",
+		logger.DefaultLogFileMaxSizeMB,
+		"Size in MB after which the log file is rotated.",
+	)
+
+	RootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false,
+		fmt.Sprintf("Disable the colored output. Color is also disabled when the output is not a terminal or %s env is set.", utils.EnvNoColor))
+	RootCmd.PersistentFlags().BoolVar(&asciiOutput, "ascii", false,
+		"Use plain [OK]/[FAIL]/[WARN] markers instead of the spinners and the ✔/✖ glyphs, for terminals or screen readers not rendering them.")
+
+	RootCmd.PersistentFlags().DurationVar(&vars.CommandTimeout, "command-timeout", 0,
+		"Overall timeout for the command (e.g. 30m, 1h), on expiry the command is cancelled and exits with a non-zero status.\n"+
+			"Unlike the --timeout of the individual commands, it bounds the whole run including the validation and downloads.\n"+
+			"Defaults to no timeout.")
+
+	RootCmd.PersistentFlags().DurationVar(&podman.SocketTimeout, "socket-timeout", podman.DefaultSocketTimeout,
+		"Time allowed to connect to the podman socket, including the retries while it is starting up (0 means no timeout).")
+
+	RootCmd.PersistentFlags().StringVarP(&workDir, "workdir", "C", "",
+		"Run as if the CLI was started in the given directory instead of the current one, the relative paths like the values\n"+
+			"files, --from-file and --output-dir are resolved against it. Also available as --chdir.")
+	// --chdir is an alias of --workdir
+	RootCmd.PersistentFlags().StringVar(&workDir, "chdir", "", "Alias of --workdir.")
+	_ = RootCmd.PersistentFlags().MarkHidden("chdir")
+
+	RootCmd.PersistentFlags().StringVar(&metricsAddr, "metrics-addr", "",
+		"Expose Prometheus-style metrics on the given address (e.g. :9090) at /metrics while the command runs. Disabled by default.")
+
+	RootCmd.PersistentFlags().BoolVar(&update.CheckUpdates, "check-updates", update.CheckUpdates,
+		fmt.Sprintf("Check whether a newer release is available on version and create (can also be enabled via %s env).", update.EnvCheckUpdates))
+	RootCmd.PersistentFlags().BoolVar(&update.Offline, "offline", update.Offline,
+		fmt.Sprintf("Disable all the optional network calls like the update check, for air-gapped hosts (can also be set via %s env).", update.EnvOffline))
+
 	RootCmd.AddCommand(version.VersionCmd)
 	RootCmd.AddCommand(bootstrap.BootstrapCmd())
 	RootCmd.AddCommand(application.ApplicationCmd)
+	RootCmd.AddCommand(registry.RegistryCmd())
 	// catalog.CatalogCmd() is registered in catalog_enabled.go when catalog_api build tag is set
 }
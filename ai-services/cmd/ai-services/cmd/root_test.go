@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestWorkDir asserts that the command runs in the directory given via --workdir, or its --chdir alias, so that the
+// relative paths given to it resolve against that directory, and in the current directory otherwise.
+func TestWorkDir(t *testing.T) {
+	base, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	project := filepath.Join(base, "project")
+	if err := os.Mkdir(project, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr string
+	}{
+		{name: "unset", want: base},
+		{name: "workdir", args: []string{"--workdir", project}, want: project},
+		{name: "shorthand", args: []string{"-C", project}, want: project},
+		{name: "chdir alias", args: []string{"--chdir", project}, want: project},
+		{name: "relative", args: []string{"-C", "project"}, want: project},
+		{name: "missing directory", args: []string{"-C", "missing"}, wantErr: "failed to change to the working directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Chdir(base)
+			factory := vars.RuntimeFactory
+			t.Cleanup(func() {
+				vars.RuntimeFactory, workDir = factory, ""
+				for _, name := range []string{"workdir", "chdir"} {
+					RootCmd.PersistentFlags().Lookup(name).Changed = false
+				}
+			})
+
+			if err := RootCmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("ParseFlags() error = %v", err)
+			}
+
+			err := RootCmd.PersistentPreRunE(RootCmd, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("PersistentPreRunE() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("PersistentPreRunE() error = %v", err)
+			}
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cwd != tt.want {
+				t.Errorf("working directory = %s, want %s", cwd, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package version
 
 import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/update"
 	"github.com/spf13/cobra"
 )
 
@@ -11,14 +17,70 @@ var (
 	BuildDate string = ""
 )
 
+var output string
+
+// Info holds the build metadata of the CLI.
+type Info struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	BuildDate string `json:"buildDate"`
+	GoVersion string `json:"goVersion"`
+	Platform  string `json:"platform"`
+}
+
 func GetVersion() string {
 	return Version
 }
 
+// GetInfo returns the build metadata of the CLI.
+func GetInfo() Info {
+	return Info{
+		Version:   Version,
+		GitCommit: GitCommit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+}
+
 var VersionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Prints CLI version with more info",
-	Run: func(cmd *cobra.Command, args []string) {
-		logger.Infof("Version: %s\nGitCommit: %s\nBuildDate: %s\n", Version, GitCommit, BuildDate)
+	Example: `  # Print the version
+  ai-services version
+
+  # Print the version along with the build metadata as JSON
+  ai-services version -o json`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if output != "" && strings.ToLower(output) != "json" {
+			return fmt.Errorf("unsupported output format: %s, supported formats are: json", output)
+		}
+
+		return nil
 	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		notifyUpdate := update.CheckInBackground(Version)
+		defer notifyUpdate()
+
+		info := GetInfo()
+
+		if strings.ToLower(output) == "json" {
+			data, err := json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal version info: %w", err)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+			return err
+		}
+
+		logger.Infof("Version: %s\nGitCommit: %s\nBuildDate: %s\nGoVersion: %s\nPlatform: %s\n",
+			info.Version, info.GitCommit, info.BuildDate, info.GoVersion, info.Platform)
+
+		return nil
+	},
+}
+
+func init() {
+	VersionCmd.Flags().StringVarP(&output, "output", "o", "", "Output format (e.g., json)")
 }
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// addAppPod adds a pod of the application with an infra container and the given containers to the fake runtime.
+func addAppPod(r *fake.Runtime, app, name string, containers ...types.Container) {
+	infra := types.Container{ID: name + "-infra", Name: name + "-infra", Status: "running", RestartCount: 100}
+	r.AddPod(types.Pod{
+		ID:               name + "-id",
+		Name:             name,
+		Status:           "Running",
+		Labels:           map[string]string{constants.ApplicationAnnotationKey: app},
+		InfraContainerID: infra.ID,
+	}, append([]types.Container{infra}, containers...)...)
+}
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// Describe collects the info, pods and the recent events of the application.
+func Describe(r runtime.Runtime, opts appTypes.DescribeOptions) (*appTypes.ApplicationDescription, error) {
+	pods, err := helpers.ListApplicationPods(r, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("application: '%s' does not exist", opts.Name)
+	}
+
+	desc := &appTypes.ApplicationDescription{
+		Name:     opts.Name,
+		Template: pods[0].Labels[string(vars.TemplateLabel)],
+		Version:  pods[0].Labels[string(vars.VersionLabel)],
+		Pods:     make([]appTypes.PodListEntry, 0, len(pods)),
+	}
+
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Name)
+
+		entry, ok := fetchPodEntry(r, pod, true)
+		if !ok {
+			continue
+		}
+		desc.Pods = append(desc.Pods, entry)
+	}
+
+	// info is best effort, do not fail the describe if it cannot be rendered
+	desc.Info, err = helpers.RenderInfo(r, opts.Name, desc.Template)
+	if err != nil {
+		logger.Infof("failed to render info: %v\n", err, logger.VerbosityLevelDebug)
+	}
+
+	events, err := r.PodEvents(podNames, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	// keep only the most recent events
+	if opts.Events >= 0 && len(events) > opts.Events {
+		events = events[len(events)-opts.Events:]
+	}
+	desc.Events = events
+
+	return desc, nil
+}
+
+// PrintDescription prints the application description as a human readable report.
+func PrintDescription(desc *appTypes.ApplicationDescription) {
+	logger.Infoln("Application Name: " + desc.Name)
+	logger.Infoln("Application Template: " + desc.Template)
+	logger.Infoln("Version: " + desc.Version)
+	logger.Infoln("-------")
+
+	logger.Infoln("Pods:")
+	printer := utils.NewTableWriter()
+	setTableHeaders(printer, true)
+	for _, entry := range desc.Pods {
+		printer.AppendRow(buildPodRow(entry, true, false)...)
+	}
+	printer.CloseTableWriter()
+	logger.Infoln("-------")
+
+	if desc.Info != "" {
+		logger.Infoln("Info:")
+		logger.Infoln(desc.Info)
+		logger.Infoln("-------")
+	}
+
+	if len(desc.Events) == 0 {
+		logger.Infoln("Recent Events: none")
+
+		return
+	}
+
+	logger.Infoln("Recent Events:")
+	printer = utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("TIME", "TYPE", "ACTION", "OBJECT", "MESSAGE")
+	for _, e := range desc.Events {
+		printer.AppendRow(e.Time.Format(time.RFC3339), e.Type, e.Action, e.Object, e.Message)
+	}
+}
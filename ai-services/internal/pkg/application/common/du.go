@@ -0,0 +1,134 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// DiskUsage returns the disk space consumed by the application: the images of its template present on the host,
+// its host directories and the model files it references. The models are shared by all the applications.
+func DiskUsage(r runtime.Runtime, appName string) (*appTypes.DiskUsage, error) {
+	pods, err := helpers.ListApplicationPods(r, appName)
+	if err != nil {
+		return nil, err
+	}
+
+	appDir := vars.ApplicationDir(appName)
+	if _, err := os.Stat(appDir); len(pods) == 0 && os.IsNotExist(err) {
+		return nil, fmt.Errorf("application '%s' does not exist", appName)
+	}
+
+	usage := &appTypes.DiskUsage{
+		Application: appName,
+		Images:      []appTypes.DiskUsageEntry{},
+		HostPaths:   []appTypes.DiskUsageEntry{},
+		Models:      []appTypes.DiskUsageEntry{},
+	}
+
+	if templateName := applicationTemplate(pods); templateName != "" {
+		if err := addImageUsage(r, usage, templateName, appName); err != nil {
+			return nil, err
+		}
+		if err := addModelUsage(usage, templateName, appName); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := addHostPathUsage(usage, appDir); err != nil {
+		return nil, err
+	}
+
+	for _, entries := range [][]appTypes.DiskUsageEntry{usage.Images, usage.HostPaths, usage.Models} {
+		for _, entry := range entries {
+			usage.Total += entry.Size
+			if !entry.Shared {
+				usage.Exclusive += entry.Size
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// applicationTemplate returns the template the application was deployed from, empty if unknown.
+func applicationTemplate(pods []types.Pod) string {
+	for _, pod := range pods {
+		if name := pod.Labels[string(vars.TemplateLabel)]; name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func addImageUsage(r runtime.Runtime, usage *appTypes.DiskUsage, templateName, appName string) error {
+	images, err := image.ListImages(templateName, appName)
+	if err != nil {
+		return fmt.Errorf("failed to list images: %w", err)
+	}
+
+	details, err := image.DescribeImages(r, images)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range details {
+		if !d.Present {
+			continue
+		}
+		// the images not run by any container, like the tool image, are counted as shared as well
+		shared := !slices.Equal(d.UsedBy, []string{appName})
+		usage.Images = append(usage.Images, appTypes.DiskUsageEntry{Name: d.Image, Size: d.Size, Shared: shared})
+	}
+
+	return nil
+}
+
+func addModelUsage(usage *appTypes.DiskUsage, templateName, appName string) error {
+	models, err := helpers.ListModels(templateName, appName)
+	if err != nil {
+		return fmt.Errorf("failed to list models: %w", err)
+	}
+	slices.Sort(models)
+
+	for _, model := range slices.Compact(models) {
+		size, err := utils.DirSize(filepath.Join(vars.ModelDirectory, model))
+		if err != nil {
+			return fmt.Errorf("failed to compute the size of model %s: %w", model, err)
+		}
+		usage.Models = append(usage.Models, appTypes.DiskUsageEntry{Name: model, Size: size, Shared: true})
+	}
+
+	return nil
+}
+
+func addHostPathUsage(usage *appTypes.DiskUsage, appDir string) error {
+	entries, err := os.ReadDir(appDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the application directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(appDir, entry.Name())
+		size, err := utils.DirSize(path)
+		if err != nil {
+			return fmt.Errorf("failed to compute the size of %s: %w", path, err)
+		}
+		usage.HostPaths = append(usage.HostPaths, appTypes.DiskUsageEntry{Name: path, Size: size})
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// Events writes the events of the application pods to w, oldest first. With opts.Follow the new events are written
+// as they occur until ctx is done, the pods created after the events are requested are not followed.
+func Events(ctx context.Context, r runtime.Runtime, opts appTypes.EventsOptions, w io.Writer) error {
+	pods, err := helpers.ListApplicationPods(r, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		return fmt.Errorf("application: '%s' does not exist", opts.Name)
+	}
+
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Name)
+	}
+
+	events, err := r.PodEvents(podNames, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		writeEvent(w, e)
+	}
+
+	if !opts.Follow {
+		return nil
+	}
+
+	return r.StreamEvents(ctx, map[string][]string{"pod": podNames}, func(e runtimeTypes.Event) {
+		writeEvent(w, e)
+	})
+}
+
+// writeEvent writes the event as a single line, so that the followed events can be piped to other tools.
+func writeEvent(w io.Writer, e runtimeTypes.Event) {
+	fields := []string{e.Time.Format(time.RFC3339), e.Type, e.Action, e.Object}
+	if e.Message != "" {
+		fields = append(fields, e.Message)
+	}
+	_, _ = fmt.Fprintln(w, strings.Join(fields, " "))
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// TestEvents asserts that only the events of the pods labelled with the application are written, the past ones
+// first and then, with follow, the new ones streamed by the runtime.
+func TestEvents(t *testing.T) {
+	at := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	event := func(pod, action string, offset time.Duration) fake.PodEvent {
+		return fake.PodEvent{Pod: pod, Event: types.Event{Time: at.Add(offset), Type: "pod", Action: action, Object: pod}}
+	}
+
+	tests := []struct {
+		name   string
+		follow bool
+		want   []string
+	}{
+		{
+			name: "past events",
+			want: []string{
+				"2026-10-17T12:00:00Z pod create app--vllm",
+				"2026-10-17T12:00:01Z pod start app--ui",
+			},
+		},
+		{
+			name:   "follow",
+			follow: true,
+			want: []string{
+				"2026-10-17T12:00:00Z pod create app--vllm",
+				"2026-10-17T12:00:01Z pod start app--ui",
+				"2026-10-17T12:01:00Z pod died app--vllm",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addAppPod(r, "app", "app--vllm", types.Container{ID: "vllm-c", Status: "running"})
+			addAppPod(r, "app", "app--ui", types.Container{ID: "ui-c", Status: "running"})
+			addAppPod(r, "other", "other--vllm", types.Container{ID: "other-c", Status: "running"})
+			r.Events = []fake.PodEvent{
+				event("app--vllm", "create", 0),
+				event("other--vllm", "create", 0),
+				event("app--ui", "start", time.Second),
+			}
+			r.StreamedEvents = []fake.PodEvent{
+				event("other--vllm", "died", time.Minute),
+				event("app--vllm", "died", time.Minute),
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			var out bytes.Buffer
+			if err := Events(ctx, r, appTypes.EventsOptions{Name: "app", Follow: tt.follow}, &out); err != nil {
+				t.Fatalf("Events() error = %v", err)
+			}
+
+			if got, want := strings.TrimSpace(out.String()), strings.Join(tt.want, "\n"); got != want {
+				t.Errorf("Events() wrote\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+
+	if err := Events(context.Background(), fake.New(), appTypes.EventsOptions{Name: "app"}, &bytes.Buffer{}); err == nil {
+		t.Errorf("Events() error = nil, want the missing application reported")
+	}
+}
@@ -0,0 +1,104 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// PrintFormattedInfo renders the application info built from its pods using the format template.
+func PrintFormattedInfo(r runtime.Runtime, opts appTypes.InfoOptions, pods []types.Pod) error {
+	info := appTypes.ApplicationInfo{
+		Name:     opts.Name,
+		Template: pods[0].Labels[string(vars.TemplateLabel)],
+		Version:  pods[0].Labels[string(vars.VersionLabel)],
+		Labels:   CustomLabels(pods[0].Labels),
+		Pods:     make([]appTypes.PodInfo, 0, len(pods)),
+	}
+
+	for _, pod := range pods {
+		podInfo := appTypes.PodInfo{
+			Name:       pod.Name,
+			ID:         pod.ID,
+			Status:     pod.Status,
+			Restarts:   GetPodRestarts(r, &pod),
+			Containers: make([]appTypes.ContainerInfo, 0, len(pod.Containers)),
+		}
+
+		for _, container := range pod.Containers {
+			containerInfo := appTypes.ContainerInfo{
+				Name:   container.Name,
+				ID:     container.ID,
+				Status: container.Status,
+			}
+			if count, err := r.ContainerRestartCount(container.ID); err == nil {
+				containerInfo.RestartCount = count
+			}
+			podInfo.Containers = append(podInfo.Containers, containerInfo)
+		}
+
+		info.Pods = append(info.Pods, podInfo)
+	}
+
+	// not failing the info if the URLs cannot be resolved, the services are only left out
+	services, err := helpers.ResolvePrimaryURLs(r, opts.Name, info.Template)
+	if err != nil {
+		logger.Infof("failed to resolve the service URLs: %v\n", err, logger.VerbosityLevelDebug)
+	}
+	info.Services = services
+
+	if r.Type() == types.RuntimeTypePodman {
+		if info.HostIP, err = helpers.DetectHostIP(); err != nil {
+			logger.Infof("unable to fetch the host IP: %v\n", err, logger.VerbosityLevelDebug)
+		}
+	}
+
+	return utils.ExecuteFormat(os.Stdout, opts.Format, info)
+}
+
+// PrintPodRestarts logs the restart count of each pod of the application.
+func PrintPodRestarts(r runtime.Runtime, pods []types.Pod) {
+	logger.Infoln("Pod Restarts:")
+	for _, pod := range pods {
+		logger.Infoln(fmt.Sprintf("\t-> %s: %d", pod.Name, GetPodRestarts(r, &pod)))
+	}
+}
+
+// PrintPodHealth prints the application along with the status and the restarts of each of its pods, see InfoOptions.Refresh.
+// The status is resolved the same way as for ps, hence the readiness transitions of the pods are visible.
+func PrintPodHealth(r runtime.Runtime, opts appTypes.InfoOptions, pods []types.Pod) {
+	printer := utils.NewTableWriter()
+	printer.SetHeaders("POD NAME", "STATUS", "RESTARTS")
+
+	for _, pod := range pods {
+		pInfo, err := r.InspectPod(pod.ID)
+		if err != nil {
+			// log and skip pod if inspect failed
+			logger.Errorf("Failed to do pod inspect: '%s' with error: %v", pod.ID, err)
+
+			continue
+		}
+
+		printer.AppendRow(pInfo.Name, getPodStatus(r, pInfo), strconv.Itoa(GetPodRestarts(r, pInfo)))
+	}
+
+	// the terminal is only cleared once all the pods are inspected, so that the redraw does not flicker
+	if opts.Redraw {
+		fmt.Fprint(os.Stderr, clearScreen)
+	}
+
+	logger.Infoln("Application Name: " + opts.Name)
+	logger.Infoln("Application Template: " + pods[0].Labels[string(vars.TemplateLabel)])
+	logger.Infoln("Version: " + pods[0].Labels[string(vars.VersionLabel)])
+	logger.Infoln("Refreshed At: " + time.Now().Format(time.RFC3339))
+	printer.CloseTableWriter()
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	rtTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// LogOptions returns the runtime log options for the given logs options.
+func LogOptions(opts types.LogsOptions) rtTypes.LogOptions {
+	logOpts := rtTypes.LogOptions{Timestamps: opts.Timestamps}
+	if opts.JSON {
+		logOpts.LineFilter = NewJSONLogFilter(opts.JSONFields, opts.Timestamps)
+	}
+
+	return logOpts
+}
+
+// NewJSONLogFilter returns a log line filter which pretty-prints the JSON log lines, keeping only those whose fields
+// match all the given fields. The field keys may be dotted paths to nested fields and the values are matched
+// case-insensitively, eg:- level=error. Non-JSON lines pass through untouched.
+// If timestamps is set, the leading timestamp added by the runtime is retained in front of the JSON.
+func NewJSONLogFilter(fields map[string]string, timestamps bool) func(string) (string, bool) {
+	return func(line string) (string, bool) {
+		timestamp, payload := "", line
+		if timestamps {
+			if ts, rest, found := strings.Cut(line, " "); found {
+				if _, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+					timestamp, payload = ts+" ", rest
+				}
+			}
+		}
+
+		trimmed := strings.TrimSpace(payload)
+		if !strings.HasPrefix(trimmed, "{") {
+			return line, true
+		}
+
+		var record map[string]any
+		if err := json.Unmarshal([]byte(trimmed), &record); err != nil {
+			return line, true
+		}
+
+		for key, want := range fields {
+			got, ok := lookupField(record, key)
+			if !ok || !strings.EqualFold(fmt.Sprint(got), want) {
+				return "", false
+			}
+		}
+
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, []byte(trimmed), "", "  "); err != nil {
+			return line, true
+		}
+
+		return timestamp + pretty.String(), true
+	}
+}
+
+// lookupField returns the value of the given dotted field path in the record.
+func lookupField(record map[string]any, key string) (any, bool) {
+	var val any = record
+	for _, part := range strings.Split(key, ".") {
+		m, ok := val.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if val, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+
+	return val, true
+}
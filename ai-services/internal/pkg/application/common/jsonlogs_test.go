@@ -0,0 +1,87 @@
+package common
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestJSONLogFilter(t *testing.T) {
+	stream := []string{
+		"INFO 10-17 starting vLLM API server",
+		`{"level":"info","msg":"loading model"}`,
+		`{"level":"ERROR","msg":"out of memory","source":{"file":"worker.py"}}`,
+		`{"level": broken`,
+		"Traceback (most recent call last):",
+	}
+
+	tests := []struct {
+		name       string
+		fields     map[string]string
+		timestamps bool
+		lines      []string
+		want       []string
+	}{
+		{
+			name:  "pretty-printed and plain text untouched",
+			lines: stream,
+			want: []string{
+				"INFO 10-17 starting vLLM API server",
+				"{\n  \"level\": \"info\",\n  \"msg\": \"loading model\"\n}",
+				"{\n  \"level\": \"ERROR\",\n  \"msg\": \"out of memory\",\n  \"source\": {\n    \"file\": \"worker.py\"\n  }\n}",
+				`{"level": broken`,
+				"Traceback (most recent call last):",
+			},
+		},
+		{
+			name:   "filtered by field case-insensitively",
+			fields: map[string]string{"level": "error"},
+			lines:  stream,
+			want: []string{
+				"INFO 10-17 starting vLLM API server",
+				"{\n  \"level\": \"ERROR\",\n  \"msg\": \"out of memory\",\n  \"source\": {\n    \"file\": \"worker.py\"\n  }\n}",
+				`{"level": broken`,
+				"Traceback (most recent call last):",
+			},
+		},
+		{
+			name:   "filtered by nested field",
+			fields: map[string]string{"source.file": "worker.py", "level": "error"},
+			lines:  stream[1:3],
+			want:   []string{"{\n  \"level\": \"ERROR\",\n  \"msg\": \"out of memory\",\n  \"source\": {\n    \"file\": \"worker.py\"\n  }\n}"},
+		},
+		{
+			name:   "missing field",
+			fields: map[string]string{"source.line": "1"},
+			lines:  stream[1:3],
+			want:   []string{},
+		},
+		{
+			name:       "timestamp retained",
+			timestamps: true,
+			lines:      []string{`2026-10-17T18:08:09.123456789Z {"msg":"ready"}`, "2026-10-17T18:08:09Z plain"},
+			want:       []string{"2026-10-17T18:08:09.123456789Z {\n  \"msg\": \"ready\"\n}", "2026-10-17T18:08:09Z plain"},
+		},
+		{
+			name:  "timestamp not expected",
+			lines: []string{`2026-10-17T18:08:09Z {"msg":"ready"}`},
+			want:  []string{`2026-10-17T18:08:09Z {"msg":"ready"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewJSONLogFilter(tt.fields, tt.timestamps)
+
+			got := []string{}
+			for _, line := range tt.lines {
+				if out, ok := filter(line); ok {
+					got = append(got, out)
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("filtered lines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// CustomLabels returns the labels attached by the user, skipping the ones managed by ai-services.
+func CustomLabels(labels map[string]string) map[string]string {
+	custom := map[string]string{}
+	for key, val := range labels {
+		if !strings.HasPrefix(key, constants.LabelKeyPrefix) {
+			custom[key] = val
+		}
+	}
+
+	if len(custom) == 0 {
+		return nil
+	}
+
+	return custom
+}
+
+// PrintLabels logs the custom labels of the application, if any.
+func PrintLabels(labels map[string]string) {
+	custom := CustomLabels(labels)
+	if len(custom) == 0 {
+		return
+	}
+
+	pairs := make([]string, 0, len(custom))
+	for _, key := range utils.ExtractMapKeys(custom) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, custom[key]))
+	}
+	slices.Sort(pairs)
+
+	logger.Infoln("Labels: " + strings.Join(pairs, ", "))
+}
@@ -0,0 +1,170 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"golang.org/x/term"
+)
+
+// AllContainers is the --container value following the logs of every container of the pod.
+const AllContainers = "all"
+
+// ResolveLogsPod returns the pod to show logs from when no pod was given explicitly.
+// It prefers the primary pod declared in the template metadata, falls back to the only pod of the application,
+// and otherwise lets the user choose interactively or fails listing the available pods.
+func ResolveLogsPod(r runtime.Runtime, appName string) (string, error) {
+	pods, err := helpers.ListApplicationPods(r, appName)
+	if err != nil {
+		return "", err
+	}
+
+	if len(pods) == 0 {
+		return "", fmt.Errorf("application '%s' does not exist", appName)
+	}
+
+	if len(pods) == 1 {
+		return pods[0].Name, nil
+	}
+
+	if podName := findPrimaryPod(appName, pods); podName != "" {
+		logger.Infof("No pod specified, using the primary pod: %s\n", podName, logger.VerbosityLevelDebug)
+
+		return podName, nil
+	}
+
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Name)
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("application '%s' has multiple pods, specify one using --pod flag: %s", appName, strings.Join(podNames, ", "))
+	}
+
+	return utils.SelectOption("Select the pod to show logs from:", podNames)
+}
+
+// ValidateLogsTarget checks that the pod, and the container if one was given, to show the logs of exist, so that
+// a mistyped name is reported as such rather than as a failure of the runtime while fetching the logs.
+func ValidateLogsTarget(r runtime.Runtime, opts appTypes.LogsOptions) error {
+	exists, err := r.PodExists(opts.PodName)
+	if err != nil {
+		return fmt.Errorf("failed to check if pod %s exists: %w", opts.PodName, err)
+	}
+	if !exists {
+		return fmt.Errorf("pod %s does not exist", opts.PodName)
+	}
+
+	if opts.ContainerNameOrID == "" || opts.ContainerNameOrID == AllContainers {
+		return nil
+	}
+
+	exists, err = r.ContainerExists(opts.ContainerNameOrID)
+	if err != nil {
+		return fmt.Errorf("failed to check if container %s exists: %w", opts.ContainerNameOrID, err)
+	}
+	if !exists {
+		return fmt.Errorf("container %s does not exist", opts.ContainerNameOrID)
+	}
+
+	return nil
+}
+
+// findPrimaryPod returns the name of the pod declared as primary in the application template metadata, if any.
+func findPrimaryPod(appName string, pods []types.Pod) string {
+	appTemplate := pods[0].Labels[string(vars.TemplateLabel)]
+	if appTemplate == "" {
+		return ""
+	}
+
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	md, err := tp.LoadMetadata(appTemplate, false)
+	if err != nil || md.PrimaryPod == "" {
+		return ""
+	}
+
+	// pods are named as '<qualified app name>--<pod>' in the templates
+	primaryName := fmt.Sprintf("%s--%s", vars.QualifiedAppName(appName), md.PrimaryPod)
+
+	var matches []string
+	for _, pod := range pods {
+		if pod.Name == primaryName {
+			return pod.Name
+		}
+		if strings.Contains(pod.Name, md.PrimaryPod) {
+			matches = append(matches, pod.Name)
+		}
+	}
+
+	if len(matches) == 1 {
+		return matches[0]
+	}
+
+	return ""
+}
+
+// FollowAllContainerLogs follows the logs of every container of the pod, resolved via pod inspect, concurrently.
+// Each line is prefixed with the name of its container, even for a single container pod, so that the logs of
+// the sidecars are told apart from the main container.
+func FollowAllContainerLogs(r runtime.Runtime, podName string, opts appTypes.LogsOptions) error {
+	pod, err := r.InspectPod(podName)
+	if err != nil {
+		return fmt.Errorf("failed to inspect pod: %s; err: %w", podName, err)
+	}
+
+	containers := make([]string, 0, len(pod.Containers))
+	for _, container := range pod.Containers {
+		// skip the infra container as it does not produce any logs
+		if container.ID != pod.InfraContainerID {
+			containers = append(containers, container.Name)
+		}
+	}
+
+	if len(containers) == 0 {
+		return fmt.Errorf("no containers found in pod: %s", podName)
+	}
+
+	logger.Infof("Fetching logs for containers: %s\n", strings.Join(containers, ", "))
+
+	errs := make([]error, len(containers))
+	var wg sync.WaitGroup
+	for i, container := range containers {
+		logOpts := LogOptions(opts)
+		logOpts.LineFilter = prefixLines("["+container+"] ", logOpts.LineFilter)
+		wg.Go(func() {
+			if err := r.ContainerLogs(container, logOpts); err != nil {
+				errs[i] = fmt.Errorf("failed to fetch container: %s logs; err: %w", container, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// prefixLines returns a log line filter prefixing each line displayed by the given filter, if any.
+// A line may span multiple lines once filtered, e.g. a pretty-printed JSON line, hence each of them is prefixed.
+func prefixLines(prefix string, filter func(string) (string, bool)) func(string) (string, bool) {
+	return func(line string) (string, bool) {
+		if filter != nil {
+			var ok bool
+			if line, ok = filter(line); !ok {
+				return "", false
+			}
+		}
+
+		return prefix + strings.ReplaceAll(line, "\n", "\n"+prefix), true
+	}
+}
@@ -0,0 +1,185 @@
+package common
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestValidateLogsTarget(t *testing.T) {
+	errRuntime := errors.New("connection refused")
+
+	tests := []struct {
+		name      string
+		pod       string
+		container string
+		fail      string
+		wantErr   string
+	}{
+		{name: "pod", pod: "app--vllm"},
+		{name: "pod and container", pod: "app--vllm", container: "app--vllm-server"},
+		{name: "all containers", pod: "app--vllm", container: AllContainers},
+		{name: "missing pod", pod: "app--vlm", container: "app--vllm-server", wantErr: "pod app--vlm does not exist"},
+		{name: "missing container", pod: "app--vllm", container: "app--vllm-srv", wantErr: "container app--vllm-srv does not exist"},
+		{name: "pod check fails", pod: "app--vllm", fail: "PodExists:app--vllm", wantErr: "failed to check if pod app--vllm exists"},
+		{
+			name: "container check fails", pod: "app--vllm", container: "app--vllm-server", fail: "ContainerExists:app--vllm-server",
+			wantErr: "failed to check if container app--vllm-server exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addAppPod(r, "app", "app--vllm", types.Container{ID: "server-id", Name: "app--vllm-server", Status: "running"})
+			if tt.fail != "" {
+				r.Fail(tt.fail, errRuntime)
+			}
+
+			err := ValidateLogsTarget(r, appTypes.LogsOptions{Name: "app", PodName: tt.pod, ContainerNameOrID: tt.container})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateLogsTarget() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateLogsTarget() error = %v, want %q", err, tt.wantErr)
+			}
+			if tt.fail != "" && !errors.Is(err, errRuntime) {
+				t.Errorf("ValidateLogsTarget() error = %v, want it to wrap %v", err, errRuntime)
+			}
+		})
+	}
+}
+
+func TestPrefixLines(t *testing.T) {
+	indent := func(line string) (string, bool) {
+		if line == "" {
+			return "", false
+		}
+
+		return strings.ReplaceAll(line, ",", ",\n"), true
+	}
+
+	tests := []struct {
+		name   string
+		filter func(string) (string, bool)
+		line   string
+		want   string
+		wantOK bool
+	}{
+		{name: "no filter", line: "ready", want: "[vllm] ready", wantOK: true},
+		{name: "filtered line spans lines", filter: indent, line: "a,b", want: "[vllm] a,\n[vllm] b", wantOK: true},
+		{name: "dropped by filter", filter: indent, line: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := prefixLines("[vllm] ", tt.filter)(tt.line)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("prefixLines() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// TestFollowAllContainerLogs follows the logs of a pod with a sidecar, asserting that every container but the infra
+// one is followed and that each displayed line, including each line of a pretty-printed JSON record, is prefixed
+// with its container.
+func TestFollowAllContainerLogs(t *testing.T) {
+	r := fake.New()
+	addAppPod(r, "app", "app--vllm",
+		types.Container{ID: "vllm-id", Name: "app--vllm-server", Status: "running"},
+		types.Container{ID: "proxy-id", Name: "app--vllm-proxy", Status: "running"},
+	)
+	r.Logs = map[string][]string{
+		"app--vllm-server": {"loading model", `{"level":"info","msg":"ready"}`},
+		"app--vllm-proxy":  {"listening on 8000"},
+	}
+
+	var mu sync.Mutex
+	displayed := map[string][]string{}
+	r.OnContainerLogs = func(container string, opts types.LogOptions) error {
+		for _, line := range r.Logs[container] {
+			if opts.LineFilter != nil {
+				var ok bool
+				if line, ok = opts.LineFilter(line); !ok {
+					continue
+				}
+			}
+			mu.Lock()
+			displayed[container] = append(displayed[container], strings.Split(line, "\n")...)
+			mu.Unlock()
+		}
+
+		return nil
+	}
+
+	if err := FollowAllContainerLogs(r, "app--vllm", appTypes.LogsOptions{JSON: true}); err != nil {
+		t.Fatalf("FollowAllContainerLogs() error = %v", err)
+	}
+
+	if len(displayed) != 2 {
+		t.Fatalf("followed containers = %v, want the server and the proxy but not the infra container", displayed)
+	}
+	for container, lines := range displayed {
+		for _, line := range lines {
+			if !strings.HasPrefix(line, "["+container+"] ") {
+				t.Errorf("line %q of %s is not prefixed with its container", line, container)
+			}
+		}
+	}
+	if got := len(displayed["app--vllm-server"]); got <= 2 {
+		t.Errorf("server lines = %q, want the JSON record pretty-printed over multiple lines", displayed["app--vllm-server"])
+	}
+}
+
+func TestFollowAllContainerLogsErrors(t *testing.T) {
+	errRuntime := errors.New("connection refused")
+
+	tests := []struct {
+		name    string
+		pod     string
+		fail    string
+		wantErr string
+	}{
+		{name: "missing pod", pod: "app--vlm", wantErr: "failed to inspect pod: app--vlm"},
+		{name: "only the infra container", pod: "app--empty", wantErr: "no containers found in pod: app--empty"},
+		{name: "container fails", pod: "app--vllm", fail: "ContainerLogs:app--vllm-proxy", wantErr: "failed to fetch container: app--vllm-proxy logs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addAppPod(r, "app", "app--vllm",
+				types.Container{ID: "vllm-id", Name: "app--vllm-server", Status: "running"},
+				types.Container{ID: "proxy-id", Name: "app--vllm-proxy", Status: "running"},
+			)
+			addAppPod(r, "app", "app--empty")
+			if tt.fail != "" {
+				r.Fail(tt.fail, errRuntime)
+			}
+
+			err := FollowAllContainerLogs(r, tt.pod, appTypes.LogsOptions{})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("FollowAllContainerLogs() error = %v, want %q", err, tt.wantErr)
+			}
+			if tt.fail != "" {
+				if !errors.Is(err, errRuntime) {
+					t.Errorf("FollowAllContainerLogs() error = %v, want it to wrap %v", err, errRuntime)
+				}
+				if calls := r.Calls(); !slices.Contains(calls, "ContainerLogs:app--vllm-server") {
+					t.Errorf("calls = %v, want the logs of the other container followed regardless", calls)
+				}
+			}
+		})
+	}
+}
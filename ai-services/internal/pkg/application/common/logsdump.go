@@ -0,0 +1,94 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+)
+
+const (
+	// DefaultDumpMaxBytes is the default cap of the logs dumped per container by --tail-all.
+	DefaultDumpMaxBytes = 1 << 20
+
+	// dumpTailLines bounds the lines fetched per container before the size cap is applied.
+	dumpTailLines = 100000
+
+	// TruncationMarker prefixes the dumped logs of a container whose older logs were dropped by the size cap.
+	TruncationMarker = "... [truncated"
+)
+
+// DumpLogs writes the most recent logs of every container of the application pods to w, without following them.
+// The logs of each container are capped to opts.MaxBytes, the dropped older part being replaced by a truncation marker,
+// so that the dump stays small enough to be attached to a bug report.
+func DumpLogs(r runtime.Runtime, opts types.LogsOptions, w io.Writer) error {
+	pods, err := helpers.ListApplicationPods(r, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		return errdefs.NoPodsFound(opts.Name)
+	}
+
+	dumped := 0
+	for _, pod := range pods {
+		if opts.PodName != "" && pod.Name != opts.PodName {
+			continue
+		}
+
+		for _, container := range pod.Containers {
+			if container.ID == pod.InfraContainerID {
+				continue
+			}
+			if opts.ContainerNameOrID != "" && opts.ContainerNameOrID != AllContainers && container.Name != opts.ContainerNameOrID && container.ID != opts.ContainerNameOrID {
+				continue
+			}
+
+			logger.Infof("Dumping logs of container: %s/%s", pod.Name, container.Name, logger.VerbosityLevelDebug)
+			lines, err := r.ContainerLogTail(container.Name, dumpTailLines)
+			if err != nil {
+				return fmt.Errorf("failed to fetch container: %s logs; err: %w", container.Name, err)
+			}
+
+			fmt.Fprintf(w, "===== %s/%s =====\n", pod.Name, container.Name)
+			fmt.Fprint(w, capLogs(lines, opts.MaxBytes))
+			dumped++
+		}
+	}
+
+	if dumped == 0 {
+		return fmt.Errorf("no containers matching the given --pod and --container found for application: %s", opts.Name)
+	}
+
+	return nil
+}
+
+// capLogs joins the log lines keeping only their most recent maxBytes. The logs are cut at a line boundary and
+// the dropped part is reported by a truncation marker, so that a reader never mistakes a partial dump for the full logs.
+func capLogs(lines []string, maxBytes int) string {
+	if len(lines) == 0 {
+		return ""
+	}
+
+	logs := strings.Join(lines, "\n") + "\n"
+	if maxBytes <= 0 || len(logs) <= maxBytes {
+		return logs
+	}
+
+	cut := len(logs) - maxBytes
+	kept := logs[cut:]
+	// drop the leading partial line, unless the cut is at a line boundary or the cap fits in a single line
+	if logs[cut-1] != '\n' {
+		if i := strings.IndexByte(kept, '\n'); i >= 0 && i < len(kept)-1 {
+			kept = kept[i+1:]
+		}
+	}
+
+	return fmt.Sprintf("%s %d bytes, showing the last %d bytes] ...\n%s", TruncationMarker, len(logs)-len(kept), len(kept), kept)
+}
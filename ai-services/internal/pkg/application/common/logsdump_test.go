@@ -0,0 +1,112 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestCapLogs(t *testing.T) {
+	lines := []string{"line 1", "line 2", "line 3", "line 4"}
+
+	tests := []struct {
+		name     string
+		lines    []string
+		maxBytes int
+		want     string
+	}{
+		{name: "no logs", maxBytes: 10, want: ""},
+		{name: "no cap", lines: lines, want: "line 1\nline 2\nline 3\nline 4\n"},
+		{name: "within the cap", lines: lines, maxBytes: 28, want: "line 1\nline 2\nline 3\nline 4\n"},
+		{
+			name: "partial line dropped", lines: lines, maxBytes: 10,
+			want: TruncationMarker + " 21 bytes, showing the last 7 bytes] ...\nline 4\n",
+		},
+		{
+			name: "cap at a line boundary", lines: lines, maxBytes: 14,
+			want: TruncationMarker + " 14 bytes, showing the last 14 bytes] ...\nline 3\nline 4\n",
+		},
+		{
+			name: "cap within the last line", lines: lines, maxBytes: 4,
+			want: TruncationMarker + " 24 bytes, showing the last 4 bytes] ...\ne 4\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := capLogs(tt.lines, tt.maxBytes); got != tt.want {
+				t.Errorf("capLogs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDumpLogs(t *testing.T) {
+	r := fake.New()
+	addAppPod(r, "app", "app--vllm", types.Container{ID: "vllm-id", Name: "app--vllm-server", Status: "running"})
+	addAppPod(r, "app", "app--ui", types.Container{ID: "ui-id", Name: "app--ui-ui", Status: "running"},
+		types.Container{ID: "proxy-id", Name: "app--ui-proxy", Status: "running"})
+	r.Logs = map[string][]string{
+		"app--vllm-server": {strings.Repeat("x", 40), "model loaded", "server ready"},
+		"app--ui-ui":       {"listening on 3000"},
+	}
+
+	tests := []struct {
+		name      string
+		opts      appTypes.LogsOptions
+		want      string
+		wantErr   error
+		wantErrIn string
+	}{
+		{
+			name: "all containers capped",
+			opts: appTypes.LogsOptions{Name: "app", MaxBytes: 30},
+			want: "===== app--vllm/app--vllm-server =====\n" +
+				TruncationMarker + " 41 bytes, showing the last 26 bytes] ...\nmodel loaded\nserver ready\n" +
+				"===== app--ui/app--ui-ui =====\nlistening on 3000\n" +
+				"===== app--ui/app--ui-proxy =====\n",
+		},
+		{
+			name: "pod",
+			opts: appTypes.LogsOptions{Name: "app", PodName: "app--ui", ContainerNameOrID: AllContainers},
+			want: "===== app--ui/app--ui-ui =====\nlistening on 3000\n===== app--ui/app--ui-proxy =====\n",
+		},
+		{
+			name: "container",
+			opts: appTypes.LogsOptions{Name: "app", ContainerNameOrID: "ui-id"},
+			want: "===== app--ui/app--ui-ui =====\nlistening on 3000\n",
+		},
+		{name: "no pods", opts: appTypes.LogsOptions{Name: "other"}, wantErr: errdefs.ErrNoPodsFound},
+		{
+			name:      "no matching container",
+			opts:      appTypes.LogsOptions{Name: "app", PodName: "app--ui", ContainerNameOrID: "app--vllm-server"},
+			wantErrIn: "no containers matching the given --pod and --container found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := DumpLogs(r, tt.opts, &out)
+			if tt.wantErr != nil || tt.wantErrIn != "" {
+				if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) || !strings.Contains(err.Error(), tt.wantErrIn) {
+					t.Errorf("DumpLogs() error = %v, want %v %q", err, tt.wantErr, tt.wantErrIn)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("DumpLogs() error = %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("DumpLogs() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
@@ -13,53 +18,140 @@ import (
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 )
 
-// FetchFilteredPods Fetch all pods for a given app based on label.
+const (
+	// shortIDLength is the length the pod IDs are truncated to in the wide table, as done by podman.
+	shortIDLength = 12
+	// clearScreen moves the cursor to the top left corner and clears the terminal.
+	clearScreen = "\033[H\033[2J"
+)
+
+// FetchFilteredPods Fetch all pods for a given app within the current namespace based on label.
 func FetchFilteredPods(r runtime.Runtime, appName string) ([]types.Pod, error) {
-	listFilters := map[string][]string{}
-	if appName != "" {
-		listFilters["label"] = []string{fmt.Sprintf("ai-services.io/application=%s", appName)}
+	return helpers.ListApplicationPods(r, appName)
+}
+
+// FilterPodsByCreated keeps the pods created within the since duration and before the until duration, relative to now.
+// A zero duration disables the respective bound.
+func FilterPodsByCreated(pods []types.Pod, since, until time.Duration, now time.Time) []types.Pod {
+	if since == 0 && until == 0 {
+		return pods
 	}
 
-	pods, err := r.ListPods(listFilters)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list pods: %w", err)
+	filtered := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if since > 0 && pod.Created.Before(now.Add(-since)) {
+			continue
+		}
+		if until > 0 && pod.Created.After(now.Add(-until)) {
+			continue
+		}
+		filtered = append(filtered, pod)
 	}
 
-	return pods, nil
+	return filtered
 }
 
 // PopulateTable Set table headers and rows.
-func PopulateTable(r runtime.Runtime, opts appTypes.ListOptions, pods []types.Pod) {
+// If a format template is provided, each pod is rendered using the template instead.
+func PopulateTable(r runtime.Runtime, opts appTypes.ListOptions, pods []types.Pod) error {
+	if opts.Format != nil {
+		return renderFormattedPods(r, opts, pods)
+	}
+
+	if opts.OutputJSON {
+		return renderJSONPods(r, pods, opts.JSONLines)
+	}
+
 	// fetch the table writer object
 	printer := utils.NewTableWriter()
-	defer printer.CloseTableWriter()
 
 	// set table headers
 	setTableHeaders(printer, opts.OutputWide)
 
 	// render each pod info as rows in the table
-	renderPodRows(r, printer, pods, opts.OutputWide)
+	renderPodRows(r, printer, pods, opts.OutputWide, opts.NoTrunc)
+
+	// the terminal is only cleared once all the pods are inspected, so that the redraw does not flicker
+	Redraw(opts)
+	printer.CloseTableWriter()
+
+	return nil
+}
+
+// Redraw clears the terminal if the pods are redrawn in place, see ListOptions.Redraw.
+func Redraw(opts appTypes.ListOptions) {
+	if opts.Redraw {
+		fmt.Fprint(os.Stderr, clearScreen)
+	}
 }
 
 func setTableHeaders(printer *utils.Printer, outputWide bool) {
 	if outputWide {
-		printer.SetHeaders("APPLICATION NAME", "POD ID", "POD NAME", "STATUS", "CREATED", "EXPOSED", "CONTAINERS")
+		printer.SetHeaders("APPLICATION NAME", "POD ID", "POD NAME", "STATUS", "RESTARTS", "CREATED", "EXPOSED", "CONTAINERS")
 	} else {
 		printer.SetHeaders("APPLICATION NAME", "POD NAME", "STATUS")
 	}
 }
 
-func renderPodRows(r runtime.Runtime, printer *utils.Printer, pods []types.Pod, wideOutput bool) {
+func renderPodRows(r runtime.Runtime, printer *utils.Printer, pods []types.Pod, wideOutput, noTrunc bool) {
 	for _, pod := range pods {
-		processAndAppendPodRow(r, printer, pod, wideOutput)
+		entry, ok := fetchPodEntry(r, pod, wideOutput)
+		if !ok {
+			continue
+		}
+
+		// append pod row to the table
+		printer.AppendRow(buildPodRow(entry, wideOutput, noTrunc)...)
 	}
 }
 
-func processAndAppendPodRow(r runtime.Runtime, printer *utils.Printer, pod types.Pod, wideOutput bool) {
+func renderJSONPods(r runtime.Runtime, pods []types.Pod, singleLine bool) error {
+	entries := make([]appTypes.PodListEntry, 0, len(pods))
+	for _, pod := range pods {
+		entry, ok := fetchPodEntry(r, pod, true)
+		if !ok {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	var data []byte
+	var err error
+	if singleLine {
+		data, err = json.Marshal(entries)
+	} else {
+		data, err = json.MarshalIndent(entries, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal pods: %w", err)
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(data))
+
+	return err
+}
+
+func renderFormattedPods(r runtime.Runtime, opts appTypes.ListOptions, pods []types.Pod) error {
+	Redraw(opts)
+	for _, pod := range pods {
+		entry, ok := fetchPodEntry(r, pod, true)
+		if !ok {
+			continue
+		}
+
+		if err := utils.ExecuteFormat(os.Stdout, opts.Format, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchPodEntry inspects the pod and builds its entry, returns false if the pod has to be skipped.
+func fetchPodEntry(r runtime.Runtime, pod types.Pod, detailed bool) (appTypes.PodListEntry, bool) {
 	appName := fetchPodNameFromLabels(pod.Labels)
 	if appName == "" {
 		// skip pods which are not linked to ai-services
-		return
+		return appTypes.PodListEntry{}, false
 	}
 
 	// do pod inspect
@@ -68,42 +160,63 @@ func processAndAppendPodRow(r runtime.Runtime, printer *utils.Printer, pod types
 		// log and skip pod if inspect failed
 		logger.Errorf("Failed to do pod inspect: '%s' with error: %v", pod.ID, err)
 
-		return
+		return appTypes.PodListEntry{}, false
 	}
 
-	// fetch pod row
-	rows := buildPodRow(r, appName, pInfo, wideOutput)
-	// append pod row to the table
-	printer.AppendRow(rows...)
+	return buildPodEntry(r, appName, pInfo, detailed), true
 }
 
 func fetchPodNameFromLabels(labels map[string]string) string {
 	return labels[constants.ApplicationAnnotationKey]
 }
 
-func buildPodRow(r runtime.Runtime, appName string, pod *types.Pod, wideOutput bool) []string {
-	status := getPodStatus(r, pod)
+// buildPodEntry builds the pod entry, the containers and ports are only fetched if detailed is set.
+func buildPodEntry(r runtime.Runtime, appName string, pod *types.Pod, detailed bool) appTypes.PodListEntry {
+	entry := appTypes.PodListEntry{
+		ApplicationName: appName,
+		PodID:           pod.ID,
+		PodName:         pod.Name,
+		Status:          getPodStatus(r, pod),
+		Labels:          CustomLabels(pod.Labels),
+	}
 
-	// if wide option flag is not set, then return appName, podName and status only
-	if !wideOutput {
-		return []string{appName, pod.Name, status}
+	if !detailed {
+		return entry
 	}
 
-	containerNames := getContainerNames(r, pod)
+	entry.Created = utils.TimeAgo(pod.Created)
+	entry.Restarts = GetPodRestarts(r, pod)
+	entry.Containers = getContainerNames(r, pod)
 
 	podPorts, err := getPodPorts(pod)
 	if err != nil {
 		podPorts = []string{"none"}
 	}
+	entry.Ports = podPorts
+
+	return entry
+}
+
+func buildPodRow(entry appTypes.PodListEntry, wideOutput, noTrunc bool) []string {
+	// if wide option flag is not set, then return appName, podName and status only
+	if !wideOutput {
+		return []string{entry.ApplicationName, entry.PodName, entry.Status}
+	}
+
+	podID := entry.PodID
+	if !noTrunc && len(podID) > shortIDLength {
+		podID = podID[:shortIDLength]
+	}
 
 	return []string{
-		appName,
-		pod.ID[:12],
-		pod.Name,
-		status,
-		utils.TimeAgo(pod.Created),
-		strings.Join(podPorts, ", "),
-		strings.Join(containerNames, ", "),
+		entry.ApplicationName,
+		podID,
+		entry.PodName,
+		entry.Status,
+		strconv.Itoa(entry.Restarts),
+		entry.Created,
+		strings.Join(entry.Ports, ", "),
+		strings.Join(entry.Containers, ", "),
 	}
 }
 
@@ -123,6 +236,29 @@ func getPodPorts(pInfo *types.Pod) ([]string, error) {
 	return podPorts, nil
 }
 
+// GetPodRestarts returns the total restart count of the containers of the pod.
+func GetPodRestarts(r runtime.Runtime, pod *types.Pod) int {
+	restarts := 0
+
+	for _, container := range pod.Containers {
+		if container.ID == pod.InfraContainerID {
+			continue
+		}
+
+		count, err := r.ContainerRestartCount(container.ID)
+		if err != nil {
+			// skip container if inspect failed
+			logger.Infof("failed to fetch restart count for pod: '%s', containerID: '%s' with error: %v", pod.Name, container.ID, err, logger.VerbosityLevelDebug)
+
+			continue
+		}
+
+		restarts += count
+	}
+
+	return restarts
+}
+
 func getContainerNames(r runtime.Runtime, pod *types.Pod) []string {
 	containerNames := []string{}
 
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestGetPodRestarts(t *testing.T) {
+	tests := []struct {
+		name       string
+		containers []types.Container
+		failing    string
+		want       int
+	}{
+		{
+			name:       "no restarts",
+			containers: []types.Container{{ID: "a", Status: "running"}, {ID: "b", Status: "running"}},
+			want:       0,
+		},
+		{
+			name:       "sums the containers, excluding infra",
+			containers: []types.Container{{ID: "a", RestartCount: 2}, {ID: "b", RestartCount: 3}},
+			want:       5,
+		},
+		{
+			name:       "skips the containers failing inspect",
+			containers: []types.Container{{ID: "a", RestartCount: 2}, {ID: "b", RestartCount: 3}},
+			failing:    "b",
+			want:       2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addAppPod(r, "app", "app--pod", tt.containers...)
+			if tt.failing != "" {
+				r.Fail("InspectContainer:"+tt.failing, errors.New("inspect failed"))
+			}
+
+			pod, err := r.InspectPod("app--pod")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := GetPodRestarts(r, pod); got != tt.want {
+				t.Errorf("GetPodRestarts() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPodRow(t *testing.T) {
+	const fullID = "4f2a7c9e1b3d5f6a8c0e2b4d6f8a0c2e4b6d8f0a2c4e6b8d0f2a4c6e8b0d2f4a"
+	entry := appTypes.PodListEntry{
+		ApplicationName: "app",
+		PodID:           fullID,
+		PodName:         "app--vllm-server",
+		Status:          "Running (healthy)",
+		Restarts:        2,
+		Created:         "5 minutes ago",
+		Ports:           []string{"8000"},
+		Containers:      []string{"app--vllm-server-vllm (healthy)"},
+	}
+
+	tests := []struct {
+		name    string
+		podID   string
+		wide    bool
+		noTrunc bool
+		want    []string
+	}{
+		{name: "default", podID: fullID, want: []string{"app", "app--vllm-server", "Running (healthy)"}},
+		{name: "default ignores no-trunc", podID: fullID, noTrunc: true, want: []string{"app", "app--vllm-server", "Running (healthy)"}},
+		{
+			name: "wide truncates the ID", podID: fullID, wide: true,
+			want: []string{"app", fullID[:12], "app--vllm-server", "Running (healthy)", "2", "5 minutes ago", "8000", "app--vllm-server-vllm (healthy)"},
+		},
+		{
+			name: "wide with no-trunc", podID: fullID, wide: true, noTrunc: true,
+			want: []string{"app", fullID, "app--vllm-server", "Running (healthy)", "2", "5 minutes ago", "8000", "app--vllm-server-vllm (healthy)"},
+		},
+		{
+			name: "short ID", podID: "4f2a7c9e", wide: true,
+			want: []string{"app", "4f2a7c9e", "app--vllm-server", "Running (healthy)", "2", "5 minutes ago", "8000", "app--vllm-server-vllm (healthy)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry.PodID = tt.podID
+			if got := buildPodRow(entry, tt.wide, tt.noTrunc); !slices.Equal(got, tt.want) {
+				t.Errorf("buildPodRow() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterPodsByCreated(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	pods := []types.Pod{
+		{Name: "just-created", Created: now.Add(-10 * time.Second)},
+		{Name: "last-hour", Created: now.Add(-30 * time.Minute)},
+		{Name: "yesterday", Created: now.Add(-26 * time.Hour)},
+		{Name: "last-week", Created: now.Add(-7 * 24 * time.Hour)},
+	}
+
+	tests := []struct {
+		name  string
+		since time.Duration
+		until time.Duration
+		want  []string
+	}{
+		{name: "no bounds", want: []string{"just-created", "last-hour", "yesterday", "last-week"}},
+		{name: "since", since: time.Hour, want: []string{"just-created", "last-hour"}},
+		{name: "until", until: 24 * time.Hour, want: []string{"yesterday", "last-week"}},
+		{name: "since and until", since: 48 * time.Hour, until: time.Minute, want: []string{"last-hour", "yesterday"}},
+		{name: "bound is inclusive", since: 30 * time.Minute, until: 30 * time.Minute, want: []string{"last-hour"}},
+		{name: "none match", since: time.Second, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, pod := range FilterPodsByCreated(pods, tt.since, tt.until, now) {
+				got = append(got, pod.Name)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FilterPodsByCreated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
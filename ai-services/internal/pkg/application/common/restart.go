@@ -0,0 +1,40 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// UnhealthyPods returns the pods with at least one container (except infra) reporting unhealthy, as per inspect.
+// The stopped pods are not unhealthy, as they are expected to be started rather than restarted.
+func UnhealthyPods(r runtime.Runtime, pods []types.Pod) ([]types.Pod, error) {
+	var unhealthy []types.Pod
+	for _, pod := range pods {
+		pInfo, err := r.InspectPod(pod.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to do pod inspect for pod: '%s': %w", pod.Name, err)
+		}
+
+		for _, container := range pInfo.Containers {
+			if container.ID == pInfo.InfraContainerID {
+				continue
+			}
+
+			cInfo, err := r.InspectContainer(container.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check container status: %w", err)
+			}
+
+			if fetchContainerStatus(cInfo) == string(constants.NotReady) {
+				unhealthy = append(unhealthy, pod)
+
+				break
+			}
+		}
+	}
+
+	return unhealthy, nil
+}
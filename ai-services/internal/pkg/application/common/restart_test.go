@@ -0,0 +1,46 @@
+package common
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestUnhealthyPods(t *testing.T) {
+	r := fake.New()
+	addAppPod(r, "app", "healthy", types.Container{ID: "healthy-c", Status: "running", Health: "healthy"})
+	addAppPod(r, "app", "no-health-check", types.Container{ID: "no-health-check-c", Status: "running"})
+	addAppPod(r, "app", "starting", types.Container{ID: "starting-c", Status: "running", Health: "starting"})
+	addAppPod(r, "app", "unhealthy", types.Container{ID: "unhealthy-c", Status: "running", Health: "unhealthy"})
+	addAppPod(r, "app", "partly-unhealthy",
+		types.Container{ID: "partly-unhealthy-c1", Status: "running", Health: "healthy"},
+		types.Container{ID: "partly-unhealthy-c2", Status: "running", Health: "unhealthy"})
+	addAppPod(r, "app", "stopped", types.Container{ID: "stopped-c", Status: "exited", Health: "unhealthy"})
+
+	pods, err := r.ListPods(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := UnhealthyPods(r, pods)
+	if err != nil {
+		t.Fatalf("UnhealthyPods() error = %v", err)
+	}
+
+	var names []string
+	for _, pod := range got {
+		names = append(names, pod.Name)
+	}
+	if want := []string{"unhealthy", "partly-unhealthy"}; !slices.Equal(names, want) {
+		t.Errorf("UnhealthyPods() = %v, want %v", names, want)
+	}
+
+	errInspect := errors.New("socket busy")
+	r.Fail("InspectContainer:healthy-c", errInspect)
+	if _, err := UnhealthyPods(r, pods); !errors.Is(err, errInspect) {
+		t.Errorf("UnhealthyPods() error = %v, want %v", err, errInspect)
+	}
+}
@@ -0,0 +1,142 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// waitPollInterval is the interval in which the pods are inspected while waiting, a var so that the tests can shorten it.
+var waitPollInterval = 5 * time.Second
+
+// WaitForPods waits until the selected pods of the application reach the requested condition.
+// All the pods share the same deadline, so the overall wait never exceeds the given timeout.
+func WaitForPods(r runtime.Runtime, opts appTypes.WaitOptions) error {
+	pods, err := helpers.ListApplicationPods(r, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		return errdefs.NoPodsFound(opts.Name)
+	}
+
+	pods, err = selectPods(pods, opts.PodNames)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(opts.Timeout)
+
+	for _, pod := range pods {
+		logger.Infof("Waiting for the pod: %s to be %s...\n", pod.Name, opts.Condition)
+
+		if err := waitForPod(r, pod, opts, deadline); err != nil {
+			return fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+
+		logger.Infof("Pod: %s is %s\n", pod.Name, opts.Condition)
+	}
+
+	return nil
+}
+
+func selectPods(pods []types.Pod, podNames []string) ([]types.Pod, error) {
+	if len(podNames) == 0 {
+		return pods, nil
+	}
+
+	podMap := make(map[string]types.Pod, len(pods))
+	for _, pod := range pods {
+		podMap[pod.Name] = pod
+	}
+
+	selected := make([]types.Pod, 0, len(podNames))
+	var notFound []string
+	for _, name := range podNames {
+		pod, exists := podMap[name]
+		if !exists {
+			notFound = append(notFound, name)
+
+			continue
+		}
+		selected = append(selected, pod)
+	}
+
+	if len(notFound) > 0 {
+		return nil, fmt.Errorf("the following pods were not found: %s", strings.Join(notFound, ", "))
+	}
+
+	return selected, nil
+}
+
+// waitForPod polls the pod until its containers (except infra) satisfy the condition.
+// The restart count at the start of the wait is taken as the baseline, so only the restarts within the wait window count.
+func waitForPod(r runtime.Runtime, pod types.Pod, opts appTypes.WaitOptions, deadline time.Time) error {
+	baseline := -1
+	var pending []string
+
+	err := utils.Poll(context.Background(), waitPollInterval, time.Until(deadline), func() (bool, error) {
+		pInfo, err := r.InspectPod(pod.ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to do pod inspect: %w", err)
+		}
+
+		restarts := 0
+		pending = []string{}
+		for _, container := range pInfo.Containers {
+			if container.ID == pInfo.InfraContainerID {
+				continue
+			}
+
+			cInfo, err := r.InspectContainer(container.ID)
+			if err != nil {
+				return false, fmt.Errorf("failed to check container status: %w", err)
+			}
+
+			restarts += cInfo.RestartCount
+			if !isConditionMet(cInfo, opts.Condition) {
+				pending = append(pending, fmt.Sprintf("%s (%s)", container.Name, fetchContainerStatus(cInfo)))
+			}
+		}
+
+		if baseline == -1 {
+			baseline = restarts
+		}
+
+		if restarts-baseline > opts.MaxRestarts {
+			return false, fmt.Errorf("containers restarted %d time(s) while waiting, exceeding the allowed max restarts: %d",
+				restarts-baseline, opts.MaxRestarts)
+		}
+
+		return len(pending) == 0, nil
+	})
+	if errors.Is(err, utils.ErrPollTimeout) {
+		return fmt.Errorf("operation timed out waiting for containers: %s", strings.Join(pending, ", "))
+	}
+
+	return err
+}
+
+func isConditionMet(cInfo *types.Container, condition appTypes.WaitCondition) bool {
+	switch condition {
+	case appTypes.WaitConditionExited:
+		// podman reports 'exited' and openshift reports 'terminated' for the stopped containers
+		return cInfo.Status == "exited" || cInfo.Status == "terminated"
+	case appTypes.WaitConditionRunning:
+		return cInfo.Status == "running"
+	default:
+		return fetchContainerStatus(cInfo) == string(constants.Ready)
+	}
+}
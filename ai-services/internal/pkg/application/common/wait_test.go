@@ -0,0 +1,123 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestMain(m *testing.M) {
+	waitPollInterval = time.Millisecond
+	m.Run()
+}
+
+func TestWaitForPods(t *testing.T) {
+	tests := []struct {
+		name        string
+		container   types.Container
+		condition   appTypes.WaitCondition
+		maxRestarts int
+		// restartsPerPoll is added to the restart count of the container on each inspect.
+		restartsPerPoll int
+		// healthyAfter is the number of inspects after which the container turns healthy, -1 for never.
+		healthyAfter int
+		wantErr      string
+	}{
+		{
+			name:         "healthy right away",
+			container:    types.Container{ID: "c", Status: "running", Health: "healthy"},
+			condition:    appTypes.WaitConditionHealthy,
+			healthyAfter: 0,
+		},
+		{
+			name:         "turns healthy",
+			container:    types.Container{ID: "c", Status: "running", Health: "starting"},
+			condition:    appTypes.WaitConditionHealthy,
+			healthyAfter: 3,
+		},
+		{
+			name:         "running without health check",
+			container:    types.Container{ID: "c", Status: "running"},
+			condition:    appTypes.WaitConditionRunning,
+			healthyAfter: -1,
+		},
+		{
+			name:            "restarts exceed the threshold",
+			container:       types.Container{ID: "c", Status: "running", Health: "starting", RestartCount: 4},
+			condition:       appTypes.WaitConditionHealthy,
+			restartsPerPoll: 1,
+			healthyAfter:    -1,
+			wantErr:         "exceeding the allowed max restarts: 0",
+		},
+		{
+			name:            "restarts within the threshold",
+			container:       types.Container{ID: "c", Status: "running", Health: "starting"},
+			condition:       appTypes.WaitConditionHealthy,
+			maxRestarts:     3,
+			restartsPerPoll: 1,
+			healthyAfter:    3,
+		},
+		{
+			name:         "times out",
+			container:    types.Container{ID: "c", Status: "running", Health: "unhealthy"},
+			condition:    appTypes.WaitConditionHealthy,
+			healthyAfter: -1,
+			wantErr:      "operation timed out waiting for containers: c (unhealthy)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			tt.container.Name = tt.container.ID
+			addAppPod(r, "app", "app--pod", tt.container)
+
+			inspects := 0
+			r.OnInspectContainer = func(c *types.Container) {
+				if c.ID != tt.container.ID {
+					return
+				}
+				inspects++
+				c.RestartCount += tt.restartsPerPoll
+				if tt.healthyAfter >= 0 && inspects > tt.healthyAfter {
+					c.Health = "healthy"
+				}
+			}
+
+			err := WaitForPods(r, appTypes.WaitOptions{
+				Name:        "app",
+				Condition:   tt.condition,
+				Timeout:     100 * time.Millisecond,
+				MaxRestarts: tt.maxRestarts,
+			})
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("WaitForPods() error = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("WaitForPods() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForPodsSelection(t *testing.T) {
+	r := fake.New()
+	addAppPod(r, "app", "app--pod", types.Container{ID: "c", Status: "running"})
+
+	err := WaitForPods(r, appTypes.WaitOptions{Name: "other", Condition: appTypes.WaitConditionRunning, Timeout: time.Second})
+	if !errors.Is(err, errdefs.ErrNoPodsFound) {
+		t.Errorf("WaitForPods() of an unknown application error = %v, want %v", err, errdefs.ErrNoPodsFound)
+	}
+
+	err = WaitForPods(r, appTypes.WaitOptions{Name: "app", PodNames: []string{"app--missing"}, Condition: appTypes.WaitConditionRunning, Timeout: time.Second})
+	if err == nil || !strings.Contains(err.Error(), "app--missing") {
+		t.Errorf("WaitForPods() of an unknown pod error = %v, want it to name the pod", err)
+	}
+}
@@ -27,6 +27,21 @@ type Application interface {
 	// Info displays detailed information about an application.
 	Info(opts types.InfoOptions) error
 
+	// Describe returns the combined info, pods and recent events of an application.
+	Describe(opts types.DescribeOptions) (*types.ApplicationDescription, error)
+
+	// Top displays the resource usage of the application containers.
+	Top(opts types.TopOptions) error
+
+	// Events displays the events of the application pods, following the new ones until ctx is done if requested.
+	Events(ctx context.Context, opts types.EventsOptions) error
+
+	// Restart restarts the application pods and waits for them to be healthy again.
+	Restart(opts types.RestartOptions) error
+
+	// Wait blocks until the application pods reach the requested condition or the timeout expires.
+	Wait(opts types.WaitOptions) error
+
 	// Logs displays logs from an application pod.
 	Logs(opts types.LogsOptions) error
 
@@ -12,6 +12,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/helm"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
@@ -19,10 +20,17 @@ import (
 )
 
 func (o *OpenshiftApplication) Create(ctx context.Context, opts types.CreateOptions) error {
-	logger.Infof("Creating application '%s' using template '%s'\n", opts.Name, opts.TemplateName)
-
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
 
+	// Resolve the canonical template name so charts, labels and output use it consistently
+	templateName, err := templates.ResolveTemplate(tp, opts.TemplateName)
+	if err != nil {
+		return &errdefs.TemplateError{Template: opts.TemplateName, Err: err}
+	}
+	opts.TemplateName = templateName
+
+	logger.Infof("Creating application '%s' using template '%s'\n", opts.Name, opts.TemplateName)
+
 	// Step1: Fetch the operation timeout
 	timeout, err := getOperationTimeout(ctx, tp, opts)
 	if err != nil {
@@ -132,7 +140,7 @@ func deployApp(ctx context.Context, chart chart.Charter, timeout time.Duration,
 	if err != nil {
 		s.Fail("failed to create application")
 
-		return fmt.Errorf("failed to perform app installation: %w", err)
+		return &errdefs.RuntimeError{Op: "install", Err: fmt.Errorf("failed to perform app installation: %w", err)}
 	}
 
 	s.Stop("Application '" + app + "' deployed successfully")
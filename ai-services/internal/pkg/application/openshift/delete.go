@@ -35,10 +35,17 @@ func (o *OpenshiftApplication) Delete(ctx context.Context, opts types.DeleteOpti
 		return nil
 	}
 
-	if err := o.confirmDeletion(opts); err != nil {
+	confirmed, err := o.confirmDeletion(opts)
+	if err != nil {
 		return err
 	}
 
+	if !confirmed {
+		logger.Infoln("Deletion cancelled")
+
+		return nil
+	}
+
 	logger.Infoln("Proceeding with deletion...")
 
 	const defaultDeleteTimeout = 5 * time.Minute
@@ -72,21 +79,16 @@ func (o *OpenshiftApplication) Delete(ctx context.Context, opts types.DeleteOpti
 	return nil
 }
 
-func (o *OpenshiftApplication) confirmDeletion(opts types.DeleteOptions) error {
+// confirmDeletion returns whether the user confirmed the deletion, which is implied by --yes.
+func (o *OpenshiftApplication) confirmDeletion(opts types.DeleteOptions) (bool, error) {
 	if opts.AutoYes {
-		return nil
+		return true, nil
 	}
 
-	confirmDelete, err := utils.ConfirmAction("Are you sure you want to delete the application '" + opts.Name + "'?")
+	confirmDelete, err := utils.ConfirmAction("Are you sure you want to delete the application '" + opts.Name + "'? ")
 	if err != nil {
-		return fmt.Errorf("failed to take user input: %w", err)
+		return false, fmt.Errorf("failed to take user input: %w", err)
 	}
 
-	if !confirmDelete {
-		logger.Infoln("Deletion cancelled")
-
-		return nil
-	}
-
-	return nil
+	return confirmDelete, nil
 }
@@ -3,8 +3,10 @@ package openshift
 import (
 	"fmt"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
@@ -25,7 +27,17 @@ func (o *OpenshiftApplication) Info(opts types.InfoOptions) error {
 
 	// If there exists no pod for given application name, then fail saying application for given application name doesnt exist
 	if len(pods) == 0 {
-		logger.Infof("Application: '%s' does not exist.", opts.Name)
+		logger.Infoln(errdefs.NoPodsFound(opts.Name).Error())
+
+		return nil
+	}
+
+	if opts.Format != nil {
+		return common.PrintFormattedInfo(o.runtime, opts, pods)
+	}
+
+	if opts.Refresh {
+		common.PrintPodHealth(o.runtime, opts, pods)
 
 		return nil
 	}
@@ -40,6 +52,10 @@ func (o *OpenshiftApplication) Info(opts types.InfoOptions) error {
 	version := pods[0].Labels[string(vars.VersionLabel)]
 	logger.Infoln("Version: " + version)
 
+	common.PrintLabels(pods[0].Labels)
+
+	common.PrintPodRestarts(o.runtime, pods)
+
 	// Step3: Read and print the info.md file
 
 	if err := helpers.PrintInfo(o.runtime, opts.Name, appTemplate); err != nil {
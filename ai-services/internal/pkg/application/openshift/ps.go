@@ -2,9 +2,11 @@ package openshift
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
@@ -19,16 +21,20 @@ func (o *OpenshiftApplication) List(opts appTypes.ListOptions) ([]appTypes.Appli
 	if err != nil {
 		return nil, err
 	}
+	pods = common.FilterPodsByCreated(pods, opts.Since, opts.Until, time.Now())
 
 	// if no pods are present and also if appName is provided then simply log and return
 	if len(pods) == 0 {
-		logger.Infof("No Pods found for the given application name: %s", opts.ApplicationName)
+		common.Redraw(opts)
+		logger.Infoln(errdefs.NoPodsFound(opts.ApplicationName).Error())
 
 		return nil, nil
 	}
 
 	// set table headers and rows
-	common.PopulateTable(o.runtime, opts, pods)
+	if err := common.PopulateTable(o.runtime, opts, pods); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
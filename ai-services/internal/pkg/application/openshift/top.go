@@ -0,0 +1,13 @@
+package openshift
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// Top displays the resource usage of the application containers.
+func (o *OpenshiftApplication) Top(opts types.TopOptions) error {
+	logger.Warningln("Not supported for openshift runtime")
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package openshift
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Wait blocks until the application pods reach the requested condition or the timeout expires.
+func (o *OpenshiftApplication) Wait(opts appTypes.WaitOptions) error {
+	return common.WaitForPods(o.runtime, opts)
+}
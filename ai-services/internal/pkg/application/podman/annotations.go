@@ -0,0 +1,44 @@
+package podman
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const annotationsRule = "annotations"
+
+// verifyAnnotationOverridePods makes sure the annotation overrides refer to the pods of the application template.
+func (p *PodmanApplication) verifyAnnotationOverridePods(tp templates.Template, templateName, appName string, tmpls map[string]*template.Template) error {
+	if len(p.annotationOverrides) == 0 {
+		return nil
+	}
+
+	pods := make([]string, 0, len(tmpls))
+	for podTemplateFileName := range tmpls {
+		podSpec, err := p.fetchPodSpec(tp, templateName, podTemplateFileName, appName, nil, nil)
+		if err != nil {
+			return err
+		}
+		// pods are named as '<qualified app name>--<pod>' in the templates
+		pods = append(pods, strings.TrimPrefix(podSpec.Name, vars.QualifiedAppName(appName)+"--"))
+	}
+	slices.Sort(pods)
+
+	for _, pod := range slices.Sorted(maps.Keys(p.annotationOverrides)) {
+		if !slices.Contains(pods, pod) {
+			return &errdefs.ValidationError{
+				Rule: annotationsRule,
+				Err:  fmt.Errorf("annotations file refers to pod '%s' which is not part of template '%s', valid pods are: %s", pod, templateName, strings.Join(pods, ", ")),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package podman
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+)
+
+// TestMergePodAnnotations asserts that the annotation overrides are merged over the annotations of the rendered
+// manifest, without losing any other field of the pod on the round trip.
+func TestMergePodAnnotations(t *testing.T) {
+	manifest := []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: app--vllm-server
+  labels:
+    ai-services.io/application: app
+  annotations:
+    ai-services.io/ports: "8000"
+    ai-services.io/instruct--spyre-cards: "1"
+spec:
+  containers:
+  - name: instruct
+    image: vllm:latest
+`)
+
+	merged, err := mergePodAnnotations(manifest, map[string]string{
+		"ai-services.io/instruct--spyre-cards":     "2",
+		"ai-services.io/resources.instruct.memory": "64Gi",
+	})
+	if err != nil {
+		t.Fatalf("mergePodAnnotations() error = %v", err)
+	}
+
+	var pod map[string]any
+	if err := k8syaml.Unmarshal(merged, &pod); err != nil {
+		t.Fatalf("unmarshal merged manifest: %v", err)
+	}
+
+	metadata := pod["metadata"].(map[string]any)
+	wantAnnotations := map[string]any{
+		"ai-services.io/ports":                     "8000",
+		"ai-services.io/instruct--spyre-cards":     "2",
+		"ai-services.io/resources.instruct.memory": "64Gi",
+	}
+	if !reflect.DeepEqual(metadata["annotations"], wantAnnotations) {
+		t.Errorf("annotations = %v, want %v", metadata["annotations"], wantAnnotations)
+	}
+	if !reflect.DeepEqual(metadata["labels"], map[string]any{"ai-services.io/application": "app"}) {
+		t.Errorf("labels = %v, want them untouched", metadata["labels"])
+	}
+	if !strings.Contains(string(merged), "image: vllm:latest") {
+		t.Errorf("merged manifest lost the pod spec:\n%s", merged)
+	}
+}
+
+func TestVerifyAnnotationOverridePods(t *testing.T) {
+	tmpls := map[string]*template.Template{"chat-bot.yaml.tmpl": nil, "vllm-server.yaml.tmpl": nil}
+
+	tests := []struct {
+		name      string
+		overrides map[string]map[string]string
+		wantErr   string
+	}{
+		{name: "no overrides"},
+		{
+			name:      "known pods",
+			overrides: map[string]map[string]string{"chat-bot": {"ai-services.io/ports": "3001:3000"}, "vllm-server": {"ai-services.io/vllm-server--spyre-cards": "2"}},
+		},
+		{
+			name:      "unknown pod",
+			overrides: map[string]map[string]string{"milvus": {"ai-services.io/ports": "19530"}},
+			wantErr:   "annotations file refers to pod 'milvus' which is not part of template 'rag', valid pods are: chat-bot, vllm-server",
+		},
+		{
+			name:      "unknown container",
+			overrides: map[string]map[string]string{"chat-bot": {"ai-services.io/resources.ui.memory": "1Gi"}},
+			wantErr:   "container 'ui' which is not part of pod 'chat-bot'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &PodmanApplication{annotationOverrides: tt.overrides}
+
+			err := p.verifyAnnotationOverridePods(stubTemplates{}, "rag", "app", tmpls)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("verifyAnnotationOverridePods() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			var validationErr *errdefs.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Rule != annotationsRule {
+				t.Fatalf("verifyAnnotationOverridePods() error = %v, want a validation error of rule %q", err, annotationsRule)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("verifyAnnotationOverridePods() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -18,8 +18,10 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/specs"
@@ -33,44 +35,68 @@ var (
 	extraContainerReadinessTimeout = 5 * time.Minute
 	containerCreationTimeout       = 10 * time.Minute
 	envMutex                       sync.Mutex
+	// spyreAllocations records the PCI addresses of the Spyre cards allocated per pod, guarded by envMutex.
+	spyreAllocations = map[string][]string{}
+	// kubePlay deploys a rendered pod manifest, a variable so that the tests can deploy to the fake runtime.
+	kubePlay = podman.RunPodmanKubePlay
+)
+
+// spyreLockPath and findFreeSpyreCards are variables, so that the tests can allocate cards without the host.
+var (
+	spyreLockPath      = constants.SpyreLockPath
+	findFreeSpyreCards = helpers.FindFreeSpyreCards
+	// spyreLockRetryInterval is the interval in which a create waiting on the Spyre card allocation of another one retries the lock.
+	spyreLockRetryInterval = 2 * time.Second
 )
 
 // Create deploys a new application based on a template.
 func (p *PodmanApplication) Create(ctx context.Context, opts types.CreateOptions) error {
-	// Proceed to create application
-	logger.Infof("Creating application '%s' using template '%s'\n", opts.Name, opts.TemplateName)
-
-	// set SMT level to target value
-	s := spinner.New("Checking SMT level")
-	s.Start(ctx)
-	err := p.setSMTLevel(opts.TemplateName)
-	if err != nil {
-		s.Fail("failed to set SMT level")
-
-		return fmt.Errorf("failed to set SMT level: %w", err)
+	p.strict = opts.Strict
+	if opts.FromFile != "" {
+		return p.createFromFile(ctx, opts)
 	}
-	s.Stop("SMT level configured successfully")
 
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
-
-	// validate whether the provided template name is correct
-	if err := validators.ValidateAppTemplateExist(tp, opts.TemplateName); err != nil {
-		return err
+	p.annotationOverrides = opts.AnnotationOverrides
+	p.outputDir = opts.OutputDir
+	p.dryRun = opts.DryRun
+	p.envFile = opts.EnvFile
+	p.envFileContainers = opts.EnvFileContainers
+
+	// validate whether the provided template name is correct and use its canonical name from here on
+	templateName, err := templates.ResolveTemplate(tp, opts.TemplateName)
+	if err != nil {
+		return &errdefs.TemplateError{Template: opts.TemplateName, Err: err}
 	}
+	opts.TemplateName = templateName
 
+	// Proceed to create application
+	logger.Infof("Creating application '%s' using template '%s'\n", opts.Name, opts.TemplateName)
+
+	// All the cheap validations run first, so that the create fails fast before changing the SMT level
+	// or downloading gigabytes of images and models.
 	tmpls, err := tp.LoadAllTemplates(opts.TemplateName)
 	if err != nil {
-		return fmt.Errorf("failed to parse the templates: %w", err)
+		return &errdefs.TemplateError{Template: opts.TemplateName, Err: fmt.Errorf("failed to parse the templates: %w", err)}
 	}
 
 	// load metadata.yml to read the app metadata
 	appMetadata, err := tp.LoadMetadata(opts.TemplateName, true)
 	if err != nil {
-		return fmt.Errorf("failed to read the app metadata: %w", err)
+		return &errdefs.TemplateError{Template: opts.TemplateName, Err: fmt.Errorf("failed to read the app metadata: %w", err)}
 	}
 
 	if err := p.verifyPodTemplateExists(tmpls, appMetadata); err != nil {
-		return fmt.Errorf("failed to verify pod template: %w", err)
+		return &errdefs.TemplateError{Template: opts.TemplateName, Err: fmt.Errorf("failed to verify pod template: %w", err)}
+	}
+
+	if err := templates.ValidateDependencies(appMetadata); err != nil {
+		return &errdefs.TemplateError{Template: opts.TemplateName, Err: fmt.Errorf("invalid pod template dependencies: %w", err)}
+	}
+
+	layers, err := selectLayers(appMetadata, opts.FromLayer, opts.OnlyLayer)
+	if err != nil {
+		return err
 	}
 
 	// Check if pods already exists with the given application name
@@ -80,65 +106,271 @@ func (p *PodmanApplication) Create(ctx context.Context, opts types.CreateOptions
 	}
 
 	// if all the pods for given application are already deployed, just log and do not proceed further
-	if len(existingPods) == len(tmpls) {
+	if len(existingPods) == len(tmpls) && opts.OnlyLayer == 0 {
 		logger.Infof("Pods for given app: %s are already deployed. Please use 'ai-services application ps %s' to see the pods deployed\n", opts.Name, opts.Name)
 
 		return nil
 	}
 
+	// pod names are unique per host, so make sure they are not taken by an application of another namespace
+	if err := p.verifyPodNamesAvailable(tp, opts.TemplateName, opts.Name, tmpls, existingPods); err != nil {
+		return err
+	}
+
+	if err := p.verifyStartPeriodPods(tp, opts.TemplateName, opts.Name, tmpls, opts.StartPeriods); err != nil {
+		return err
+	}
+
+	if err := p.verifyHostPortsAvailable(tp, opts, tmpls, existingPods); err != nil {
+		return err
+	}
+
+	if err := p.verifyAnnotationOverridePods(tp, opts.TemplateName, opts.Name, tmpls); err != nil {
+		return err
+	}
+
+	if err := p.verifyResourceAnnotations(tp, opts, tmpls); err != nil {
+		return err
+	}
+
+	if err := p.verifyEnvFileContainers(tp, opts, tmpls); err != nil {
+		return err
+	}
+
+	// the skipped layers are assumed to be healthy, as the selected layers depend on them
+	if err := p.verifyPrecedingLayers(tp, opts, appMetadata, layers); err != nil {
+		return err
+	}
+
+	// remove the pods of the layer before the Spyre allocation, so that their cards are free again
+	if opts.OnlyLayer > 0 && !opts.DryRun {
+		if err := p.removeLayerPods(tp, opts, appMetadata, layers, existingPods); err != nil {
+			return err
+		}
+	}
+
 	// ---- Validate Spyre card Requirements ----
-	pciAddresses, err := p.validateAndAllocateSpyreCards(opts.TemplateName, opts.Name, tmpls)
+	// the free cards are checked before preparing the host to fail fast, but only allocated under the lock
+	// once the images and models are ready, so that a concurrent create is not blocked by the downloads
+	reqSpyreCardsCount, podRequests, err := p.calculateReqSpyreCards(tp, utils.ExtractMapKeys(layers.podTemplates(appMetadata, tmpls)), opts.TemplateName, opts.Name)
 	if err != nil {
+		return fmt.Errorf("failed to calculateReqSpyreCards: %w", err)
+	}
+	if _, _, err := p.findSpyreCards(reqSpyreCardsCount, podRequests, opts.AllowReducedSpyre); err != nil {
 		return err
 	}
 
-	if err := p.prepareApplicationArtifacts(ctx, opts); err != nil {
+	// a dry run only renders the manifests, hence it must not change the host
+	if !opts.DryRun {
+		if err := p.prepareHost(ctx, opts); err != nil {
+			return err
+		}
+	}
+
+	defer p.releaseSpyreAllocation()
+	pciAddresses, err := p.allocateSpyreCards(ctx, reqSpyreCardsCount, podRequests, opts.AllowReducedSpyre)
+	if err != nil {
 		return err
 	}
 
 	// Loop through all pod templates, render and run kube play
-	logger.Infof("Total Pod Templates to be processed: %d\n", len(tmpls))
+	logger.Infoln(fmt.Sprintf("Total Pod Templates to be processed: %d", len(tmpls)))
 
-	return p.deployApplication(ctx, opts, tmpls, appMetadata, pciAddresses)
+	return p.deployApplication(ctx, opts, tmpls, appMetadata, pciAddresses, layers)
 }
 
-func (p *PodmanApplication) validateAndAllocateSpyreCards(templateName, appName string, tmpls map[string]*template.Template) ([]string, error) {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+// prepareHost sets the SMT level and downloads the images and models of the application.
+func (p *PodmanApplication) prepareHost(ctx context.Context, opts types.CreateOptions) error {
+	if !opts.SkipModelDownload {
+		models, err := helpers.ListModels(opts.TemplateName, opts.Name)
+		if err != nil {
+			return err
+		}
 
-	reqSpyreCardsCount, err := p.calculateReqSpyreCards(tp, utils.ExtractMapKeys(tmpls), templateName, appName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to calculateReqSpyreCards: %w", err)
+		if err := verifyModelDiskSpace(ctx, modelDirectory(opts), models); err != nil {
+			return err
+		}
+	}
+
+	// set SMT level to target value
+	s := spinner.New("Checking SMT level")
+	s.Start(ctx)
+	if err := p.setSMTLevel(opts.TemplateName, opts.Name, opts.Force); err != nil {
+		s.Fail("failed to set SMT level")
+
+		return fmt.Errorf("failed to set SMT level: %w", err)
+	}
+	s.Stop("SMT level configured successfully")
+
+	return p.prepareApplicationArtifacts(ctx, opts)
+}
+
+func (p *PodmanApplication) verifyPodNamesAvailable(tp templates.Template, templateName, appName string, tmpls map[string]*template.Template, existingPods []string) error {
+	for podTemplateFileName := range tmpls {
+		podSpec, err := p.fetchPodSpec(tp, templateName, podTemplateFileName, appName, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(existingPods, podSpec.Name) {
+			continue
+		}
+
+		exists, err := p.runtime.PodExists(podSpec.Name)
+		if err != nil {
+			return fmt.Errorf("failed to check pod status: %w", err)
+		}
+
+		if exists {
+			return fmt.Errorf("pod '%s' already exists outside the namespace '%s', please use a different application name", podSpec.Name, vars.Namespace)
+		}
 	}
 
+	return nil
+}
+
+// verifyStartPeriodPods makes sure the start period overrides refer to the pods of the application.
+func (p *PodmanApplication) verifyStartPeriodPods(tp templates.Template, templateName, appName string, tmpls map[string]*template.Template, startPeriods map[string]time.Duration) error {
+	if len(startPeriods) == 0 {
+		return nil
+	}
+
+	podNames := make([]string, 0, len(tmpls))
+	for podTemplateFileName := range tmpls {
+		podSpec, err := p.fetchPodSpec(tp, templateName, podTemplateFileName, appName, nil, nil)
+		if err != nil {
+			return err
+		}
+		podNames = append(podNames, podSpec.Name)
+	}
+
+	for podName := range startPeriods {
+		if !slices.Contains(podNames, podName) {
+			slices.Sort(podNames)
+
+			return fmt.Errorf("invalid start period override: pod '%s' is not part of the application, valid pods are: %s",
+				podName, strings.Join(podNames, ", "))
+		}
+	}
+
+	return nil
+}
+
+// allocateSpyreCards validates that the required count of Spyre cards is free and returns their PCI addresses.
+// If allowReduced is set and not enough cards are free, the containers of podRequests get fewer cards than declared.
+// The allocation is locked until releaseSpyreAllocation, refer lockSpyreAllocation.
+func (p *PodmanApplication) allocateSpyreCards(ctx context.Context, reqSpyreCardsCount int, podRequests map[string]map[string]int, allowReduced bool) ([]string, error) {
 	if reqSpyreCardsCount == 0 {
 		return nil, nil
 	}
 
+	if err := p.lockSpyreAllocation(ctx); err != nil {
+		return nil, err
+	}
+
+	pciAddresses, reductions, err := p.findSpyreCards(reqSpyreCardsCount, podRequests, allowReduced)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reductions) > 0 {
+		recordSpyreReductions(reductions)
+		reqSpyreCardsCount = len(pciAddresses)
+	}
+
+	metrics.SpyreCardsAllocated.Set(float64(reqSpyreCardsCount))
+
+	return pciAddresses, nil
+}
+
+// findSpyreCards returns the PCI addresses of the free Spyre cards, after validating that enough of them are free.
+// If allowReduced is set and not enough cards are free, it also returns the reductions of the containers of podRequests.
+// The cards found are not reserved, refer allocateSpyreCards.
+func (p *PodmanApplication) findSpyreCards(reqSpyreCardsCount int, podRequests map[string]map[string]int,
+	allowReduced bool) ([]string, map[string]map[string]spyreReduction, error) {
+	if reqSpyreCardsCount == 0 {
+		return nil, nil, nil
+	}
+
 	// calculate the actual available spyre cards
-	pciAddresses, err := helpers.FindFreeSpyreCards()
+	pciAddresses, err := findFreeSpyreCards()
 	if err != nil {
-		return nil, fmt.Errorf("failed to find free Spyre Cards: %w", err)
+		return nil, nil, fmt.Errorf("failed to find free Spyre Cards: %w", err)
 	}
 
 	actualSpyreCardsCount := len(pciAddresses)
 
 	// validate spyre card requirements
-	if err := p.validateSpyreCardRequirements(reqSpyreCardsCount, actualSpyreCardsCount); err != nil {
-		return nil, err
+	err = p.validateSpyreCardRequirements(reqSpyreCardsCount, actualSpyreCardsCount)
+	if err == nil {
+		return pciAddresses, nil, nil
 	}
 
-	return pciAddresses, nil
+	if !allowReduced {
+		return nil, nil, &errdefs.SpyreError{
+			Required:  reqSpyreCardsCount,
+			Available: actualSpyreCardsCount,
+			Err:       fmt.Errorf("%w, found %d free. Use --allow-reduced-spyre to deploy with fewer spyre cards", err, actualSpyreCardsCount),
+		}
+	}
+
+	if p.strict {
+		return nil, nil, &errdefs.SpyreError{
+			Required:  reqSpyreCardsCount,
+			Available: actualSpyreCardsCount,
+			Err:       fmt.Errorf("%w, found %d free. A reduced spyre allocation is not allowed in strict mode", err, actualSpyreCardsCount),
+		}
+	}
+
+	reductions, err := planSpyreReduction(podRequests, actualSpyreCardsCount)
+	if err != nil {
+		return nil, nil, &errdefs.SpyreError{Required: reqSpyreCardsCount, Available: actualSpyreCardsCount, Err: err}
+	}
+
+	return pciAddresses, reductions, nil
+}
+
+// lockSpyreAllocation serializes the Spyre card allocation with the concurrent creates of the host.
+// A card only turns busy once a container opens it, hence the lock is held until the pods are deployed,
+// refer releaseSpyreAllocation, so that a concurrent create cannot find the same cards free meanwhile.
+func (p *PodmanApplication) lockSpyreAllocation(ctx context.Context) error {
+	if p.spyreLock != nil {
+		return nil
+	}
+
+	lock, err := utils.LockFile(ctx, spyreLockPath, spyreLockRetryInterval, func() {
+		logger.Infoln("Waiting for another application create to finish allocating Spyre cards...")
+	})
+	if err != nil {
+		return fmt.Errorf("failed to lock the Spyre card allocation: %w", err)
+	}
+	p.spyreLock = lock
+
+	return nil
+}
+
+// releaseSpyreAllocation releases the lock of the Spyre card allocation, if held.
+func (p *PodmanApplication) releaseSpyreAllocation() {
+	if err := p.spyreLock.Unlock(); err != nil {
+		logger.Warningf("failed to release the Spyre card allocation lock: %v\n", err)
+	}
+	p.spyreLock = nil
 }
 
 func (p *PodmanApplication) prepareApplicationArtifacts(ctx context.Context, opts types.CreateOptions) error {
 	// Download Container Images
-	if err := p.downloadImagesForTemplate(opts.TemplateName, opts.Name, opts.ImagePullPolicy); err != nil {
+	if err := p.downloadImagesForTemplate(ctx, opts.TemplateName, opts.Name, opts.ImagePullPolicy); err != nil {
 		return err
 	}
 
 	// Download models if flag is set to true(default: true)
 	if !opts.SkipModelDownload {
-		if err := p.downloadModels(ctx, opts.TemplateName, opts.Name); err != nil {
+		downloadOpts := helpers.ModelDownloadOptions{
+			Timeout:      opts.ModelDownloadTimeout,
+			TotalTimeout: opts.ModelDownloadTotalTimeout,
+			Quiet:        opts.QuietModels,
+		}
+		if err := p.downloadModels(ctx, opts.TemplateName, opts.Name, modelDirectory(opts), downloadOpts); err != nil {
 			return err
 		}
 	}
@@ -146,9 +378,8 @@ func (p *PodmanApplication) prepareApplicationArtifacts(ctx context.Context, opt
 	return nil
 }
 
-func (p *PodmanApplication) deployApplication(ctx context.Context, opts types.CreateOptions, tmpls map[string]*template.Template, appMetadata *templates.AppMetadata, pciAddresses []string) error {
-	logger.Infof("Total Pod Templates to be processed: %d\n", len(tmpls))
-
+func (p *PodmanApplication) deployApplication(ctx context.Context, opts types.CreateOptions, tmpls map[string]*template.Template,
+	appMetadata *templates.AppMetadata, pciAddresses []string, layers layerSelection) error {
 	s := spinner.New("Deploying application '" + opts.Name + "'...")
 	s.Start(ctx)
 
@@ -160,14 +391,37 @@ func (p *PodmanApplication) deployApplication(ctx context.Context, opts types.Cr
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 
 	// execute the pod Templates
-	if err := p.executePodTemplates(tp, opts.Name, appMetadata, tmpls, pciAddresses, existingPods, opts.ValuesFiles, opts.ArgParams); err != nil {
+	pause := newLayerPause(opts.PauseBetweenLayers, opts.LayerDelay)
+	if err := p.executePodTemplates(ctx, tp, podTemplatesOptions{
+		appName:      opts.Name,
+		appMetadata:  appMetadata,
+		tmpls:        tmpls,
+		pciAddresses: pciAddresses,
+		existingPods: existingPods,
+		valuesFiles:  opts.ValuesFiles,
+		argParams:    opts.ArgParams,
+		labels:       opts.Labels,
+		modelDir:     modelDirectory(opts),
+		readiness:    newReadinessOptions(opts),
+		concurrency:  opts.DeployConcurrency,
+		layers:       layers,
+		pause:        pause,
+	}); err != nil {
 		return err
 	}
 
+	if opts.DryRun {
+		s.Stop("Application '" + opts.Name + "' rendered to '" + opts.OutputDir + "' without deploying it")
+
+		return nil
+	}
+
 	s.Stop("Application '" + opts.Name + "' deployed successfully")
 
 	logger.Infoln("-------")
 
+	p.printCreateSummary(opts)
+
 	// print the next steps to be performed at the end of create
 	if err := helpers.PrintNextSteps(p.runtime, opts.Name, opts.TemplateName); err != nil {
 		// do not want to fail the overall create if we cannot print next steps
@@ -179,7 +433,16 @@ func (p *PodmanApplication) deployApplication(ctx context.Context, opts types.Cr
 	return nil
 }
 
-func (p *PodmanApplication) downloadModels(ctx context.Context, templateName, appName string) error {
+// modelDirectory returns the host directory of the models, either the one passed via --model-dir or the default one.
+func modelDirectory(opts types.CreateOptions) string {
+	if opts.ModelDir != "" {
+		return opts.ModelDir
+	}
+
+	return vars.ModelDirectory
+}
+
+func (p *PodmanApplication) downloadModels(ctx context.Context, templateName, appName, modelDir string, downloadOpts helpers.ModelDownloadOptions) error {
 	s := spinner.New("Downloading models as part of application creation...")
 	s.Start(ctx)
 
@@ -190,26 +453,35 @@ func (p *PodmanApplication) downloadModels(ctx context.Context, templateName, ap
 		return err
 	}
 
-	logger.Infoln("Downloading models required for application template " + templateName + ":")
+	if !downloadOpts.Quiet {
+		logger.Infoln("Downloading models required for application template " + templateName + ":")
+	}
 
-	for _, model := range models {
-		s.UpdateMessage("Downloading model: " + model + "...")
-		err = utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
-			return helpers.DownloadModel(model, vars.ModelDirectory)
-		})
-		if err != nil {
-			s.Fail("failed to download model: " + model)
+	var current string
+	downloaded := 0
+	err = helpers.DownloadModels(ctx, models, modelDir, downloadOpts, func(model string) {
+		current = model
+		if downloadOpts.Quiet {
+			// the models downloaded so far, as the callback runs before each download
+			s.UpdateMessage(fmt.Sprintf("Downloading models: %d of %d done...", downloaded, len(models)))
+			downloaded++
 
-			return fmt.Errorf("failed to download model: %w", err)
+			return
 		}
+		s.UpdateMessage("Downloading model: " + model + "...")
+	})
+	if err != nil {
+		s.Fail("failed to download model: " + current)
+
+		return err
 	}
 
-	s.Stop("Model download completed.")
+	s.Stop(fmt.Sprintf("Model download completed: %d model(s).", len(models)))
 
 	return nil
 }
 
-func (p *PodmanApplication) setSMTLevel(templateName string) error {
+func (p *PodmanApplication) setSMTLevel(templateName, appName string, force bool) error {
 	// 1. Fetch Current SMT level
 	cmd := exec.Command("ppc64_cpu", "--smt")
 	out, err := cmd.CombinedOutput()
@@ -243,7 +515,12 @@ func (p *PodmanApplication) setSMTLevel(templateName string) error {
 		return nil
 	}
 
-	// 4. Set SMT level to target value
+	// 4. Make sure the change does not disrupt the other running applications, as the SMT level is host-global
+	if err := p.verifySMTChange(appName, *targetSMTLevel, force); err != nil {
+		return err
+	}
+
+	// 5. Set SMT level to target value
 	arg := "--smt=" + strconv.Itoa(*targetSMTLevel)
 	cmd = exec.Command("ppc64_cpu", arg)
 	out, err = cmd.CombinedOutput()
@@ -251,7 +528,7 @@ func (p *PodmanApplication) setSMTLevel(templateName string) error {
 		return fmt.Errorf("failed to set SMT level: %v, output: %s", err, string(out))
 	}
 
-	// 5. Verify again
+	// 6. Verify again
 	cmd = exec.Command("ppc64_cpu", "--smt")
 	out, err = cmd.CombinedOutput()
 	if err != nil {
@@ -328,21 +605,24 @@ func (p *PodmanApplication) validateSpyreCardRequirements(req int, actual int) e
 	return nil
 }
 
-func (p *PodmanApplication) calculateReqSpyreCards(tp templates.Template, podTemplateFileNames []string, appTemplateName, appName string) (int, error) {
+// calculateReqSpyreCards returns the total count of Spyre cards required by the pods yet to be deployed,
+// along with the count per container. Key -> pod name, Value -> count per container name.
+func (p *PodmanApplication) calculateReqSpyreCards(tp templates.Template, podTemplateFileNames []string, appTemplateName, appName string) (int, map[string]map[string]int, error) {
 	totalReqSpyreCounts := 0
+	podRequests := map[string]map[string]int{}
 
 	// Calculate Req Spyre Counts
 	for _, podTemplateFileName := range podTemplateFileNames {
 		// fetch pod spec
 		podSpec, err := p.fetchPodSpec(tp, appTemplateName, podTemplateFileName, appName, nil, nil)
 		if err != nil {
-			return totalReqSpyreCounts, fmt.Errorf("failed to load pod Template: '%s' for appTemplate: '%s' with error: %w", podTemplateFileName, appTemplateName, err)
+			return totalReqSpyreCounts, podRequests, fmt.Errorf("failed to load pod Template: '%s' for appTemplate: '%s' with error: %w", podTemplateFileName, appTemplateName, err)
 		}
 
 		// check if pod already exists and skip counting if it does exists
 		exists, err := p.runtime.PodExists(podSpec.Name)
 		if err != nil {
-			return totalReqSpyreCounts, fmt.Errorf("failed to check pod status: %w", err)
+			return totalReqSpyreCounts, podRequests, fmt.Errorf("failed to check pod status: %w", err)
 		}
 
 		if exists {
@@ -352,15 +632,16 @@ func (p *PodmanApplication) calculateReqSpyreCards(tp templates.Template, podTem
 		}
 
 		// fetch the spyreCount for all containers from the annotations
-		spyreCount, _, err := p.fetchSpyreCardsFromPodAnnotations(podSpec.Annotations)
+		spyreCount, spyreCardContainerMap, err := p.fetchSpyreCardsFromPodAnnotations(podSpec.Annotations)
 		if err != nil {
-			return totalReqSpyreCounts, err
+			return totalReqSpyreCounts, podRequests, err
 		}
 
 		totalReqSpyreCounts += spyreCount
+		podRequests[podSpec.Name] = spyreCardContainerMap
 	}
 
-	return totalReqSpyreCounts, nil
+	return totalReqSpyreCounts, podRequests, nil
 }
 
 func (p *PodmanApplication) fetchPodSpec(tp templates.Template, appTemplateName, podTemplateFileName, appName string, valuesFiles []string, argParams map[string]string) (*models.PodSpec, error) {
@@ -369,6 +650,10 @@ func (p *PodmanApplication) fetchPodSpec(tp templates.Template, appTemplateName,
 		return nil, fmt.Errorf("failed to load pod Template: '%s' for appTemplate: '%s' with error: %w", podTemplateFileName, appTemplateName, err)
 	}
 
+	if err := specs.ApplyAnnotationOverrides(podSpec, appName, p.annotationOverrides); err != nil {
+		return nil, &errdefs.ValidationError{Rule: annotationsRule, Err: err}
+	}
+
 	return podSpec, nil
 }
 
@@ -401,50 +686,96 @@ func (p *PodmanApplication) fetchSpyreCardsFromPodAnnotations(annotations map[st
 	return spyreCards, spyreCardContainerMap, nil
 }
 
-func (p *PodmanApplication) downloadImagesForTemplate(templateName, appName string, imagePullPolicy image.ImagePullPolicy) error {
+func (p *PodmanApplication) downloadImagesForTemplate(ctx context.Context, templateName, appName string, imagePullPolicy image.ImagePullPolicy) error {
 	// create a new imagePull object based on imagePullPolicy
 	imagePull := image.NewImagePull(p.runtime, imagePullPolicy, appName, templateName)
 
 	// based on the imagePullPolicy set, download the images
-	return imagePull.Run()
+	return imagePull.Run(ctx)
+}
+
+// podTemplatesOptions are the parameters of the rendering and the deploy of the pod templates of an application.
+type podTemplatesOptions struct {
+	appName     string
+	appMetadata *templates.AppMetadata
+	tmpls       map[string]*template.Template
+	// pciAddresses are the free Spyre cards, taken by the deployed pods under envMutex.
+	pciAddresses []string
+	// existingPods are skipped, as they are already deployed.
+	existingPods []string
+	valuesFiles  []string
+	argParams    map[string]string
+	// labels are merged into the labels of each rendered pod.
+	labels map[string]string
+	// modelDir is the host directory the models are mounted from.
+	modelDir  string
+	readiness readinessOptions
+	// concurrency is the maximum number of pods of a layer deployed concurrently.
+	concurrency int
+	layers      layerSelection
+	pause       layerPause
 }
 
-func (p *PodmanApplication) executePodTemplates(tp templates.Template,
-	appName string, appMetadata *templates.AppMetadata,
-	tmpls map[string]*template.Template, pciAddresses []string, existingPods []string,
-	valuesFiles []string, argParams map[string]string) error {
+func (p *PodmanApplication) executePodTemplates(ctx context.Context, tp templates.Template, opts podTemplatesOptions) error {
+	appName, appMetadata := opts.appName, opts.appMetadata
+
 	// Load values for template rendering
-	values, err := tp.LoadValues(appMetadata.Name, valuesFiles, argParams)
+	values, err := tp.LoadValues(appMetadata.Name, opts.valuesFiles, opts.argParams)
 	if err != nil {
 		return fmt.Errorf("failed to load params for application: %w", err)
 	}
 
 	globalParams := map[string]any{
 		"AppName":         appName,
+		"PodPrefix":       vars.QualifiedAppName(appName),
+		"AppDir":          vars.ApplicationDir(appName),
 		"AppTemplateName": appMetadata.Name,
 		"Version":         appMetadata.Version,
+		"Namespace":       vars.Namespace,
 		"Values":          values,
 		// Key -> container name
 		// Value -> range of key-value env pairs
 		"env": map[string]map[string]string{},
+		// Key -> hostpath annotation name
+		// Value -> app scoped host directory
+		"hostPaths": map[string]string{},
+		// custom labels merged into the labels of each rendered pod
+		"Labels": opts.labels,
+		// host directory the models are mounted from
+		"ModelDirectory": opts.modelDir,
 	}
 
 	// looping over each layer of podTemplateExecutions
 	for i, layer := range appMetadata.PodTemplateExecutions {
+		if !opts.layers.contains(i) {
+			logger.Infof("\n Skipping Layer %d/%d: %v\n", i+1, len(appMetadata.PodTemplateExecutions), layer)
+
+			continue
+		}
+
 		logger.Infof("\n Executing Layer %d/%d: %v\n", i+1, len(appMetadata.PodTemplateExecutions), layer)
 		logger.Infoln("-------")
+
+		// the pods of a layer are deployed by a bounded pool of workers, so that a large layer does not overwhelm the podman socket
+		workers := min(max(opts.concurrency, 1), len(layer))
+
+		podTemplateCh := make(chan string, len(layer))
+		for _, podTemplateName := range layer {
+			podTemplateCh <- podTemplateName
+		}
+		close(podTemplateCh)
+
 		var wg sync.WaitGroup
 		errCh := make(chan error, len(layer))
 
-		// for each layer, fetch all the pod Template Names and do the pod deploy
-		for _, podTemplateName := range layer {
-			wg.Add(1)
-			go func(t string) {
-				defer wg.Done()
-				if err := p.executePodTemplateLayer(tp, tmpls, globalParams, pciAddresses, existingPods, podTemplateName, appName, valuesFiles, argParams); err != nil {
-					errCh <- err
+		for range workers {
+			wg.Go(func() {
+				for podTemplateName := range podTemplateCh {
+					if err := p.executePodTemplateLayer(ctx, tp, &opts, globalParams, podTemplateName); err != nil {
+						errCh <- err
+					}
 				}
-			}(podTemplateName)
+			})
 		}
 
 		wg.Wait()
@@ -462,26 +793,35 @@ func (p *PodmanApplication) executePodTemplates(tp templates.Template,
 		}
 
 		logger.Infof("Layer %d completed\n", i+1)
+
+		// pause before the next selected layer, there is nothing left to inspect after the last one
+		if opts.layers.contains(i + 1) {
+			if err := opts.pause.wait(ctx, p, appName, i+1, len(appMetadata.PodTemplateExecutions)); err != nil {
+				return fmt.Errorf("deploy interrupted after layer %d: %w", i+1, err)
+			}
+		}
 	}
 
 	return nil
 }
 
-func (p *PodmanApplication) executePodTemplateLayer(tp templates.Template, tmpls map[string]*template.Template,
-	globalParams map[string]any, pciAddresses []string, existingPods []string, podTemplateName, appName string,
-	valuesFiles []string, argParams map[string]string) error {
+// executePodTemplateLayer renders and deploys a single pod template. The Spyre cards of the pod are taken from
+// opts.pciAddresses, which is shared by the concurrently deployed pods and guarded by envMutex.
+func (p *PodmanApplication) executePodTemplateLayer(ctx context.Context, tp templates.Template, opts *podTemplatesOptions,
+	globalParams map[string]any, podTemplateName string) error {
+	appName := opts.appName
 	logger.Infof("'%s': Processing template...\n", podTemplateName)
 
 	// Shallow Copy globalParams Map
 	params := utils.CopyMap(globalParams)
 
 	// fetch pod Spec
-	podSpec, err := p.fetchPodSpec(tp, globalParams["AppTemplateName"].(string), podTemplateName, appName, valuesFiles, argParams)
+	podSpec, err := p.fetchPodSpec(tp, globalParams["AppTemplateName"].(string), podTemplateName, appName, opts.valuesFiles, opts.argParams)
 	if err != nil {
 		return err
 	}
 
-	if slices.Contains(existingPods, podSpec.Name) {
+	if slices.Contains(opts.existingPods, podSpec.Name) {
 		logger.Infof("%s: Skipping pod deploy as '%s' it already exists", podTemplateName, podSpec.Name)
 
 		return nil
@@ -491,25 +831,84 @@ func (p *PodmanApplication) executePodTemplateLayer(tp templates.Template, tmpls
 	podAnnotations := p.fetchPodAnnotations(podSpec)
 
 	// get the env params for a given pod
-	env, err := p.returnEnvParamsForPod(podSpec, podAnnotations, &pciAddresses)
+	env, err := p.returnEnvParamsForPod(podSpec, podAnnotations, &opts.pciAddresses)
 	if err != nil {
 		return fmt.Errorf("'%s': Failed to fetch env params: %w", podTemplateName, err)
 	}
 	params["env"] = env
 
-	podTemplate := tmpls[podTemplateName]
+	hostPaths, err := p.prepareHostPaths(appName, podAnnotations)
+	if err != nil {
+		return fmt.Errorf("'%s': Failed to prepare host paths: %w", podTemplateName, err)
+	}
+	params["hostPaths"] = hostPaths
+
+	podTemplate := opts.tmpls[podTemplateName]
 
 	var rendered bytes.Buffer
 	if err := podTemplate.Execute(&rendered, params); err != nil {
-		return fmt.Errorf("'%s': Failed to parse pod template: %w", podTemplateName, err)
+		return &errdefs.TemplateError{
+			Template: globalParams["AppTemplateName"].(string),
+			Err:      fmt.Errorf("'%s': Failed to parse pod template: %w", podTemplateName, err),
+		}
+	}
+
+	manifest := rendered.Bytes()
+	if labels, _ := params["Labels"].(map[string]string); len(labels) > 0 {
+		manifest, err = mergePodLabels(manifest, labels)
+		if err != nil {
+			return fmt.Errorf("'%s': Failed to apply custom labels: %w", podTemplateName, err)
+		}
+	}
+
+	if overrides := p.annotationOverrides[strings.TrimPrefix(podSpec.Name, vars.QualifiedAppName(appName)+"--")]; len(overrides) > 0 {
+		manifest, err = mergePodAnnotations(manifest, overrides)
+		if err != nil {
+			return fmt.Errorf("'%s': Failed to apply annotation overrides: %w", podTemplateName, err)
+		}
+	}
+
+	if len(p.envFile) > 0 {
+		manifest, err = applyEnvFile(manifest, p.envFile, p.envFileContainers, env)
+		if err != nil {
+			return fmt.Errorf("'%s': Failed to apply the env file: %w", podTemplateName, err)
+		}
+	}
+
+	resources, err := parseResourceAnnotations(podSpec)
+	if err != nil {
+		return fmt.Errorf("'%s': Invalid resource annotations: %w", podTemplateName, err)
+	}
+	if len(resources) > 0 {
+		manifest, err = applyResourceLimits(manifest, resources)
+		if err != nil {
+			return fmt.Errorf("'%s': Failed to apply resource limits: %w", podTemplateName, err)
+		}
+	}
+
+	if p.outputDir != "" {
+		values, _ := params["Values"].(map[string]any)
+		if err := writeRenderedManifest(p.outputDir, podSpec.Name, manifest, values); err != nil {
+			return fmt.Errorf("'%s': %w", podTemplateName, err)
+		}
+	}
+
+	if p.dryRun {
+		logger.Infof("'%s': Skipping pod deploy of '%s' as it is a dry run\n", podTemplateName, podSpec.Name)
+
+		return nil
 	}
 
 	// Wrap the bytes in a bytes.Reader
-	reader := bytes.NewReader(rendered.Bytes())
+	reader := bytes.NewReader(manifest)
 
 	// Deploy the Pod and do Readiness check
-	if err := p.deployPodAndReadinessCheck(podSpec, podTemplateName, reader, p.constructPodDeployOptions(podAnnotations)); err != nil {
-		return fmt.Errorf("'%s': Failed to deploy pod and do readiness check: %w", podTemplateName, err)
+	if err := p.deployPodAndReadinessCheck(ctx, podSpec, podTemplateName, reader, p.constructPodDeployOptions(podAnnotations), opts.readiness); err != nil {
+		return &errdefs.RuntimeError{
+			Op:  "deploy",
+			Pod: podSpec.Name,
+			Err: fmt.Errorf("'%s': Failed to deploy pod and do readiness check: %w", podTemplateName, err),
+		}
 	}
 
 	return nil
@@ -544,7 +943,18 @@ func (p *PodmanApplication) returnEnvParamsForPod(podSpec *models.PodSpec, podAn
 	envMutex.Lock()
 	for container, spyreCount := range spyreCardContainerMap {
 		if spyreCount != 0 {
-			env[container] = map[string]string{string(constants.PCIAddressKey): utils.JoinAndRemove(pciAddresses, spyreCount, " ")}
+			reduction, reduced := spyreReductions[podSpec.Name][container]
+			if reduced {
+				spyreCount = reduction.allocated
+			}
+
+			allocated := utils.JoinAndRemove(pciAddresses, spyreCount, " ")
+			env[container] = map[string]string{string(constants.PCIAddressKey): allocated}
+			if reduced {
+				// let the container adapt its tensor parallelism to the reduced card count
+				env[container][string(constants.WorldSizeKey)] = strconv.Itoa(spyreCount)
+			}
+			spyreAllocations[podSpec.Name] = append(spyreAllocations[podSpec.Name], strings.Fields(allocated)...)
 		}
 	}
 	envMutex.Unlock()
@@ -552,14 +962,28 @@ func (p *PodmanApplication) returnEnvParamsForPod(podSpec *models.PodSpec, podAn
 	return env, nil
 }
 
-func (p *PodmanApplication) deployPodAndReadinessCheck(podSpec *models.PodSpec,
-	podTemplateName string, body io.Reader, opts map[string]string) error {
-	pods, err := podman.RunPodmanKubePlay(body, opts)
+// readinessOptions holds the user overrides for the pod readiness checks.
+type readinessOptions struct {
+	startPeriods       map[string]time.Duration
+	maxStartupRestarts int
+}
+
+func newReadinessOptions(opts types.CreateOptions) readinessOptions {
+	return readinessOptions{
+		startPeriods:       opts.StartPeriods,
+		maxStartupRestarts: opts.MaxStartupRestarts,
+	}
+}
+
+func (p *PodmanApplication) deployPodAndReadinessCheck(ctx context.Context, podSpec *models.PodSpec,
+	podTemplateName string, body io.Reader, opts map[string]string, readiness readinessOptions) error {
+	pods, err := kubePlay(body, opts)
 	if err != nil {
 		return fmt.Errorf("failed pod creation: %w", err)
 	}
 
 	logger.Infof("'%s': Successfully ran podman kube play\n", podTemplateName, logger.VerbosityLevelDebug)
+	recordAssignedPorts(podSpec.Name, p.fetchHostPortMappingFromAnnotation(p.fetchPodAnnotations(podSpec)))
 
 	// ---- Pod Readiness Checks ----
 	for _, pod := range pods {
@@ -573,17 +997,23 @@ func (p *PodmanApplication) deployPodAndReadinessCheck(podSpec *models.PodSpec,
 		logger.Infof("'%s', '%s': Starting Pod Readiness check...\n", podTemplateName, podName)
 
 		// Step1: ---- Containers Creation Check ----
-		if err := p.doContainersCreationCheck(podSpec, podTemplateName, pInfo.Name, pInfo.ID); err != nil {
+		if err := p.doContainersCreationCheck(ctx, podSpec, podTemplateName, pInfo.Name, pInfo.ID); err != nil {
 			return err
 		}
 
 		// Step2: ---- Containers Readiness Check ----
+		startPeriodOverride, overridden := readiness.startPeriods[podName]
+		if overridden {
+			logger.Infof("'%s', '%s': Start period overridden to: %s\n", podTemplateName, podName, startPeriodOverride)
+		}
+
 		for _, container := range pInfo.Containers {
-			if err := p.doContainerReadinessCheck(podTemplateName, pInfo.Name, container.ID); err != nil {
+			if err := p.doContainerReadinessCheck(ctx, podTemplateName, pInfo.Name, container.ID, startPeriodOverride, readiness.maxStartupRestarts); err != nil {
 				return err
 			}
 			logger.Infoln("-------")
 		}
+		metrics.PodsDeployed.Inc()
 		logger.Infof("'%s', '%s': Pod has been successfully deployed and ready!\n", podTemplateName, podName)
 		logger.Infoln("-------")
 	}
@@ -593,14 +1023,14 @@ func (p *PodmanApplication) deployPodAndReadinessCheck(podSpec *models.PodSpec,
 	return nil
 }
 
-func (p *PodmanApplication) doContainersCreationCheck(podSpec *models.PodSpec, podTemplateName, podName, podID string) error {
+func (p *PodmanApplication) doContainersCreationCheck(ctx context.Context, podSpec *models.PodSpec, podTemplateName, podName, podID string) error {
 	logger.Infof("'%s', '%s': Performing Containers Creation check for pod...\n", podTemplateName, podName)
 
 	expectedContainerCount := len(specs.FetchContainerNames(*podSpec))
 
 	logger.Infof("'%s', '%s': Waiting for Containers Creation... Timeout set: %s\n", podTemplateName, podName, containerCreationTimeout)
 	// wait for all containers for a given pod are created
-	if err := helpers.WaitForContainersCreation(p.runtime, podID, expectedContainerCount, containerCreationTimeout); err != nil {
+	if err := helpers.WaitForContainersCreation(ctx, p.runtime, podID, expectedContainerCount, containerCreationTimeout); err != nil {
 		return fmt.Errorf("containers creation check failed for pod: '%s' with error: %w", podName, err)
 	}
 
@@ -609,7 +1039,9 @@ func (p *PodmanApplication) doContainersCreationCheck(podSpec *models.PodSpec, p
 	return nil
 }
 
-func (p *PodmanApplication) doContainerReadinessCheck(podTemplateName, podName, containerID string) error {
+// doContainerReadinessCheck waits for the container to be ready, a non-zero startPeriodOverride replaces the start period set in the template.
+// The check fails early once the container restarted more than maxStartupRestarts times, a negative value disables it.
+func (p *PodmanApplication) doContainerReadinessCheck(ctx context.Context, podTemplateName, podName, containerID string, startPeriodOverride time.Duration, maxStartupRestarts int) error {
 	cInfo, err := p.runtime.InspectContainer(containerID)
 	if err != nil {
 		return fmt.Errorf("failed to do container inspect for containerID: '%s' with error: %w", containerID, err)
@@ -629,12 +1061,21 @@ func (p *PodmanApplication) doContainerReadinessCheck(podTemplateName, podName,
 		return nil
 	}
 
+	if startPeriodOverride > 0 {
+		startPeriod = startPeriodOverride
+	}
+
 	// configure readiness timeout by appending start period with additional extra timeout
 	readinessTimeout := startPeriod + extraContainerReadinessTimeout
 
+	logger.Infof("'%s', '%s', '%s': Effective start period: %s\n", podTemplateName, podName, cInfo.Name, startPeriod)
+
 	logger.Infof("'%s', '%s', '%s': Waiting for Container Readiness... Timeout set: %s\n", podTemplateName, podName, cInfo.Name, readinessTimeout)
 
-	if err := helpers.WaitForContainerReadiness(p.runtime, containerID, readinessTimeout); err != nil {
+	readinessStart := time.Now()
+	err = helpers.WaitForContainerReadiness(ctx, p.runtime, containerID, readinessTimeout, maxStartupRestarts)
+	metrics.ReadinessWaitSeconds.Add(time.Since(readinessStart).Seconds())
+	if err != nil {
 		return fmt.Errorf("readiness check failed for container: '%s'!: %w", cInfo.Name, err)
 	}
 	logger.Infof("'%s', '%s', '%s': Readiness Check for the container is completed!\n", podTemplateName, podName, cInfo.Name)
@@ -0,0 +1,182 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const podKind = "Pod"
+
+// createFromFile deploys a user provided pod manifest, skipping the templating but going through
+// the same labeling, Spyre card allocation and readiness checks as the templated pods.
+func (p *PodmanApplication) createFromFile(ctx context.Context, opts types.CreateOptions) error {
+	logger.Infof("Creating application '%s' from file '%s'\n", opts.Name, opts.FromFile)
+
+	podSpec, err := loadPodManifest(opts.FromFile)
+	if err != nil {
+		return err
+	}
+
+	if err := checkEnvFileContainers(opts.EnvFileContainers, specs.FetchContainerNames(*podSpec)); err != nil {
+		return err
+	}
+
+	if err := applyApplicationLabels(podSpec, opts.Name); err != nil {
+		return err
+	}
+	maps.Copy(podSpec.Labels, opts.Labels)
+
+	exists, err := p.runtime.PodExists(podSpec.Name)
+	if err != nil {
+		return fmt.Errorf("failed to check pod status: %w", err)
+	}
+
+	if exists {
+		existingPods, err := helpers.CheckExistingPodsForApplication(p.runtime, opts.Name)
+		if err != nil {
+			return fmt.Errorf("failed while checking existing pods for application: %w", err)
+		}
+
+		for _, existingPod := range existingPods {
+			if existingPod == podSpec.Name {
+				logger.Infof("Pod '%s' for given app: %s is already deployed. Please use 'ai-services application ps %s' to see the pods deployed\n", podSpec.Name, opts.Name, opts.Name)
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("pod '%s' already exists outside the namespace '%s', please use a different pod name", podSpec.Name, vars.Namespace)
+	}
+
+	podAnnotations := p.fetchPodAnnotations(podSpec)
+
+	// ---- Validate Spyre card Requirements ----
+	reqSpyreCardsCount, spyreCardContainerMap, err := p.fetchSpyreCardsFromPodAnnotations(podAnnotations)
+	if err != nil {
+		return err
+	}
+
+	podRequests := map[string]map[string]int{podSpec.Name: spyreCardContainerMap}
+	defer p.releaseSpyreAllocation()
+	pciAddresses, err := p.allocateSpyreCards(ctx, reqSpyreCardsCount, podRequests, opts.AllowReducedSpyre)
+	if err != nil {
+		return err
+	}
+
+	env, err := p.returnEnvParamsForPod(podSpec, podAnnotations, &pciAddresses)
+	if err != nil {
+		return fmt.Errorf("failed to fetch env params: %w", err)
+	}
+	if len(opts.EnvFile) > 0 {
+		injectContainerEnv(podSpec, envFileForContainers(podSpec, opts.EnvFile, opts.EnvFileContainers, env))
+	}
+	injectContainerEnv(podSpec, env)
+
+	// manifests are not templated, so the host paths are only created for the manifest to mount
+	if _, err := p.prepareHostPaths(opts.Name, podAnnotations); err != nil {
+		return fmt.Errorf("failed to prepare host paths: %w", err)
+	}
+
+	manifest, err := k8syaml.Marshal(podSpec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pod manifest: %w", err)
+	}
+
+	s := spinner.New("Deploying application '" + opts.Name + "'...")
+	s.Start(ctx)
+
+	manifestName := filepath.Base(opts.FromFile)
+	if err := p.deployPodAndReadinessCheck(ctx, podSpec, manifestName, bytes.NewReader(manifest), p.constructPodDeployOptions(podAnnotations), newReadinessOptions(opts)); err != nil {
+		s.Fail("failed to deploy application '" + opts.Name + "'")
+
+		return fmt.Errorf("'%s': Failed to deploy pod and do readiness check: %w", manifestName, err)
+	}
+
+	s.Stop("Application '" + opts.Name + "' deployed successfully")
+
+	p.printCreateSummary(opts)
+
+	return nil
+}
+
+// loadPodManifest reads the pod manifest from the given file.
+func loadPodManifest(path string) (*models.PodSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pod manifest: %w", err)
+	}
+
+	var spec models.PodSpec
+	if err := k8syaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("unable to read YAML as Kube Pod: %w", err)
+	}
+
+	if spec.Kind != podKind {
+		return nil, fmt.Errorf("unsupported kind '%s' in '%s', only '%s' manifests are supported", spec.Kind, path, podKind)
+	}
+
+	if spec.Name == "" {
+		return nil, fmt.Errorf("pod manifest '%s' is missing metadata.name", path)
+	}
+
+	return &spec, nil
+}
+
+// applyApplicationLabels makes sure the pod carries the ai-services labels, injecting the missing ones.
+func applyApplicationLabels(podSpec *models.PodSpec, appName string) error {
+	if podSpec.Labels == nil {
+		podSpec.Labels = map[string]string{}
+	}
+
+	if val, ok := podSpec.Labels[constants.ApplicationAnnotationKey]; ok && val != appName {
+		return fmt.Errorf("pod manifest label '%s' is set to '%s', which does not match the application name '%s'",
+			constants.ApplicationAnnotationKey, val, appName)
+	}
+	podSpec.Labels[constants.ApplicationAnnotationKey] = appName
+
+	if vars.Namespace != "" {
+		if val, ok := podSpec.Labels[constants.NamespaceLabelKey]; ok && val != vars.Namespace {
+			return fmt.Errorf("pod manifest label '%s' is set to '%s', which does not match the namespace '%s'",
+				constants.NamespaceLabelKey, val, vars.Namespace)
+		}
+		podSpec.Labels[constants.NamespaceLabelKey] = vars.Namespace
+	}
+
+	return nil
+}
+
+// injectContainerEnv sets the computed env on the containers, replacing already defined variables.
+func injectContainerEnv(podSpec *models.PodSpec, env map[string]map[string]string) {
+	for i := range podSpec.Spec.Containers {
+		container := &podSpec.Spec.Containers[i]
+		for key, val := range env[container.Name] {
+			replaced := false
+			for j := range container.Env {
+				if container.Env[j].Name == key {
+					container.Env[j].Value = val
+					container.Env[j].ValueFrom = nil
+					replaced = true
+				}
+			}
+			if !replaced {
+				container.Env = append(container.Env, v1.EnvVar{Name: key, Value: val})
+			}
+		}
+	}
+}
@@ -4,31 +4,30 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
-	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 // Delete removes an application and its associated resources.
 func (p *PodmanApplication) Delete(_ context.Context, opts appTypes.DeleteOptions) error {
-	appDir := filepath.Join(constants.ApplicationsPath, filepath.Base(opts.Name))
+	appDir := vars.ApplicationDir(opts.Name)
 	appExists := utils.FileExists(appDir)
 
-	pods, err := p.runtime.ListPods(map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", opts.Name)},
-	})
+	pods, err := helpers.ListApplicationPods(p.runtime, opts.Name)
 	if err != nil {
-		return fmt.Errorf("failed to list pods: %w", err)
+		return err
 	}
 	podsExists := len(pods) != 0
 
 	if !podsExists {
-		logger.Infof("No pods found for application: %s\n", opts.Name)
+		logger.Infoln(errdefs.NoPodsFound(opts.Name).Error())
 
 		return nil
 	}
@@ -0,0 +1,147 @@
+package podman
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// spyreTemplates renders each pod template like stubTemplates, with its container requesting a single Spyre card.
+type spyreTemplates struct {
+	stubTemplates
+}
+
+func (t spyreTemplates) LoadPodTemplateWithValues(app, file, appName string, valuesFileOverrides []string,
+	cliOverrides map[string]string) (*models.PodSpec, error) {
+	podSpec, err := t.stubTemplates.LoadPodTemplateWithValues(app, file, appName, valuesFileOverrides, cliOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	container := podSpec.Spec.Containers[0].Name
+	podSpec.Annotations = map[string]string{"ai-services.io/" + container + "--spyre-cards": "1"}
+
+	return podSpec, nil
+}
+
+// TestDeployConcurrency deploys a layer through the bounded pool of workers to the fake runtime. Run with -race,
+// it also asserts that the Spyre cards are allocated to the concurrently deployed pods without data races.
+func TestDeployConcurrency(t *testing.T) {
+	const pods = 6
+
+	tests := []struct {
+		name        string
+		concurrency int
+		wantMax     int
+	}{
+		{name: "sequential", concurrency: 1, wantMax: 1},
+		{name: "bounded", concurrency: 3, wantMax: 3},
+		{name: "more workers than pods", concurrency: 10, wantMax: pods},
+		{name: "unset concurrency deploys one by one", concurrency: 0, wantMax: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+
+			var mu sync.Mutex
+			running, maxRunning := 0, 0
+			allocated := map[string]string{}
+			r.OnCreatePod = func(body io.Reader) ([]types.Pod, error) {
+				mu.Lock()
+				running++
+				maxRunning = max(maxRunning, running)
+				mu.Unlock()
+
+				// hold the deploy, so that the deploys of the other workers overlap with it
+				time.Sleep(20 * time.Millisecond)
+
+				manifest, err := io.ReadAll(body)
+				if err != nil {
+					return nil, err
+				}
+				name, pciAddress, _ := strings.Cut(strings.TrimSpace(string(manifest)), " ")
+
+				mu.Lock()
+				running--
+				allocated[name] = pciAddress
+				mu.Unlock()
+
+				container := strings.TrimPrefix(name, "app--")
+				r.AddPod(types.Pod{ID: name + "-id", Name: name, Status: "Running", State: "Running"},
+					types.Container{ID: name + "-infra", Name: name + "-infra", Status: "running"},
+					types.Container{ID: name + "-c", Name: container, Status: "running", Health: "healthy", HealthcheckStartPeriod: time.Second})
+
+				return []types.Pod{{ID: name + "-id", Name: name}}, nil
+			}
+
+			play := kubePlay
+			t.Cleanup(func() {
+				kubePlay = play
+				envMutex.Lock()
+				clear(spyreAllocations)
+				clear(assignedPorts)
+				envMutex.Unlock()
+			})
+			kubePlay = func(body io.Reader, _ map[string]string) ([]types.Pod, error) {
+				return r.CreatePod(body)
+			}
+
+			layer := make([]string, 0, pods)
+			tmpls := map[string]*template.Template{}
+			pciAddresses := make([]string, 0, pods)
+			for i := range pods {
+				pod := fmt.Sprintf("pod%d", i)
+				layer = append(layer, pod+".yaml.tmpl")
+				// the rendered manifest is the name of the pod followed by the PCI address of its Spyre card
+				tmpls[pod+".yaml.tmpl"] = template.Must(template.New(pod).Parse(
+					`{{ .PodPrefix }}--` + pod + ` {{ range $k, $v := index .env "` + pod + `" }}{{ $v }}{{ end }}`))
+				pciAddresses = append(pciAddresses, fmt.Sprintf("0000:%02x:00.0", i))
+			}
+			appMetadata := &templates.AppMetadata{Name: "stub", PodTemplateExecutions: [][]string{layer}}
+
+			err := NewPodmanApplication(r).executePodTemplates(context.Background(), spyreTemplates{}, podTemplatesOptions{
+				appName:      "app",
+				appMetadata:  appMetadata,
+				tmpls:        tmpls,
+				pciAddresses: pciAddresses,
+				readiness:    readinessOptions{maxStartupRestarts: -1},
+				concurrency:  tt.concurrency,
+				layers:       layerSelection{start: 0, end: 1},
+			})
+			if err != nil {
+				t.Fatalf("executePodTemplates() error = %v", err)
+			}
+
+			if maxRunning != tt.wantMax {
+				t.Errorf("executePodTemplates() deployed %d pods at once, want %d", maxRunning, tt.wantMax)
+			}
+
+			if len(allocated) != pods {
+				t.Fatalf("executePodTemplates() deployed %v, want %d pods", allocated, pods)
+			}
+			cards := []string{}
+			for pod, pciAddress := range allocated {
+				if pciAddress == "" {
+					t.Errorf("executePodTemplates() allocated no Spyre card to %s", pod)
+				}
+				cards = append(cards, pciAddress)
+			}
+			slices.Sort(cards)
+			if !slices.Equal(cards, pciAddresses) {
+				t.Errorf("executePodTemplates() allocated the Spyre cards %v, want each card allocated once", cards)
+			}
+		})
+	}
+}
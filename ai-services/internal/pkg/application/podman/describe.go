@@ -0,0 +1,11 @@
+package podman
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Describe returns the combined info, pods and recent events of an application.
+func (p *PodmanApplication) Describe(opts appTypes.DescribeOptions) (*appTypes.ApplicationDescription, error) {
+	return common.Describe(p.runtime, opts)
+}
@@ -0,0 +1,130 @@
+package podman
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// envFileTargets reports whether the env file applies to the given container, i.e. all of them if none was selected.
+func envFileTargets(containers []string, name string) bool {
+	return len(containers) == 0 || slices.Contains(containers, name)
+}
+
+// verifyEnvFileContainers makes sure the containers selected for the env file are part of the application,
+// so that a typo does not silently leave the env file unused.
+func (p *PodmanApplication) verifyEnvFileContainers(tp templates.Template, opts types.CreateOptions, tmpls map[string]*template.Template) error {
+	if len(opts.EnvFileContainers) == 0 {
+		return nil
+	}
+
+	var names []string
+	for podTemplateFileName := range tmpls {
+		podSpec, err := p.fetchPodSpec(tp, opts.TemplateName, podTemplateFileName, opts.Name, opts.ValuesFiles, opts.ArgParams)
+		if err != nil {
+			return err
+		}
+		names = append(names, specs.FetchContainerNames(*podSpec)...)
+	}
+
+	return checkEnvFileContainers(opts.EnvFileContainers, names)
+}
+
+func checkEnvFileContainers(selected, names []string) error {
+	for _, container := range selected {
+		if !slices.Contains(names, container) {
+			slices.Sort(names)
+
+			return fmt.Errorf("container '%s' selected for the env file is not part of the application, available containers: %s",
+				container, strings.Join(slices.Compact(names), ", "))
+		}
+	}
+
+	return nil
+}
+
+// applyEnvFile sets the env file variables on the targeted containers of the rendered pod manifest, overriding the
+// variables set by the template, including the ones rendered from --params. The env computed from the pod annotations,
+// e.g. the Spyre PCI addresses, takes precedence over the env file, as the Spyre allocation depends on it.
+func applyEnvFile(manifest []byte, envFile map[string]string, containers []string, computed map[string]map[string]string) ([]byte, error) {
+	var pod map[string]any
+	if err := k8syaml.Unmarshal(manifest, &pod); err != nil {
+		return nil, fmt.Errorf("unable to read YAML as Kube Pod: %w", err)
+	}
+
+	spec, _ := pod["spec"].(map[string]any)
+	podContainers, _ := spec["containers"].([]any)
+
+	for _, c := range podContainers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _ := container["name"].(string)
+		if !envFileTargets(containers, name) {
+			continue
+		}
+
+		envList, _ := container["env"].([]any)
+		for _, key := range slices.Sorted(maps.Keys(envFile)) {
+			if _, ok := computed[name][key]; ok {
+				logger.Warningf("Ignoring %s of the env file for container '%s', it is computed from the pod annotations\n", key, name)
+
+				continue
+			}
+
+			envList = setEnvVar(envList, key, envFile[key])
+		}
+		container["env"] = envList
+	}
+
+	return k8syaml.Marshal(pod)
+}
+
+// setEnvVar sets the variable in the generic env list of a container, replacing an existing definition,
+// also if it was taken from a secret or a config map.
+func setEnvVar(envList []any, key, value string) []any {
+	entry := map[string]any{"name": key, "value": value}
+	for i, e := range envList {
+		if existing, ok := e.(map[string]any); ok && existing["name"] == key {
+			envList[i] = entry
+
+			return envList
+		}
+	}
+
+	return append(envList, entry)
+}
+
+// envFileForContainers returns the env file variables keyed by the targeted containers of the pod spec, in the form
+// taken by injectContainerEnv. Like for applyEnvFile, the variables computed from the pod annotations are left out.
+func envFileForContainers(podSpec *models.PodSpec, envFile map[string]string, containers []string, computed map[string]map[string]string) map[string]map[string]string {
+	env := map[string]map[string]string{}
+	for _, container := range podSpec.Spec.Containers {
+		if !envFileTargets(containers, container.Name) {
+			continue
+		}
+
+		env[container.Name] = map[string]string{}
+		for key, val := range envFile {
+			if _, ok := computed[container.Name][key]; ok {
+				logger.Warningf("Ignoring %s of the env file for container '%s', it is computed from the pod annotations\n", key, container.Name)
+
+				continue
+			}
+			env[container.Name][key] = val
+		}
+	}
+
+	return env
+}
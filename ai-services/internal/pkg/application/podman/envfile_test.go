@@ -0,0 +1,154 @@
+package podman
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+)
+
+const envFileManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: app--vllm
+spec:
+  containers:
+  - name: vllm
+    image: vllm:latest
+    env:
+    - name: LOG_LEVEL
+      value: info
+    - name: HF_TOKEN
+      valueFrom:
+        secretKeyRef:
+          name: hf
+          key: token
+  - name: ui
+    image: ui:latest
+`
+
+// TestApplyEnvFile asserts that the env file overrides the env of the template on the targeted containers only,
+// while the env computed from the pod annotations takes precedence over it.
+func TestApplyEnvFile(t *testing.T) {
+	envFile := map[string]string{"LOG_LEVEL": "debug", "HF_TOKEN": "hf_x", "AIU_WORLD_SIZE": "8"}
+	computed := map[string]map[string]string{"vllm": {"AIU_WORLD_SIZE": "4"}}
+
+	tests := []struct {
+		name       string
+		containers []string
+		want       map[string][]v1.EnvVar
+	}{
+		{
+			name: "all containers",
+			want: map[string][]v1.EnvVar{
+				"vllm": {{Name: "LOG_LEVEL", Value: "debug"}, {Name: "HF_TOKEN", Value: "hf_x"}},
+				"ui":   {{Name: "AIU_WORLD_SIZE", Value: "8"}, {Name: "HF_TOKEN", Value: "hf_x"}, {Name: "LOG_LEVEL", Value: "debug"}},
+			},
+		},
+		{
+			name:       "selected containers",
+			containers: []string{"ui"},
+			want: map[string][]v1.EnvVar{
+				"vllm": {{Name: "LOG_LEVEL", Value: "info"}, {Name: "HF_TOKEN", ValueFrom: &v1.EnvVarSource{
+					SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "hf"}, Key: "token"},
+				}}},
+				"ui": {{Name: "AIU_WORLD_SIZE", Value: "8"}, {Name: "HF_TOKEN", Value: "hf_x"}, {Name: "LOG_LEVEL", Value: "debug"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := applyEnvFile([]byte(envFileManifest), envFile, tt.containers, computed)
+			if err != nil {
+				t.Fatalf("applyEnvFile() error = %v", err)
+			}
+
+			var pod v1.Pod
+			if err := k8syaml.Unmarshal(out, &pod); err != nil {
+				t.Fatalf("applyEnvFile() returned an invalid manifest: %v", err)
+			}
+			got := map[string][]v1.EnvVar{}
+			for _, c := range pod.Spec.Containers {
+				got[c.Name] = c.Env
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyEnvFile() env = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := applyEnvFile([]byte("spec: ["), envFile, nil, nil); err == nil {
+		t.Errorf("applyEnvFile() error = nil, want the invalid manifest reported")
+	}
+}
+
+func TestEnvFileForContainers(t *testing.T) {
+	podSpec := &models.PodSpec{Pod: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "vllm"}, {Name: "ui"}}}}}
+	envFile := map[string]string{"LOG_LEVEL": "debug", "AIU_WORLD_SIZE": "8"}
+	computed := map[string]map[string]string{"vllm": {"AIU_WORLD_SIZE": "4"}}
+
+	tests := []struct {
+		name       string
+		containers []string
+		want       map[string]map[string]string
+	}{
+		{
+			name: "all containers",
+			want: map[string]map[string]string{
+				"vllm": {"LOG_LEVEL": "debug"},
+				"ui":   {"LOG_LEVEL": "debug", "AIU_WORLD_SIZE": "8"},
+			},
+		},
+		{
+			name:       "selected containers",
+			containers: []string{"vllm"},
+			want:       map[string]map[string]string{"vllm": {"LOG_LEVEL": "debug"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := envFileForContainers(podSpec, envFile, tt.containers, computed)
+			if !maps.EqualFunc(got, tt.want, maps.Equal) {
+				t.Errorf("envFileForContainers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckEnvFileContainers(t *testing.T) {
+	names := []string{"vllm", "ui", "vllm"}
+
+	tests := []struct {
+		name     string
+		selected []string
+		wantErr  string
+	}{
+		{name: "none selected"},
+		{name: "known containers", selected: []string{"ui", "vllm"}},
+		{name: "unknown container", selected: []string{"vlm"}, wantErr: "container 'vlm' selected for the env file is not part of the application, available containers: ui, vllm"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEnvFileContainers(tt.selected, slices.Clone(names))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkEnvFileContainers() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkEnvFileContainers() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
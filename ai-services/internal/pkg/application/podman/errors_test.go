@@ -0,0 +1,63 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	rtTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// TestCreateTemplateError asserts that an unknown template fails the create with a TemplateError.
+func TestCreateTemplateError(t *testing.T) {
+	r := fake.New()
+	err := NewPodmanApplication(r).Create(context.Background(), types.CreateOptions{Name: "app", TemplateName: "chatbot"})
+
+	var templateErr *errdefs.TemplateError
+	if !errors.As(err, &templateErr) || templateErr.Template != "chatbot" {
+		t.Fatalf("Create() error = %v, want a TemplateError of the template chatbot", err)
+	}
+	if !errors.Is(err, templates.ErrTemplateNotFound) {
+		t.Errorf("Create() error = %v, want it to wrap %v", err, templates.ErrTemplateNotFound)
+	}
+	if calls := r.Calls(); len(calls) > 0 {
+		t.Errorf("Create() changed the runtime: %v", calls)
+	}
+}
+
+// TestDeployRuntimeError asserts that a pod failing to deploy fails the create with a RuntimeError naming the pod.
+func TestDeployRuntimeError(t *testing.T) {
+	errPlay := errors.New("image not known")
+
+	play := kubePlay
+	t.Cleanup(func() { kubePlay = play })
+	kubePlay = func(io.Reader, map[string]string) ([]rtTypes.Pod, error) {
+		return nil, errPlay
+	}
+
+	tmpls := map[string]*template.Template{"vllm.yaml.tmpl": template.Must(template.New("vllm").Parse(`{{ .PodPrefix }}--vllm`))}
+	appMetadata := &templates.AppMetadata{Name: "stub", PodTemplateExecutions: [][]string{{"vllm.yaml.tmpl"}}}
+
+	err := NewPodmanApplication(fake.New()).executePodTemplates(context.Background(), stubTemplates{}, podTemplatesOptions{
+		appName:     "app",
+		appMetadata: appMetadata,
+		tmpls:       tmpls,
+		readiness:   readinessOptions{maxStartupRestarts: -1},
+		concurrency: 1,
+		layers:      layerSelection{start: 0, end: 1},
+	})
+
+	var runtimeErr *errdefs.RuntimeError
+	if !errors.As(err, &runtimeErr) || runtimeErr.Op != "deploy" || runtimeErr.Pod != "app--vllm" {
+		t.Fatalf("executePodTemplates() error = %v, want a RuntimeError deploying app--vllm", err)
+	}
+	if !errors.Is(err, errPlay) {
+		t.Errorf("executePodTemplates() error = %v, want it to wrap %v", err, errPlay)
+	}
+}
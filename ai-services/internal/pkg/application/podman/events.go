@@ -0,0 +1,14 @@
+package podman
+
+import (
+	"context"
+	"os"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Events displays the events of the application pods, following the new ones until ctx is done if requested.
+func (p *PodmanApplication) Events(ctx context.Context, opts appTypes.EventsOptions) error {
+	return common.Events(ctx, p.runtime, opts, os.Stdout)
+}
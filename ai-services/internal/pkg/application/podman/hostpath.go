@@ -0,0 +1,46 @@
+package podman
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// prepareHostPaths creates the app scoped host directories declared via the hostpath annotations
+// and returns them keyed by name, so that the pod templates can mount them as '{{ .hostPaths.<name> }}'.
+// The directories live under the application data dir and are hence cleaned up on delete unless --skip-cleanup is set.
+func (p *PodmanApplication) prepareHostPaths(appName string, podAnnotations map[string]string) (map[string]string, error) {
+	hostPaths := map[string]string{}
+	appDir := vars.ApplicationDir(appName)
+
+	for key, subPath := range podAnnotations {
+		name, ok := strings.CutPrefix(key, constants.HostPathAnnotationPrefix)
+		if !ok {
+			continue
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("annotation '%s' is missing the host path name", key)
+		}
+
+		subPath = strings.TrimSpace(subPath)
+		if subPath == "" || !filepath.IsLocal(subPath) {
+			return nil, fmt.Errorf("annotation '%s' has invalid sub path '%s', it must be relative to the application directory", key, subPath)
+		}
+
+		hostPath := filepath.Join(appDir, subPath)
+		if err := os.MkdirAll(hostPath, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("failed to create host path '%s': %w", hostPath, err)
+		}
+
+		logger.Infof("Prepared host path '%s': %s\n", name, hostPath, logger.VerbosityLevelDebug)
+		hostPaths[name] = hostPath
+	}
+
+	return hostPaths, nil
+}
@@ -1,10 +1,10 @@
 package podman
 
 import (
-	"fmt"
-
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
@@ -13,19 +13,24 @@ import (
 func (p *PodmanApplication) Info(opts types.InfoOptions) error {
 	// Step1: Do List pods and filter for given application name
 
-	listFilters := map[string][]string{}
-	if opts.Name != "" {
-		listFilters["label"] = []string{fmt.Sprintf("ai-services.io/application=%s", opts.Name)}
-	}
-
-	pods, err := p.runtime.ListPods(listFilters)
+	pods, err := helpers.ListApplicationPods(p.runtime, opts.Name)
 	if err != nil {
-		return fmt.Errorf("failed to list pods: %w", err)
+		return err
 	}
 
 	// If there exists no pod for given application name, then fail saying application for given application name doesnt exist
 	if len(pods) == 0 {
-		logger.Infof("Application: '%s' does not exist.", opts.Name)
+		logger.Infoln(errdefs.NoPodsFound(opts.Name).Error())
+
+		return nil
+	}
+
+	if opts.Format != nil {
+		return common.PrintFormattedInfo(p.runtime, opts, pods)
+	}
+
+	if opts.Refresh {
+		common.PrintPodHealth(p.runtime, opts, pods)
 
 		return nil
 	}
@@ -40,6 +45,10 @@ func (p *PodmanApplication) Info(opts types.InfoOptions) error {
 	version := pods[0].Labels[string(vars.VersionLabel)]
 	logger.Infoln("Version: " + version)
 
+	common.PrintLabels(pods[0].Labels)
+
+	common.PrintPodRestarts(p.runtime, pods)
+
 	// Step3: Read and print the info.md file
 
 	if err := helpers.PrintInfo(p.runtime, opts.Name, appTemplate); err != nil {
@@ -0,0 +1,44 @@
+package podman
+
+import (
+	"fmt"
+
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// mergePodLabels merges the custom labels into the metadata labels of the rendered pod manifest.
+func mergePodLabels(manifest []byte, labels map[string]string) ([]byte, error) {
+	return mergePodMetadata(manifest, "labels", labels)
+}
+
+// mergePodAnnotations merges the annotation overrides into the metadata annotations of the rendered pod manifest.
+func mergePodAnnotations(manifest []byte, annotations map[string]string) ([]byte, error) {
+	return mergePodMetadata(manifest, "annotations", annotations)
+}
+
+// mergePodMetadata merges the values into the given metadata field of the rendered pod manifest.
+// The manifest is handled as a generic map, so that no field of the pod spec gets lost on the round trip.
+func mergePodMetadata(manifest []byte, field string, values map[string]string) ([]byte, error) {
+	var pod map[string]any
+	if err := k8syaml.Unmarshal(manifest, &pod); err != nil {
+		return nil, fmt.Errorf("unable to read YAML as Kube Pod: %w", err)
+	}
+
+	metadata, ok := pod["metadata"].(map[string]any)
+	if !ok {
+		metadata = map[string]any{}
+		pod["metadata"] = metadata
+	}
+
+	fieldValues, ok := metadata[field].(map[string]any)
+	if !ok {
+		fieldValues = map[string]any{}
+		metadata[field] = fieldValues
+	}
+
+	for key, val := range values {
+		fieldValues[key] = val
+	}
+
+	return k8syaml.Marshal(pod)
+}
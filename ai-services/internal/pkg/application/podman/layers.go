@@ -0,0 +1,121 @@
+package podman
+
+import (
+	"fmt"
+	"slices"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const layersRule = "layers"
+
+// layerSelection is the range of layers of the podTemplateExecutions to deploy, start is inclusive and end exclusive.
+type layerSelection struct {
+	start int
+	end   int
+}
+
+// contains reports whether the layer at the given index is part of the selection.
+func (l layerSelection) contains(i int) bool {
+	return i >= l.start && i < l.end
+}
+
+// podTemplates returns the pod templates of the selected layers.
+func (l layerSelection) podTemplates(appMetadata *templates.AppMetadata, tmpls map[string]*template.Template) map[string]*template.Template {
+	selected := make(map[string]*template.Template)
+	for _, podTemplateName := range utils.FlattenArray(appMetadata.PodTemplateExecutions[l.start:l.end]) {
+		selected[podTemplateName] = tmpls[podTemplateName]
+	}
+
+	return selected
+}
+
+// selectLayers resolves the 1 based --from-layer and --only-layer options against the layers of the application.
+func selectLayers(appMetadata *templates.AppMetadata, fromLayer, onlyLayer int) (layerSelection, error) {
+	total := len(appMetadata.PodTemplateExecutions)
+
+	layer := max(fromLayer, onlyLayer)
+	if layer > total {
+		return layerSelection{}, &errdefs.ValidationError{
+			Rule: layersRule,
+			Err:  fmt.Errorf("invalid layer %d, application template '%s' has %d layers", layer, appMetadata.Name, total),
+		}
+	}
+
+	switch {
+	case onlyLayer > 0:
+		return layerSelection{start: onlyLayer - 1, end: onlyLayer}, nil
+	case fromLayer > 0:
+		return layerSelection{start: fromLayer - 1, end: total}, nil
+	default:
+		return layerSelection{start: 0, end: total}, nil
+	}
+}
+
+// verifyPrecedingLayers makes sure the pods of the layers before the selection are running,
+// as the selected layers depend on them and are deployed without touching them.
+func (p *PodmanApplication) verifyPrecedingLayers(tp templates.Template, opts types.CreateOptions,
+	appMetadata *templates.AppMetadata, layers layerSelection) error {
+	for i := range layers.start {
+		for _, podTemplateName := range appMetadata.PodTemplateExecutions[i] {
+			podSpec, err := p.fetchPodSpec(tp, opts.TemplateName, podTemplateName, opts.Name, opts.ValuesFiles, opts.ArgParams)
+			if err != nil {
+				return err
+			}
+
+			exists, err := p.runtime.PodExists(podSpec.Name)
+			if err != nil {
+				return fmt.Errorf("failed to check pod '%s' of layer %d: %w", podSpec.Name, i+1, err)
+			}
+			if !exists {
+				return &errdefs.ValidationError{
+					Rule: layersRule,
+					Err: fmt.Errorf("layer %d depends on layer %d, but pod '%s' is not deployed. Use --from-layer %d to deploy it",
+						layers.start+1, i+1, podSpec.Name, i+1),
+				}
+			}
+
+			pod, err := p.runtime.InspectPod(podSpec.Name)
+			if err != nil {
+				return fmt.Errorf("failed to inspect pod '%s' of layer %d: %w", podSpec.Name, i+1, err)
+			}
+			if pod.State != "Running" {
+				return &errdefs.ValidationError{
+					Rule: layersRule,
+					Err: fmt.Errorf("layer %d depends on layer %d, but pod '%s' is in state '%s'. Use --only-layer %d to redeploy it",
+						layers.start+1, i+1, podSpec.Name, pod.State, i+1),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeLayerPods removes the already deployed pods of the selected layers, so that --only-layer redeploys them
+// from the current templates.
+func (p *PodmanApplication) removeLayerPods(tp templates.Template, opts types.CreateOptions,
+	appMetadata *templates.AppMetadata, layers layerSelection, existingPods []string) error {
+	for _, podTemplateName := range utils.FlattenArray(appMetadata.PodTemplateExecutions[layers.start:layers.end]) {
+		podSpec, err := p.fetchPodSpec(tp, opts.TemplateName, podTemplateName, opts.Name, opts.ValuesFiles, opts.ArgParams)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(existingPods, podSpec.Name) {
+			continue
+		}
+
+		logger.Infoln(fmt.Sprintf("Removing pod '%s' to redeploy layer %d", podSpec.Name, layers.start+1))
+		if err := p.runtime.DeletePod(podSpec.Name, utils.BoolPtr(true)); err != nil {
+			return fmt.Errorf("failed to remove pod '%s' of layer %d: %w", podSpec.Name, layers.start+1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package podman
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+)
+
+func threeLayers() *templates.AppMetadata {
+	return &templates.AppMetadata{
+		Name: "test",
+		PodTemplateExecutions: [][]string{
+			{"db.yaml.tmpl"},
+			{"vllm.yaml.tmpl", "embedding.yaml.tmpl"},
+			{"ui.yaml.tmpl"},
+		},
+	}
+}
+
+func TestSelectLayers(t *testing.T) {
+	tests := []struct {
+		name      string
+		fromLayer int
+		onlyLayer int
+		want      layerSelection
+		wantErr   bool
+	}{
+		{name: "all layers by default", want: layerSelection{start: 0, end: 3}},
+		{name: "from the first layer", fromLayer: 1, want: layerSelection{start: 0, end: 3}},
+		{name: "from a middle layer", fromLayer: 2, want: layerSelection{start: 1, end: 3}},
+		{name: "from the last layer", fromLayer: 3, want: layerSelection{start: 2, end: 3}},
+		{name: "only the first layer", onlyLayer: 1, want: layerSelection{start: 0, end: 1}},
+		{name: "only a middle layer", onlyLayer: 2, want: layerSelection{start: 1, end: 2}},
+		{name: "only the last layer", onlyLayer: 3, want: layerSelection{start: 2, end: 3}},
+		{name: "from beyond the last layer", fromLayer: 4, wantErr: true},
+		{name: "only beyond the last layer", onlyLayer: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectLayers(threeLayers(), tt.fromLayer, tt.onlyLayer)
+			if tt.wantErr {
+				var validationErr *errdefs.ValidationError
+				if !errors.As(err, &validationErr) || validationErr.Rule != layersRule {
+					t.Fatalf("selectLayers() error = %v, want a %s validation error", err, layersRule)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectLayers() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("selectLayers() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayerSelectionPodTemplates(t *testing.T) {
+	got := layerSelection{start: 1, end: 2}.podTemplates(threeLayers(), nil)
+	if len(got) != 2 {
+		t.Fatalf("podTemplates() = %v, want the 2 templates of layer 2", got)
+	}
+	for _, name := range []string{"vllm.yaml.tmpl", "embedding.yaml.tmpl"} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("podTemplates() is missing %s", name)
+		}
+	}
+}
+
+func TestVerifyPrecedingLayers(t *testing.T) {
+	tests := []struct {
+		name string
+		// pods are the deployed pods of the application keyed by name, with their state.
+		pods    map[string]string
+		layers  layerSelection
+		wantErr string
+	}{
+		{
+			name:   "nothing precedes the first layer",
+			layers: layerSelection{start: 0, end: 3},
+		},
+		{
+			name:   "preceding layers running",
+			pods:   map[string]string{"app--db": "Running", "app--vllm": "Running", "app--embedding": "Running"},
+			layers: layerSelection{start: 2, end: 3},
+		},
+		{
+			name:    "preceding pod missing",
+			pods:    map[string]string{"app--db": "Running", "app--vllm": "Running"},
+			layers:  layerSelection{start: 2, end: 3},
+			wantErr: "layer 3 depends on layer 2, but pod 'app--embedding' is not deployed. Use --from-layer 2",
+		},
+		{
+			name:    "preceding pod not running",
+			pods:    map[string]string{"app--db": "Exited"},
+			layers:  layerSelection{start: 1, end: 2},
+			wantErr: "layer 2 depends on layer 1, but pod 'app--db' is in state 'Exited'. Use --only-layer 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			for name, state := range tt.pods {
+				addAppPod(r, "app", name, state, "")
+			}
+			p := NewPodmanApplication(r)
+
+			err := p.verifyPrecedingLayers(stubTemplates{}, types.CreateOptions{Name: "app", TemplateName: "test"}, threeLayers(), tt.layers)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("verifyPrecedingLayers() error = %v", err)
+				}
+
+				return
+			}
+
+			var validationErr *errdefs.ValidationError
+			if !errors.As(err, &validationErr) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("verifyPrecedingLayers() error = %v, want a validation error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemoveLayerPods(t *testing.T) {
+	r := fake.New()
+	for _, name := range []string{"app--db", "app--vllm", "app--ui"} {
+		addAppPod(r, "app", name, "Running", "")
+	}
+	p := NewPodmanApplication(r)
+
+	// only the deployed pods of the selected layer are removed
+	err := p.removeLayerPods(stubTemplates{}, types.CreateOptions{Name: "app", TemplateName: "test"}, threeLayers(),
+		layerSelection{start: 1, end: 2}, []string{"app--db", "app--vllm", "app--ui"})
+	if err != nil {
+		t.Fatalf("removeLayerPods() error = %v", err)
+	}
+
+	if got := r.Calls(); len(got) != 1 || got[0] != "DeletePod:app--vllm" {
+		t.Errorf("removeLayerPods() calls = %v, want [DeletePod:app--vllm]", got)
+	}
+}
@@ -2,18 +2,40 @@ package podman
 
 import (
 	"fmt"
+	"os"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
 // Logs displays logs from an application pod.
 func (p *PodmanApplication) Logs(opts types.LogsOptions) error {
+	if opts.TailAll {
+		return common.DumpLogs(p.runtime, opts, os.Stdout)
+	}
+
+	if opts.PodName == "" {
+		podName, err := common.ResolveLogsPod(p.runtime, opts.Name)
+		if err != nil {
+			return err
+		}
+		opts.PodName = podName
+	}
+
+	if err := common.ValidateLogsTarget(p.runtime, opts); err != nil {
+		return err
+	}
+
 	logger.Warningln("Press Ctrl+C to exit the logs and return to the terminal.")
 	logger.Infof("Fetching logs for application pod: %s", opts.PodName)
 
+	if opts.ContainerNameOrID == common.AllContainers {
+		return common.FollowAllContainerLogs(p.runtime, opts.PodName, opts)
+	}
+
 	if opts.ContainerNameOrID == "" {
-		if err := p.runtime.PodLogs(opts.PodName); err != nil {
+		if err := p.runtime.PodLogs(opts.PodName, common.LogOptions(opts)); err != nil {
 			return fmt.Errorf("failed to fetch pod: %s logs; err: %w", opts.PodName, err)
 		}
 
@@ -21,16 +43,8 @@ func (p *PodmanApplication) Logs(opts types.LogsOptions) error {
 	}
 
 	// Fetch container logs
-	exists, err := p.runtime.ContainerExists(opts.ContainerNameOrID)
-	if err != nil {
-		return err
-	}
-	if !exists {
-		return fmt.Errorf("container %s doesn't exists", opts.ContainerNameOrID)
-	}
-
 	logger.Infof("Fetching logs for container: %s", opts.ContainerNameOrID)
-	if err := p.runtime.ContainerLogs(opts.ContainerNameOrID); err != nil {
+	if err := p.runtime.ContainerLogs(opts.ContainerNameOrID, common.LogOptions(opts)); err != nil {
 		return fmt.Errorf("failed to fetch container: %s logs; err: %w", opts.ContainerNameOrID, err)
 	}
 
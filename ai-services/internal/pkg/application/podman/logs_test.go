@@ -0,0 +1,51 @@
+package podman
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+)
+
+// TestLogsChecksTarget asserts that the logs are only fetched once the pod and the container are known to exist.
+func TestLogsChecksTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		pod       string
+		container string
+		wantCall  string
+		wantErr   string
+	}{
+		{name: "pod logs", pod: "app--vllm", wantCall: "PodLogs:app--vllm"},
+		{name: "container logs", pod: "app--vllm", container: "app--vllm-c", wantCall: "ContainerLogs:app--vllm-c"},
+		{name: "missing pod", pod: "app--vlm", wantErr: "pod app--vlm does not exist"},
+		{name: "missing container", pod: "app--vllm", container: "app--vllm-x", wantErr: "container app--vllm-x does not exist"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addAppPod(r, "app", "app--vllm", "Running", "healthy")
+
+			err := NewPodmanApplication(r).Logs(types.LogsOptions{Name: "app", PodName: tt.pod, ContainerNameOrID: tt.container})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Logs() error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("Logs() error = %v", err)
+			}
+
+			for _, call := range r.Calls() {
+				if (strings.HasPrefix(call, "PodLogs:") || strings.HasPrefix(call, "ContainerLogs:")) && call != tt.wantCall {
+					t.Errorf("Logs() fetched %s, want %q", call, tt.wantCall)
+				}
+			}
+			if tt.wantCall != "" && !slices.Contains(r.Calls(), tt.wantCall) {
+				t.Errorf("Logs() calls = %v, want %s", r.Calls(), tt.wantCall)
+			}
+		})
+	}
+}
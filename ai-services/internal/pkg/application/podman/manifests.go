@@ -0,0 +1,89 @@
+package podman
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	// redactedValue replaces the secrets in the rendered manifests written to --output-dir.
+	redactedValue = "REDACTED"
+
+	outputDirPerm    = 0o755
+	manifestFilePerm = 0o644
+)
+
+var (
+	// secretEnvPattern matches the env variables holding a secret, e.g. OPENSEARCH_PASSWORD but not EMB_MAX_TOKENS.
+	secretEnvPattern = regexp.MustCompile(`(?i)(^|_)(password|passwd|secret|token|api_?key|credentials?)$`)
+	// secretValuePattern matches the template values holding a secret, e.g. opensearch.password.
+	secretValuePattern = regexp.MustCompile(`(?i)(password|passwd|secret|token|apikey|credentials?)$`)
+)
+
+// writeRenderedManifest writes the manifest of the pod to '<outputDir>/<pod>.yaml' with its secrets redacted.
+// The secrets are the values of the secret env variables and template values, which are replaced wherever they
+// occur, e.g. also in a health check command.
+func writeRenderedManifest(outputDir, podName string, manifest []byte, values map[string]any) error {
+	redacted, err := redactSecrets(manifest, values)
+	if err != nil {
+		return fmt.Errorf("failed to redact the secrets: %w", err)
+	}
+
+	if err := os.MkdirAll(outputDir, outputDirPerm); err != nil {
+		return fmt.Errorf("failed to create the output directory: %w", err)
+	}
+
+	path := filepath.Join(outputDir, podName+".yaml")
+	if err := os.WriteFile(path, redacted, manifestFilePerm); err != nil {
+		return fmt.Errorf("failed to write the rendered manifest: %w", err)
+	}
+
+	return nil
+}
+
+// redactSecrets replaces the secrets found in the manifest and in the template values with redactedValue.
+func redactSecrets(manifest []byte, values map[string]any) ([]byte, error) {
+	var podSpec models.PodSpec
+	if err := k8syaml.Unmarshal(manifest, &podSpec); err != nil {
+		return nil, fmt.Errorf("failed to parse the manifest: %w", err)
+	}
+
+	secrets := secretTemplateValues(values)
+	for _, container := range podSpec.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Value != "" && secretEnvPattern.MatchString(env.Name) {
+				secrets = append(secrets, env.Value)
+			}
+		}
+	}
+
+	for _, secret := range secrets {
+		manifest = bytes.ReplaceAll(manifest, []byte(secret), []byte(redactedValue))
+	}
+
+	return manifest, nil
+}
+
+// secretTemplateValues returns the non empty string values whose key denotes a secret, walking the nested values.
+func secretTemplateValues(values map[string]any) []string {
+	secrets := []string{}
+	for key, value := range values {
+		switch v := value.(type) {
+		case map[string]any:
+			secrets = append(secrets, secretTemplateValues(v)...)
+		case string:
+			if v != "" && secretValuePattern.MatchString(key) {
+				secrets = append(secrets, v)
+			}
+		}
+	}
+
+	return secrets
+}
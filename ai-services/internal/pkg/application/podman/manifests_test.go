@@ -0,0 +1,178 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// manifestTemplate is the pod template of the pod named 'POD', holding a secret in its env.
+const manifestTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ .PodPrefix }}--POD
+spec:
+  containers:
+  - name: POD
+    image: POD:latest
+    env:
+    - name: LOG_LEVEL
+      value: info
+    - name: HF_TOKEN
+      value: hf_secret
+`
+
+// TestRenderedManifestsMatchDeployed asserts that the manifest written to --output-dir for each pod is the one
+// deployed by kube play, except for the redacted secrets, and that a dry run writes the manifests without deploying.
+func TestRenderedManifestsMatchDeployed(t *testing.T) {
+	pods := []string{"vllm", "ui"}
+
+	for _, dryRun := range []bool{false, true} {
+		t.Run(map[bool]string{false: "deploy", true: "dry run"}[dryRun], func(t *testing.T) {
+			deployed := map[string][]byte{}
+			play := kubePlay
+			t.Cleanup(func() { kubePlay = play })
+			kubePlay = func(body io.Reader, _ map[string]string) ([]types.Pod, error) {
+				manifest, err := io.ReadAll(body)
+				if err != nil {
+					return nil, err
+				}
+				var podSpec models.PodSpec
+				if err := k8syaml.Unmarshal(manifest, &podSpec); err != nil {
+					return nil, err
+				}
+
+				envMutex.Lock()
+				deployed[podSpec.Name] = manifest
+				envMutex.Unlock()
+
+				return []types.Pod{}, nil
+			}
+
+			tmpls := map[string]*template.Template{}
+			for _, pod := range pods {
+				tmpls[pod+".yaml.tmpl"] = template.Must(template.New(pod).Parse(strings.ReplaceAll(manifestTemplate, "POD", pod)))
+			}
+			appMetadata := &templates.AppMetadata{Name: "stub", PodTemplateExecutions: [][]string{{"vllm.yaml.tmpl", "ui.yaml.tmpl"}}}
+
+			outputDir := t.TempDir()
+			p := NewPodmanApplication(fake.New())
+			p.outputDir, p.dryRun = outputDir, dryRun
+
+			err := p.executePodTemplates(context.Background(), stubTemplates{}, podTemplatesOptions{
+				appName:     "app",
+				appMetadata: appMetadata,
+				tmpls:       tmpls,
+				readiness:   readinessOptions{maxStartupRestarts: -1},
+				concurrency: 2,
+				layers:      layerSelection{start: 0, end: 1},
+			})
+			if err != nil {
+				t.Fatalf("executePodTemplates() error = %v", err)
+			}
+
+			for _, pod := range pods {
+				name := "app--" + pod
+				written, err := os.ReadFile(filepath.Join(outputDir, name+".yaml"))
+				if err != nil {
+					t.Fatalf("manifest of %s not written: %v", name, err)
+				}
+				if bytes.Contains(written, []byte("hf_secret")) {
+					t.Errorf("manifest of %s = %s, want the secret redacted", name, written)
+				}
+
+				if dryRun {
+					continue
+				}
+				want := bytes.ReplaceAll(deployed[name], []byte("hf_secret"), []byte(redactedValue))
+				if !bytes.Equal(written, want) {
+					t.Errorf("manifest of %s = %s, want the deployed one %s", name, written, deployed[name])
+				}
+			}
+
+			if dryRun && len(deployed) > 0 {
+				t.Errorf("dry run deployed %d pods, want none", len(deployed))
+			}
+			if !dryRun && len(deployed) != len(pods) {
+				t.Errorf("deployed %d pods, want %d", len(deployed), len(pods))
+			}
+		})
+	}
+}
+
+// TestModelDirectoryMount renders the vllm server pod of the rag template in a dry run, asserting that the models
+// are mounted from the directory given via --model-dir, or from the default model directory otherwise.
+func TestModelDirectoryMount(t *testing.T) {
+	tests := []struct {
+		name     string
+		modelDir string
+		want     string
+	}{
+		{name: "default", want: vars.ModelDirectory},
+		{name: "model dir", modelDir: "/mnt/share/models", want: "/mnt/share/models"},
+	}
+
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	allTmpls, err := tp.LoadAllTemplates("rag")
+	if err != nil {
+		t.Fatalf("LoadAllTemplates() error = %v", err)
+	}
+	tmpls := map[string]*template.Template{"vllm-server.yaml.tmpl": allTmpls["vllm-server.yaml.tmpl"]}
+	appMetadata := &templates.AppMetadata{Name: "rag", PodTemplateExecutions: [][]string{{"vllm-server.yaml.tmpl"}}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			p := NewPodmanApplication(fake.New())
+			p.outputDir, p.dryRun = outputDir, true
+
+			err := p.executePodTemplates(context.Background(), tp, podTemplatesOptions{
+				appName:     "app",
+				appMetadata: appMetadata,
+				tmpls:       tmpls,
+				modelDir:    modelDirectory(appTypes.CreateOptions{ModelDir: tt.modelDir}),
+				readiness:   readinessOptions{maxStartupRestarts: -1},
+				concurrency: 1,
+				layers:      layerSelection{start: 0, end: 1},
+			})
+			if err != nil {
+				t.Fatalf("executePodTemplates() error = %v", err)
+			}
+
+			written, err := os.ReadFile(filepath.Join(outputDir, "app--vllm-server.yaml"))
+			if err != nil {
+				t.Fatalf("manifest not written: %v", err)
+			}
+			var podSpec models.PodSpec
+			if err := k8syaml.Unmarshal(written, &podSpec); err != nil {
+				t.Fatalf("unmarshal manifest: %v", err)
+			}
+
+			for _, volume := range podSpec.Spec.Volumes {
+				if volume.Name != "models" {
+					continue
+				}
+				if volume.HostPath == nil || volume.HostPath.Path != tt.want {
+					t.Errorf("models volume = %+v, want the host path %s", volume.VolumeSource, tt.want)
+				}
+
+				return
+			}
+			t.Errorf("volumes = %+v, want the models volume", podSpec.Spec.Volumes)
+		})
+	}
+}
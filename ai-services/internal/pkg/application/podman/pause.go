@@ -0,0 +1,97 @@
+package podman
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"golang.org/x/term"
+)
+
+// layerPause holds the options to pause the deploy between the layers, so that the intermediate state can be inspected.
+type layerPause struct {
+	// enabled waits for Enter after each layer, if stdin is a terminal.
+	enabled bool
+	// delay waits for the given duration after each layer instead, also in non-interactive mode.
+	delay time.Duration
+	// interactive reports whether the user can press Enter on stdin.
+	interactive bool
+}
+
+func newLayerPause(enabled bool, delay time.Duration) layerPause {
+	return layerPause{
+		enabled:     enabled,
+		delay:       delay,
+		interactive: term.IsTerminal(int(os.Stdin.Fd())),
+	}
+}
+
+// active reports whether the deploy pauses after a layer. Waiting for Enter is a no-op in non-interactive mode,
+// so that scripted deploys never block, while a delay pauses regardless.
+func (l layerPause) active() bool {
+	return l.delay > 0 || (l.enabled && l.interactive)
+}
+
+// wait prints the state of the application pods and pauses before the next layer is deployed.
+func (l layerPause) wait(ctx context.Context, p *PodmanApplication, appName string, layer, total int) error {
+	if !l.active() {
+		if l.enabled {
+			logger.Infof("Not pausing after layer %d as stdin is not a terminal, use --layer-delay to pause\n", layer, logger.VerbosityLevelDebug)
+		}
+
+		return nil
+	}
+
+	p.printLayerStatus(appName, layer, total)
+
+	if l.delay > 0 {
+		logger.Infof("Pausing for %s before the next layer...\n", l.delay)
+		timer := time.NewTimer(l.delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	logger.Infoln("Press Enter to deploy the next layer...")
+	enterCh := make(chan error, 1)
+	go func() {
+		_, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		enterCh <- err
+	}()
+
+	select {
+	case err := <-enterCh:
+		if err != nil {
+			return fmt.Errorf("failed to read from stdin: %w", err)
+		}
+
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// printLayerStatus logs the state of the pods deployed so far.
+func (p *PodmanApplication) printLayerStatus(appName string, layer, total int) {
+	logger.Infoln(fmt.Sprintf("Layer %d/%d passed readiness, the application pods are:", layer, total))
+
+	pods, err := helpers.ListApplicationPods(p.runtime, appName)
+	if err != nil {
+		logger.Warningf("unable to list the application pods: %v\n", err)
+
+		return
+	}
+
+	for _, pod := range pods {
+		logger.Infof("- %s: %s\n", pod.Name, pod.Status)
+	}
+}
@@ -0,0 +1,56 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+)
+
+func TestLayerPauseActive(t *testing.T) {
+	tests := []struct {
+		name  string
+		pause layerPause
+		want  bool
+	}{
+		{name: "disabled", pause: layerPause{interactive: true}, want: false},
+		{name: "enabled on a terminal", pause: layerPause{enabled: true, interactive: true}, want: true},
+		{name: "enabled in non-interactive mode", pause: layerPause{enabled: true}, want: false},
+		{name: "delay in non-interactive mode", pause: layerPause{delay: time.Second}, want: true},
+		{name: "delay with pause enabled", pause: layerPause{enabled: true, delay: time.Second}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pause.active(); got != tt.want {
+				t.Errorf("active() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayerPauseWait(t *testing.T) {
+	p := NewPodmanApplication(fake.New())
+
+	// inactive pauses return at once, even with an expired context
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := (layerPause{enabled: true}).wait(cancelled, p, "app", 1, 2); err != nil {
+		t.Errorf("wait() of an inactive pause error = %v, want nil", err)
+	}
+
+	start := time.Now()
+	if err := (layerPause{delay: 10 * time.Millisecond}).wait(context.Background(), p, "app", 1, 2); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("wait() returned after %s, want at least the 10ms delay", elapsed)
+	}
+
+	// a cancelled deploy does not wait out the delay
+	if err := (layerPause{delay: time.Hour}).wait(cancelled, p, "app", 1, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -3,11 +3,25 @@ package podman
 import (
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // PodmanApplication implements the Application interface for Podman runtime.
 type PodmanApplication struct {
 	runtime runtime.Runtime
+	// annotationOverrides are set on the pod specs loaded from the templates, refer specs.LoadAnnotationOverrides.
+	annotationOverrides map[string]map[string]string
+	// outputDir when set, receives the rendered manifest of each pod before it is deployed, refer writeRenderedManifest.
+	outputDir string
+	// envFile is set on the containers of the rendered pods, only on envFileContainers if any, refer applyEnvFile.
+	envFile           map[string]string
+	envFileContainers []string
+	// dryRun renders the manifests without deploying them.
+	dryRun bool
+	// strict treats the warnings of the create as errors.
+	strict bool
+	// spyreLock is held from the Spyre card allocation until the pods using the cards are deployed, refer lockSpyreAllocation.
+	spyreLock *utils.FileLock
 }
 
 // NewPodmanApplication creates a new PodmanApplication instance.
@@ -0,0 +1,45 @@
+package podman
+
+import (
+	"strings"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// stubTemplates renders each pod template file '<pod>.yaml.tmpl' to a pod named '<app>--<pod>' with a single
+// container named after the pod and no values, the other methods of the template provider are not implemented.
+type stubTemplates struct {
+	templates.Template
+}
+
+func (stubTemplates) LoadValues(app string, valuesFileOverrides []string, cliOverrides map[string]string) (map[string]any, error) {
+	return map[string]any{}, nil
+}
+
+func (stubTemplates) LoadPodTemplateWithValues(app, file, appName string, valuesFileOverrides []string,
+	cliOverrides map[string]string) (*models.PodSpec, error) {
+	pod := strings.TrimSuffix(file, ".yaml.tmpl")
+
+	return &models.PodSpec{Pod: v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: appName + "--" + pod},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: pod}}},
+	}}, nil
+}
+
+// addAppPod adds a pod of the application with a single container in the given state to the fake runtime.
+func addAppPod(r *fake.Runtime, app, name, state, health string) {
+	r.AddPod(types.Pod{
+		ID:     name + "-id",
+		Name:   name,
+		Status: state,
+		State:  state,
+		Labels: map[string]string{constants.ApplicationAnnotationKey: app},
+	}, types.Container{ID: name + "-c", Name: name + "-c", Status: strings.ToLower(state), Health: health})
+}
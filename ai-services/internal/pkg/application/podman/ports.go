@@ -0,0 +1,66 @@
+package podman
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// assignedPorts records the container ports published without a host port, for which podman assigned a random one,
+// e.g. when ui.port is not set. Guarded by envMutex.
+// Key -> pod name, Value -> container ports.
+var assignedPorts = map[string][]string{}
+
+// recordAssignedPorts records the container ports of the pod published without a host port, refer constructPodDeployOptions.
+func recordAssignedPorts(podName string, hostPortMappings map[string]string) {
+	envMutex.Lock()
+	defer envMutex.Unlock()
+
+	for containerPort, hostPort := range hostPortMappings {
+		if hostPort == "" {
+			assignedPorts[podName] = append(assignedPorts[podName], containerPort)
+		}
+	}
+}
+
+// podPortMappings inspects the pod for the host ports actually bound, as the pod list does not report them.
+// The ports assigned by podman are flagged, refer recordAssignedPorts. The caller must hold envMutex.
+func (p *PodmanApplication) podPortMappings(podID, podName string) []types.PortMapping {
+	pod, err := p.runtime.InspectPod(podID)
+	if err != nil {
+		logger.Infof("unable to inspect the ports of pod %s: %v\n", podName, err, logger.VerbosityLevelDebug)
+
+		return []types.PortMapping{}
+	}
+
+	mappings := []types.PortMapping{}
+	for containerPort, hostPorts := range pod.Ports {
+		for _, hostPort := range hostPorts {
+			mappings = append(mappings, types.PortMapping{
+				HostPort:      hostPort,
+				ContainerPort: containerPort,
+				Assigned:      isAssignedPort(assignedPorts[podName], containerPort),
+			})
+		}
+	}
+	slices.SortFunc(mappings, func(a, b types.PortMapping) int {
+		return cmp.Or(cmp.Compare(a.ContainerPort, b.ContainerPort), cmp.Compare(a.HostPort, b.HostPort))
+	})
+
+	return mappings
+}
+
+// isAssignedPort reports whether the container port is one of the assigned ports. The pod inspect reports the port
+// along with its protocol, eg:- 3000/tcp, while the ports annotation omits it, hence only the port numbers are compared.
+func isAssignedPort(assigned []string, containerPort string) bool {
+	port, _, _ := strings.Cut(containerPort, "/")
+
+	return slices.ContainsFunc(assigned, func(p string) bool {
+		assignedPort, _, _ := strings.Cut(p, "/")
+
+		return assignedPort == port
+	})
+}
@@ -0,0 +1,139 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// minModelDiskSpaceGiB is the free space required in the model directory before downloading models of unknown size.
+const minModelDiskSpaceGiB = 20
+
+const bytesPerGiB = 1 << 30
+
+// verifyHostPortsAvailable makes sure the host ports published by the pods to be deployed are not in use already.
+func (p *PodmanApplication) verifyHostPortsAvailable(tp templates.Template, opts types.CreateOptions, tmpls map[string]*template.Template, existingPods []string) error {
+	for podTemplateFileName := range tmpls {
+		podSpec, err := p.fetchPodSpec(tp, opts.TemplateName, podTemplateFileName, opts.Name, opts.ValuesFiles, opts.ArgParams)
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(existingPods, podSpec.Name) {
+			continue
+		}
+
+		for containerPort, hostPort := range p.fetchHostPortMappingFromAnnotation(p.fetchPodAnnotations(podSpec)) {
+			// ports without a host port are published dynamically by podman
+			if hostPort == "" || hostPort == "0" {
+				continue
+			}
+
+			if err := checkPortAvailable(hostPort); err != nil {
+				return fmt.Errorf("host port %s for container port %s of pod '%s' is not available: %w", hostPort, containerPort, podSpec.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkPortAvailable(port string) error {
+	listener, err := net.Listen("tcp", net.JoinHostPort("", port))
+	if err != nil {
+		return err
+	}
+
+	return listener.Close()
+}
+
+// verifyModelDiskSpace makes sure there is enough free space in the model directory to download the models, which are not
+// present yet. The required space is the estimated size of those models, less what got downloaded of them already.
+// If the size of a missing model is unknown, e.g. in offline mode, at least minModelDiskSpaceGiB must be free.
+func verifyModelDiskSpace(ctx context.Context, modelDir string, models []string) error {
+	var required int64
+	var missing []string
+	sizeUnknown := false
+
+	for _, model := range models {
+		result := helpers.VerifyModel(modelDir, model)
+		if result.Status == helpers.ModelStatusOK || result.Status == helpers.ModelStatusUnverified {
+			continue
+		}
+		missing = append(missing, model)
+
+		size, source := helpers.EstimateModelSize(ctx, modelDir, model)
+		if source == helpers.ModelSizeSourceUnknown {
+			sizeUnknown = true
+
+			continue
+		}
+
+		// an interrupted download is resumed, hence only the rest of the model is downloaded
+		partial, err := utils.DirSize(result.Path)
+		if err != nil {
+			return fmt.Errorf("failed to check the size of model %s: %w", model, err)
+		}
+		required += max(size-partial, 0)
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if sizeUnknown {
+		required = max(required, minModelDiskSpaceGiB*bytesPerGiB)
+	}
+
+	free, err := freeDiskSpace(modelDir)
+	if err != nil {
+		return err
+	}
+
+	if free < required {
+		return fmt.Errorf("insufficient disk space for models in '%s': %s free, %s required to download %s. "+
+			"Free up space or use --skip-model-download if the models are already present",
+			modelDir, utils.HumanSize(free), utils.HumanSize(required), strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// freeDiskSpace returns the free space in bytes of the file system holding the given directory.
+// It is a variable, so that the tests can fake the free space.
+var freeDiskSpace = func(path string) (int64, error) {
+	// the model directory gets created during download, hence check the closest existing parent
+	dir := path
+	for {
+		if _, err := os.Stat(dir); err == nil {
+			break
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return 0, fmt.Errorf("failed to check model directory: %w", err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, fmt.Errorf("failed to check free disk space of '%s': %w", dir, err)
+	}
+
+	return int64(stat.Bavail) * stat.Bsize, nil
+}
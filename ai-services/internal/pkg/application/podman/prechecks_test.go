@@ -0,0 +1,150 @@
+package podman
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/update"
+)
+
+const gib = int64(bytesPerGiB)
+
+// writeModel creates a model in the model directory, with a file of the given size.
+func writeModel(t *testing.T, modelDir, model string, size int) {
+	t.Helper()
+
+	dir := filepath.Join(modelDir, model)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "weights.safetensors"), make([]byte, size), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func fakeFreeDiskSpace(t *testing.T, free int64) {
+	t.Helper()
+
+	orig := freeDiskSpace
+	freeDiskSpace = func(string) (int64, error) { return free, nil }
+	t.Cleanup(func() { freeDiskSpace = orig })
+}
+
+func TestVerifyModelDiskSpaceOffline(t *testing.T) {
+	update.Offline = true
+	t.Cleanup(func() { update.Offline = false })
+
+	tests := []struct {
+		name    string
+		present []string
+		free    int64
+		wantErr string
+	}{
+		{name: "all models present", present: []string{"org/a", "org/b"}, free: 0},
+		{name: "missing model of unknown size", present: []string{"org/a"}, free: minModelDiskSpaceGiB * gib},
+		{name: "missing model short of the minimum", present: []string{"org/a"}, free: gib, wantErr: "required to download org/b"},
+		{name: "all models missing", free: gib, wantErr: "required to download org/a, org/b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+			for _, model := range tt.present {
+				writeModel(t, modelDir, model, 1)
+			}
+			fakeFreeDiskSpace(t, tt.free)
+
+			err := verifyModelDiskSpace(context.Background(), modelDir, []string{"org/a", "org/b"})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("verifyModelDiskSpace() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("verifyModelDiskSpace() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyModelDiskSpaceHubSizes(t *testing.T) {
+	// every model of the hub is 3 GiB
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"siblings": [{"size": 1073741824}, {"size": 2147483648}]}`))
+	}))
+	defer hub.Close()
+	t.Setenv(helpers.EnvHFEndpoint, hub.URL)
+
+	tests := []struct {
+		name    string
+		free    int64
+		wantErr bool
+	}{
+		// the 3 GiB model is missing, less the 1 KiB downloaded already of the interrupted one
+		{name: "enough space", free: 6*gib - 1024},
+		{name: "short of space", free: 6*gib - 1025, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+			// an interrupted download, hf download keeps the file being downloaded as incomplete
+			writeModel(t, modelDir, "org/partial", 1024)
+			cacheDir := filepath.Join(modelDir, "org/partial", ".cache", "huggingface", "download")
+			if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(cacheDir, "model.safetensors.incomplete"), nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			fakeFreeDiskSpace(t, tt.free)
+
+			err := verifyModelDiskSpace(context.Background(), modelDir, []string{"org/missing", "org/partial"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyModelDiskSpace() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCreateValidatesBeforeChangingHost asserts the ordering of the create phases: a failing cheap validation,
+// here an occupied host port, stops the create before the images are pulled, the models downloaded or a pod deployed.
+func TestCreateValidatesBeforeChangingHost(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = listener.Close() }()
+	_, port, _ := net.SplitHostPort(listener.Addr().String())
+
+	r := fake.New()
+	modelDir := filepath.Join(t.TempDir(), "models")
+
+	err = NewPodmanApplication(r).Create(context.Background(), types.CreateOptions{
+		Name:         "app",
+		TemplateName: "rag",
+		ArgParams:    map[string]string{"ui.port": port},
+		ModelDir:     modelDir,
+	})
+	if err == nil || !strings.Contains(err.Error(), "host port "+port) {
+		t.Fatalf("Create() error = %v, want the host port %s to be reported as not available", err, port)
+	}
+
+	if calls := r.Calls(); len(calls) > 0 {
+		t.Errorf("Create() changed the runtime before the validations: %v", calls)
+	}
+	if _, err := os.Stat(modelDir); !os.IsNotExist(err) {
+		t.Errorf("Create() touched the model directory before the validations: %v", err)
+	}
+}
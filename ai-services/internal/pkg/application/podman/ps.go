@@ -1,10 +1,12 @@
 package podman
 
 import (
+	"time"
+
 	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
-	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // List returns information about running applications.
@@ -14,20 +16,20 @@ func (p *PodmanApplication) List(opts appTypes.ListOptions) ([]appTypes.Applicat
 	if err != nil {
 		return nil, err
 	}
+	pods = common.FilterPodsByCreated(pods, opts.Since, opts.Until, time.Now())
 
 	// if no pods are present and also if appName is provided then simply log and return
 	if len(pods) == 0 && opts.ApplicationName != "" {
-		logger.Infof("No Pods found for the given application name: %s", opts.ApplicationName)
+		common.Redraw(opts)
+		logger.Infoln(errdefs.NoPodsFound(opts.ApplicationName).Error())
 
 		return nil, nil
 	}
 
-	// fetch the table writer object
-	printer := utils.NewTableWriter()
-	defer printer.CloseTableWriter()
-
 	// set table headers and rows
-	common.PopulateTable(p.runtime, opts, pods)
+	if err := common.PopulateTable(p.runtime, opts, pods); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
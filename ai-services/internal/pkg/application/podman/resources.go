@@ -0,0 +1,143 @@
+package podman
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	resourcesRule  = "resources"
+	resourceMemory = "memory"
+	resourceCPU    = "cpu"
+)
+
+var (
+	// memoryQuantityRegex accepts the memory sizes in Mi or Gi, eg:- 512Mi, 4Gi.
+	memoryQuantityRegex = regexp.MustCompile(`^[1-9][0-9]*(Mi|Gi)$`)
+	// cpuQuantityRegex accepts the CPU cores either as a decimal or in millicores, eg:- 2, 0.5, 500m.
+	cpuQuantityRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?|[1-9][0-9]*m)$`)
+)
+
+// parseResourceAnnotations reads the resource limits declared as 'ai-services.io/resources.<container>.<memory|cpu>: <quantity>'
+// and returns them keyed by container and resource name. The annotations are rendered along with the pod template,
+// so their values usually refer to the template values and can hence be overridden using --params.
+// An empty value means no limit.
+func parseResourceAnnotations(podSpec *models.PodSpec) (map[string]map[string]string, error) {
+	containerNames := specs.FetchContainerNames(*podSpec)
+	resources := map[string]map[string]string{}
+
+	for key, quantity := range specs.FetchPodAnnotations(*podSpec) {
+		name, ok := strings.CutPrefix(key, constants.ResourcesAnnotationPrefix)
+		if !ok {
+			continue
+		}
+
+		// container names cannot contain dots, hence the first one separates the resource name
+		container, resource, ok := strings.Cut(name, ".")
+		if !ok || container == "" {
+			return nil, fmt.Errorf("annotation '%s' must be in the form '%s<container>.<memory|cpu>'", key, constants.ResourcesAnnotationPrefix)
+		}
+
+		if !slices.Contains(containerNames, container) {
+			return nil, fmt.Errorf("annotation '%s' refers to container '%s' which is not part of pod '%s'", key, container, podSpec.Name)
+		}
+
+		quantity = strings.TrimSpace(quantity)
+		if quantity == "" {
+			continue
+		}
+
+		switch resource {
+		case resourceMemory:
+			if !memoryQuantityRegex.MatchString(quantity) {
+				return nil, fmt.Errorf("annotation '%s' has invalid memory '%s', it must be a size in Mi or Gi, eg:- 512Mi, 4Gi", key, quantity)
+			}
+		case resourceCPU:
+			if !cpuQuantityRegex.MatchString(quantity) {
+				return nil, fmt.Errorf("annotation '%s' has invalid cpu '%s', it must be a number of cores or millicores, eg:- 2, 500m", key, quantity)
+			}
+		default:
+			return nil, fmt.Errorf("annotation '%s' has unsupported resource '%s', supported resources are: %s, %s", key, resource, resourceMemory, resourceCPU)
+		}
+
+		if resources[container] == nil {
+			resources[container] = map[string]string{}
+		}
+		resources[container][resource] = quantity
+	}
+
+	return resources, nil
+}
+
+// verifyResourceAnnotations makes sure the resource annotations of all the pods are valid once rendered with the
+// user provided values, so that an invalid --params override fails the create before anything gets deployed.
+func (p *PodmanApplication) verifyResourceAnnotations(tp templates.Template, opts types.CreateOptions, tmpls map[string]*template.Template) error {
+	for podTemplateFileName := range tmpls {
+		podSpec, err := p.fetchPodSpec(tp, opts.TemplateName, podTemplateFileName, opts.Name, opts.ValuesFiles, opts.ArgParams)
+		if err != nil {
+			return err
+		}
+
+		if _, err := parseResourceAnnotations(podSpec); err != nil {
+			return &errdefs.ValidationError{Rule: resourcesRule, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// applyResourceLimits sets the resource annotations as both the requests and the limits of the containers
+// in the rendered pod manifest, overriding the resources set by the template itself.
+func applyResourceLimits(manifest []byte, resources map[string]map[string]string) ([]byte, error) {
+	var pod map[string]any
+	if err := k8syaml.Unmarshal(manifest, &pod); err != nil {
+		return nil, fmt.Errorf("unable to read YAML as Kube Pod: %w", err)
+	}
+
+	spec, _ := pod["spec"].(map[string]any)
+	containers, _ := spec["containers"].([]any)
+
+	for _, c := range containers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _ := container["name"].(string)
+		limits, ok := resources[name]
+		if !ok {
+			continue
+		}
+
+		containerResources, ok := container["resources"].(map[string]any)
+		if !ok {
+			containerResources = map[string]any{}
+			container["resources"] = containerResources
+		}
+
+		for _, kind := range []string{"requests", "limits"} {
+			quantities, ok := containerResources[kind].(map[string]any)
+			if !ok {
+				quantities = map[string]any{}
+				containerResources[kind] = quantities
+			}
+
+			for resource, quantity := range limits {
+				quantities[resource] = quantity
+			}
+		}
+	}
+
+	return k8syaml.Marshal(pod)
+}
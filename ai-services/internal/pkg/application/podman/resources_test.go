@@ -0,0 +1,151 @@
+package podman
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+)
+
+func TestParseResourceAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        map[string]map[string]string
+		wantErr     string
+	}{
+		{name: "none", annotations: map[string]string{"ai-services.io/vllm--spyre-cards": "1"}, want: map[string]map[string]string{}},
+		{
+			name: "memory and cpu",
+			annotations: map[string]string{
+				"ai-services.io/resources.opensearch.memory": "4Gi",
+				"ai-services.io/resources.opensearch.cpu":    "2",
+				"ai-services.io/resources.proxy.memory":      " 512Mi ",
+				"ai-services.io/resources.proxy.cpu":         "500m",
+			},
+			want: map[string]map[string]string{
+				"opensearch": {"memory": "4Gi", "cpu": "2"},
+				"proxy":      {"memory": "512Mi", "cpu": "500m"},
+			},
+		},
+		{name: "decimal cpu", annotations: map[string]string{"ai-services.io/resources.proxy.cpu": "0.5"}, want: map[string]map[string]string{"proxy": {"cpu": "0.5"}}},
+		{name: "empty means no limit", annotations: map[string]string{"ai-services.io/resources.opensearch.memory": ""}, want: map[string]map[string]string{}},
+		{name: "memory without unit", annotations: map[string]string{"ai-services.io/resources.opensearch.memory": "4096"}, wantErr: "invalid memory '4096'"},
+		{name: "memory in unsupported unit", annotations: map[string]string{"ai-services.io/resources.opensearch.memory": "4G"}, wantErr: "invalid memory '4G'"},
+		{name: "invalid cpu", annotations: map[string]string{"ai-services.io/resources.opensearch.cpu": "two"}, wantErr: "invalid cpu 'two'"},
+		{name: "unsupported resource", annotations: map[string]string{"ai-services.io/resources.opensearch.gpu": "1"}, wantErr: "unsupported resource 'gpu'"},
+		{name: "unknown container", annotations: map[string]string{"ai-services.io/resources.milvus.memory": "1Gi"}, wantErr: "container 'milvus' which is not part of pod 'app--opensearch'"},
+		{name: "missing resource", annotations: map[string]string{"ai-services.io/resources.opensearch": "1Gi"}, wantErr: "must be in the form"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podSpec := &models.PodSpec{Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "app--opensearch", Annotations: tt.annotations},
+				Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "opensearch"}, {Name: "proxy"}}},
+			}}
+
+			got, err := parseResourceAnnotations(podSpec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("parseResourceAnnotations() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseResourceAnnotations() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseResourceAnnotations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyResourceLimits(t *testing.T) {
+	manifest := []byte(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app--opensearch
+spec:
+  containers:
+    - name: opensearch
+      resources:
+        limits:
+          memory: 1Gi
+          cpu: "4"
+    - name: proxy
+`)
+
+	out, err := applyResourceLimits(manifest, map[string]map[string]string{"opensearch": {"memory": "8Gi"}})
+	if err != nil {
+		t.Fatalf("applyResourceLimits() error = %v", err)
+	}
+
+	var pod v1.Pod
+	if err := k8syaml.Unmarshal(out, &pod); err != nil {
+		t.Fatal(err)
+	}
+
+	opensearch := pod.Spec.Containers[0].Resources
+	if got := opensearch.Limits.Memory().String(); got != "8Gi" {
+		t.Errorf("opensearch memory limit = %s, want the annotation to override the template", got)
+	}
+	if got := opensearch.Requests.Memory().String(); got != "8Gi" {
+		t.Errorf("opensearch memory request = %s, want 8Gi", got)
+	}
+	if got := opensearch.Limits.Cpu().String(); got != "4" {
+		t.Errorf("opensearch cpu limit = %s, want the template limit kept", got)
+	}
+	if proxy := pod.Spec.Containers[1].Resources; len(proxy.Limits) > 0 || len(proxy.Requests) > 0 {
+		t.Errorf("proxy resources = %v, want none", proxy)
+	}
+}
+
+// TestResourceAnnotationOverride renders the opensearch template of rag, asserting that the memory limit of the
+// template values is overridden by a values file, which is in turn overridden by --params.
+func TestResourceAnnotationOverride(t *testing.T) {
+	valuesFile := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(valuesFile, []byte("opensearch:\n  memoryLimit: 6Gi\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		valuesFiles []string
+		params      map[string]string
+		want        string
+	}{
+		{name: "template default", want: "4Gi"},
+		{name: "values file", valuesFiles: []string{valuesFile}, want: "6Gi"},
+		{name: "params", valuesFiles: []string{valuesFile}, params: map[string]string{"opensearch.memoryLimit": "8Gi"}, want: "8Gi"},
+	}
+
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podSpec, err := tp.LoadPodTemplateWithValues("rag", "opensearch.yaml.tmpl", "app", tt.valuesFiles, tt.params)
+			if err != nil {
+				t.Fatalf("LoadPodTemplateWithValues() error = %v", err)
+			}
+
+			resources, err := parseResourceAnnotations(podSpec)
+			if err != nil {
+				t.Fatalf("parseResourceAnnotations() error = %v", err)
+			}
+			if got := resources["opensearch"]["memory"]; got != tt.want {
+				t.Errorf("opensearch memory = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
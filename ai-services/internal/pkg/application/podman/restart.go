@@ -0,0 +1,114 @@
+package podman
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// Restart restarts the application pods, only the unhealthy ones if requested, and waits for them to be healthy again.
+func (p *PodmanApplication) Restart(opts appTypes.RestartOptions) error {
+	pods, err := helpers.ListApplicationPods(p.runtime, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		return errdefs.NoPodsFound(opts.Name)
+	}
+
+	podsToRestart, err := p.fetchPodsToStop(pods, opts.PodNames, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if opts.OnUnhealthy {
+		podsToRestart, err = common.UnhealthyPods(p.runtime, podsToRestart)
+		if err != nil {
+			return err
+		}
+
+		if len(podsToRestart) == 0 {
+			logger.Infof("No unhealthy pods found for given application: %s, nothing to restart\n", opts.Name)
+
+			return nil
+		}
+	}
+
+	if len(podsToRestart) == 0 {
+		logger.Infof("Invalid/No pods found to restart for given application: %s\n", opts.Name)
+
+		return nil
+	}
+
+	logger.Infoln("Below pods will be restarted:")
+	for _, pod := range podsToRestart {
+		logger.Infof("\t-> %s\n", pod.Name)
+	}
+
+	if !opts.AutoYes {
+		confirmRestart, err := utils.ConfirmAction("Are you sure you want to restart the above pods? ")
+		if err != nil {
+			return fmt.Errorf("failed to take user input: %w", err)
+		}
+
+		if !confirmRestart {
+			logger.Infof("Skipping restarting of pods\n")
+
+			return nil
+		}
+	}
+
+	restarted, err := p.restartPods(podsToRestart)
+	if len(restarted) > 0 {
+		// the restarted pods are waited for even if others failed, to report on their recovery
+		waitErr := common.WaitForPods(p.runtime, appTypes.WaitOptions{
+			Name:      opts.Name,
+			PodNames:  restarted,
+			Condition: appTypes.WaitConditionHealthy,
+			Timeout:   opts.Timeout,
+		})
+		if waitErr != nil {
+			waitErr = fmt.Errorf("restarted pods did not recover: %w", waitErr)
+		}
+		err = errors.Join(err, waitErr)
+	}
+
+	return err
+}
+
+// restartPods stops and starts the pods, returning the names of the ones restarted successfully.
+func (p *PodmanApplication) restartPods(pods []types.Pod) ([]string, error) {
+	var restarted, failed []string
+	for _, pod := range pods {
+		logger.Infof("Restarting the pod: %s\n", pod.Name)
+
+		if err := p.runtime.StopPod(pod.ID); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", pod.Name, err))
+
+			continue
+		}
+
+		if err := p.runtime.StartPod(pod.ID); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", pod.Name, err))
+
+			continue
+		}
+
+		restarted = append(restarted, pod.Name)
+	}
+
+	if len(failed) > 0 {
+		return restarted, fmt.Errorf("failed to restart pods: \n%s", strings.Join(failed, "\n"))
+	}
+
+	return restarted, nil
+}
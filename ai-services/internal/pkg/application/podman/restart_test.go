@@ -0,0 +1,75 @@
+package podman
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// TestRestartOnUnhealthy asserts that --on-unhealthy only restarts the pods reporting unhealthy, leaving the healthy
+// and the stopped ones alone, and that the restarted pods are awaited to recover.
+func TestRestartOnUnhealthy(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        appTypes.RestartOptions
+		noRecovery  bool
+		wantRestart []string
+		wantErr     string
+	}{
+		{name: "unhealthy pods only", opts: appTypes.RestartOptions{OnUnhealthy: true}, wantRestart: []string{"app--vllm-id", "app--reranker-id"}},
+		{name: "selected healthy pod", opts: appTypes.RestartOptions{OnUnhealthy: true, PodNames: []string{"app--ui"}}},
+		{name: "selected unhealthy pod", opts: appTypes.RestartOptions{OnUnhealthy: true, PodNames: []string{"app--ui", "app--vllm"}}, wantRestart: []string{"app--vllm-id"}},
+		{name: "all pods", wantRestart: []string{"app--vllm-id", "app--ui-id", "app--reranker-id", "app--db-id"}},
+		{
+			name: "no recovery", opts: appTypes.RestartOptions{OnUnhealthy: true, PodNames: []string{"app--vllm"}}, noRecovery: true,
+			wantRestart: []string{"app--vllm-id"}, wantErr: "restarted pods did not recover",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addAppPod(r, "app", "app--vllm", "Running", "unhealthy")
+			addAppPod(r, "app", "app--ui", "Running", "healthy")
+			addAppPod(r, "app", "app--reranker", "Running", "unhealthy")
+			addAppPod(r, "app", "app--db", "Exited", "unhealthy")
+			r.OnStartPod = func(r *fake.Runtime, id string) {
+				health := "healthy"
+				if tt.noRecovery {
+					health = "unhealthy"
+				}
+				name := strings.TrimSuffix(id, "-id")
+				r.SetContainer(types.Container{ID: name + "-c", Name: name + "-c", Status: "running", Health: health})
+			}
+
+			opts := tt.opts
+			opts.Name, opts.AutoYes, opts.Timeout = "app", true, 50*time.Millisecond
+
+			err := NewPodmanApplication(r).Restart(opts)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Restart() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("Restart() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+
+			var stopped, started []string
+			for _, call := range r.Calls() {
+				if id, ok := strings.CutPrefix(call, "StopPod:"); ok {
+					stopped = append(stopped, id)
+				}
+				if id, ok := strings.CutPrefix(call, "StartPod:"); ok {
+					started = append(started, id)
+				}
+			}
+			if !slices.Equal(stopped, tt.wantRestart) || !slices.Equal(started, tt.wantRestart) {
+				t.Errorf("Restart() stopped %v and started %v, want %v restarted", stopped, started, tt.wantRestart)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package podman
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// activePodStatuses are the statuses of the pods whose containers are, at least partially, running.
+var activePodStatuses = []string{"Running", "Degraded"}
+
+// smtRequirement is the SMT level required by a running application.
+type smtRequirement struct {
+	// app is the application, prefixed with its namespace if it has one.
+	app   string
+	level int
+}
+
+// runningSMTRequirements returns the SMT levels required by the running applications other than appName.
+// The SMT level is a host-global setting, hence the applications of all the namespaces are considered.
+func (p *PodmanApplication) runningSMTRequirements(appName string) ([]smtRequirement, error) {
+	pods, err := p.runtime.ListPods(map[string][]string{"label": {constants.ApplicationAnnotationKey}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// the template of each running application, keyed by the application
+	apps := map[string]string{}
+	for _, pod := range pods {
+		app := pod.Labels[constants.ApplicationAnnotationKey]
+		namespace := pod.Labels[constants.NamespaceLabelKey]
+		if !slices.Contains(activePodStatuses, pod.Status) || (app == appName && namespace == vars.Namespace) {
+			continue
+		}
+
+		if namespace != "" {
+			app = namespace + "/" + app
+		}
+		apps[app] = pod.Labels[string(vars.TemplateLabel)]
+	}
+
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+
+	requirements := []smtRequirement{}
+	for app, templateName := range apps {
+		appMetadata, err := tp.LoadMetadata(templateName, false)
+		if err != nil {
+			// the template of an application created by another version may not exist anymore, it cannot conflict then
+			logger.Infof("failed to read the metadata of application '%s': %v\n", app, err, logger.VerbosityLevelDebug)
+
+			continue
+		}
+
+		if appMetadata.SMTLevel != nil {
+			requirements = append(requirements, smtRequirement{app: app, level: *appMetadata.SMTLevel})
+		}
+	}
+
+	return requirements, nil
+}
+
+// smtConflicts returns the applications among requirements which require a different SMT level than target, sorted.
+func smtConflicts(requirements []smtRequirement, target int) []string {
+	conflicts := []string{}
+	for _, req := range requirements {
+		if req.level != target {
+			conflicts = append(conflicts, fmt.Sprintf("%s (SMT %d)", req.app, req.level))
+		}
+	}
+	slices.Sort(conflicts)
+
+	return conflicts
+}
+
+// verifySMTChange makes sure changing the SMT level to target does not disrupt other running applications.
+// A conflict fails the create unless force is set, in which case it is only warned about.
+func (p *PodmanApplication) verifySMTChange(appName string, target int, force bool) error {
+	requirements, err := p.runningSMTRequirements(appName)
+	if err != nil {
+		return fmt.Errorf("failed to check the SMT level of the running applications: %w", err)
+	}
+
+	conflicts := smtConflicts(requirements, target)
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("changing the SMT level to %d may disrupt the running applications: %s", target, strings.Join(conflicts, ", "))
+	if !force {
+		return fmt.Errorf("%s. Use --force to change it anyway", msg)
+	}
+
+	if p.strict {
+		return fmt.Errorf("%s, which is not allowed in strict mode", msg)
+	}
+
+	logger.Warningf("%s\n", msg)
+
+	return nil
+}
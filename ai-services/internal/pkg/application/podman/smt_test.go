@@ -0,0 +1,166 @@
+package podman
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestSMTConflicts(t *testing.T) {
+	requirements := []smtRequirement{
+		{app: "team/rag", level: 4},
+		{app: "chat", level: 2},
+		{app: "ingest", level: 8},
+	}
+
+	tests := []struct {
+		name         string
+		requirements []smtRequirement
+		target       int
+		want         []string
+	}{
+		{name: "no running applications", target: 2, want: []string{}},
+		{name: "conflicts sorted", requirements: requirements, target: 2, want: []string{"ingest (SMT 8)", "team/rag (SMT 4)"}},
+		{name: "no conflict", requirements: []smtRequirement{{app: "chat", level: 2}}, target: 2, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := smtConflicts(tt.requirements, tt.target); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("smtConflicts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// addTemplatePod adds a pod of an application created from the given template to the fake runtime.
+func addTemplatePod(r *fake.Runtime, namespace, app, templateName, status string) {
+	labels := map[string]string{constants.ApplicationAnnotationKey: app, string(vars.TemplateLabel): templateName}
+	if namespace != "" {
+		labels[constants.NamespaceLabelKey] = namespace
+	}
+
+	name := app + "--vllm-server"
+	if namespace != "" {
+		name = namespace + "--" + name
+	}
+	r.AddPod(types.Pod{ID: name + "-id", Name: name, Status: status, State: status, Labels: labels})
+}
+
+// TestRunningSMTRequirements reads the SMT levels required by the running applications from the metadata of
+// their templates, the embedded rag template requiring SMT 2.
+func TestRunningSMTRequirements(t *testing.T) {
+	type pod struct {
+		namespace, app, template, status string
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		pods      []pod
+		want      []smtRequirement
+	}{
+		{name: "no running applications", want: []smtRequirement{}},
+		{
+			name: "running application",
+			pods: []pod{{app: "chat", template: "rag", status: "Running"}},
+			want: []smtRequirement{{app: "chat", level: 2}},
+		},
+		{
+			name: "degraded application",
+			pods: []pod{{app: "chat", template: "rag", status: "Degraded"}},
+			want: []smtRequirement{{app: "chat", level: 2}},
+		},
+		{
+			name: "stopped application",
+			pods: []pod{{app: "chat", template: "rag", status: "Exited"}},
+			want: []smtRequirement{},
+		},
+		{
+			name: "the application itself excluded",
+			pods: []pod{{app: "app", template: "rag", status: "Running"}},
+			want: []smtRequirement{},
+		},
+		{
+			name:      "the application itself excluded in its namespace",
+			namespace: "team",
+			pods:      []pod{{namespace: "team", app: "app", template: "rag", status: "Running"}},
+			want:      []smtRequirement{},
+		},
+		{
+			name: "namespaced application prefixed",
+			pods: []pod{{namespace: "team", app: "app", template: "rag", status: "Running"}},
+			want: []smtRequirement{{app: "team/app", level: 2}},
+		},
+		{
+			name: "unknown template skipped",
+			pods: []pod{{app: "chat", template: "retired", status: "Running"}},
+			want: []smtRequirement{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := vars.Namespace
+			vars.Namespace = tt.namespace
+			t.Cleanup(func() { vars.Namespace = orig })
+
+			r := fake.New()
+			for _, pod := range tt.pods {
+				addTemplatePod(r, pod.namespace, pod.app, pod.template, pod.status)
+			}
+
+			got, err := NewPodmanApplication(r).runningSMTRequirements("app")
+			if err != nil {
+				t.Fatalf("runningSMTRequirements() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("runningSMTRequirements() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestVerifySMTChange asserts that a conflicting SMT change fails the create unless forced, and is never allowed
+// in strict mode.
+func TestVerifySMTChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  int
+		force   bool
+		strict  bool
+		wantErr string
+	}{
+		{name: "no conflict", target: 2},
+		{name: "conflict", target: 4, wantErr: "may disrupt the running applications: chat (SMT 2). Use --force"},
+		{name: "forced conflict", target: 4, force: true},
+		{name: "forced conflict in strict mode", target: 4, force: true, strict: true, wantErr: "not allowed in strict mode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			addTemplatePod(r, "", "chat", "rag", "Running")
+
+			p := NewPodmanApplication(r)
+			p.strict = tt.strict
+
+			err := p.verifySMTChange("app", tt.target, tt.force)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("verifySMTChange() error = %v, want nil", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("verifySMTChange() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package podman
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// spyreReductions records the containers deployed with fewer Spyre cards than declared by the template, guarded by envMutex.
+// Key -> pod name, Value -> reduced allocation per container name.
+var spyreReductions = map[string]map[string]spyreReduction{}
+
+// spyreReduction holds the declared and the actually allocated Spyre card count of a container.
+type spyreReduction struct {
+	requested int
+	allocated int
+}
+
+// spyreRequest is the Spyre card count declared for a container of a pod.
+type spyreRequest struct {
+	pod       string
+	container string
+	count     int
+}
+
+// planSpyreReduction spreads the available Spyre cards across the containers requesting them.
+// Every container gets at least one card, the rest is handed out in pod and container name order. The reduced counts
+// are powers of two, as the cards are used for tensor parallelism where the world size has to divide the model.
+// It returns the containers whose allocation got reduced, keyed by pod and container name.
+func planSpyreReduction(podRequests map[string]map[string]int, available int) (map[string]map[string]spyreReduction, error) {
+	var requests []spyreRequest
+	for pod, containers := range podRequests {
+		for container, count := range containers {
+			if count > 0 {
+				requests = append(requests, spyreRequest{pod: pod, container: container, count: count})
+			}
+		}
+	}
+	slices.SortFunc(requests, func(a, b spyreRequest) int {
+		return cmp.Or(cmp.Compare(a.pod, b.pod), cmp.Compare(a.container, b.container))
+	})
+
+	if len(requests) > available {
+		return nil, fmt.Errorf("insufficient spyre cards even with reduced allocation. Require: at least %d spyre cards, one per container, found %d free",
+			len(requests), available)
+	}
+
+	// one card per container is reserved upfront, the rest is spare
+	spare := available - len(requests)
+	reductions := map[string]map[string]spyreReduction{}
+	for _, req := range requests {
+		allocated := 1
+		// doubling the allocation takes as many spare cards as already allocated
+		for allocated*2 <= req.count && allocated <= spare {
+			spare -= allocated
+			allocated *= 2
+		}
+
+		if allocated == req.count {
+			continue
+		}
+
+		if reductions[req.pod] == nil {
+			reductions[req.pod] = map[string]spyreReduction{}
+		}
+		reductions[req.pod][req.container] = spyreReduction{requested: req.count, allocated: allocated}
+	}
+
+	return reductions, nil
+}
+
+// recordSpyreReductions records the reduced allocations, so that the pods are deployed with them, and warns about them.
+func recordSpyreReductions(reductions map[string]map[string]spyreReduction) {
+	envMutex.Lock()
+	defer envMutex.Unlock()
+
+	for pod, containers := range reductions {
+		spyreReductions[pod] = containers
+		for container, reduction := range containers {
+			logger.Warningf("Reduced Spyre allocation for pod '%s', container '%s': %d of %d requested spyre cards\n",
+				pod, container, reduction.allocated, reduction.requested)
+		}
+	}
+}
+
+// spyreReductionSummary returns the reduced allocations of the given pod for the create summary.
+// The caller must hold envMutex.
+func spyreReductionSummary(pod string) []types.SpyreReduction {
+	var summary []types.SpyreReduction
+	for container, reduction := range spyreReductions[pod] {
+		summary = append(summary, types.SpyreReduction{
+			Pod:       pod,
+			Container: container,
+			Requested: reduction.requested,
+			Allocated: reduction.allocated,
+		})
+	}
+	slices.SortFunc(summary, func(a, b types.SpyreReduction) int {
+		return cmp.Compare(a.Container, b.Container)
+	})
+
+	return summary
+}
@@ -0,0 +1,143 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+)
+
+// fakeSpyreLock points the allocation lock to a temporary file, retried quickly.
+func fakeSpyreLock(t *testing.T) {
+	t.Helper()
+
+	origPath, origFind, origInterval := spyreLockPath, findFreeSpyreCards, spyreLockRetryInterval
+	t.Cleanup(func() { spyreLockPath, findFreeSpyreCards, spyreLockRetryInterval = origPath, origFind, origInterval })
+	spyreLockPath = filepath.Join(t.TempDir(), "spyre.lock")
+	spyreLockRetryInterval = time.Millisecond
+}
+
+// TestAllocateSpyreCardsConcurrent simulates concurrent creates, each one allocating cards and deploying the pods
+// opening them. The allocation lock makes sure no card is handed out twice, although a card only turns busy once
+// the pod using it is deployed.
+func TestAllocateSpyreCardsConcurrent(t *testing.T) {
+	const creates, cardsPerCreate = 4, 2
+
+	var mu sync.Mutex
+	cards := []string{"0000:01:00.0", "0000:02:00.0", "0000:03:00.0", "0000:04:00.0",
+		"0000:05:00.0", "0000:06:00.0", "0000:07:00.0", "0000:08:00.0"}
+	busy := map[string]bool{}
+
+	fakeSpyreLock(t)
+	findFreeSpyreCards = func() ([]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return slices.DeleteFunc(slices.Clone(cards), func(card string) bool { return busy[card] }), nil
+	}
+
+	allocated := make([][]string, creates)
+	errs := make([]error, creates)
+	var wg sync.WaitGroup
+	for i := range creates {
+		wg.Go(func() {
+			p := NewPodmanApplication(fake.New())
+			defer p.releaseSpyreAllocation()
+
+			requests := map[string]map[string]int{"pod": {"vllm": cardsPerCreate}}
+			pciAddresses, err := p.allocateSpyreCards(context.Background(), cardsPerCreate, requests, false)
+			if err != nil {
+				errs[i] = err
+
+				return
+			}
+
+			// widen the window between finding the cards free and deploying the pod opening them
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			allocated[i] = pciAddresses[:cardsPerCreate]
+			for _, card := range allocated[i] {
+				busy[card] = true
+			}
+		})
+	}
+	wg.Wait()
+
+	seen := map[string]int{}
+	for i := range creates {
+		if errs[i] != nil {
+			t.Fatalf("create %d: allocateSpyreCards() error = %v", i, errs[i])
+		}
+		for _, card := range allocated[i] {
+			if other, ok := seen[card]; ok {
+				t.Errorf("card %s allocated to both create %d and %d", card, other, i)
+			}
+			seen[card] = i
+		}
+	}
+}
+
+func TestAllocateSpyreCardsReleasesLock(t *testing.T) {
+	fakeSpyreLock(t)
+	findFreeSpyreCards = func() ([]string, error) { return []string{"0000:01:00.0"}, nil }
+
+	requests := map[string]map[string]int{"pod": {"vllm": 2}}
+
+	// a failed allocation still holds the lock until released
+	p := NewPodmanApplication(fake.New())
+	if _, err := p.allocateSpyreCards(context.Background(), 2, requests, false); err == nil {
+		t.Fatal("allocateSpyreCards() error = nil, want not enough free cards")
+	}
+	p.releaseSpyreAllocation()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	other := NewPodmanApplication(fake.New())
+	defer other.releaseSpyreAllocation()
+	if _, err := other.allocateSpyreCards(ctx, 1, map[string]map[string]int{"pod": {"vllm": 1}}, false); err != nil {
+		t.Errorf("allocateSpyreCards() after the release error = %v", err)
+	}
+}
+
+// TestFindSpyreCardsStrict asserts that a reduced Spyre allocation, allowed by --allow-reduced-spyre, fails in strict mode.
+func TestFindSpyreCardsStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		strict  bool
+		free    []string
+		wantErr bool
+	}{
+		{name: "reduced", free: []string{"0000:01:00.0"}},
+		{name: "reduced in strict mode", strict: true, free: []string{"0000:01:00.0"}, wantErr: true},
+		{name: "enough cards in strict mode", strict: true, free: []string{"0000:01:00.0", "0000:02:00.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSpyreLock(t)
+			findFreeSpyreCards = func() ([]string, error) { return tt.free, nil }
+
+			p := NewPodmanApplication(fake.New())
+			p.strict = tt.strict
+
+			requests := map[string]map[string]int{"pod": {"vllm": 2}}
+			_, _, err := p.findSpyreCards(2, requests, true)
+
+			var spyreErr *errdefs.SpyreError
+			if tt.wantErr != errors.As(err, &spyreErr) {
+				t.Errorf("findSpyreCards() error = %v, want a SpyreError %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("findSpyreCards() error = %v", err)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -18,7 +20,7 @@ func (p *PodmanApplication) Start(opts appTypes.StartOptions) error {
 		return err
 	}
 	if len(pods) == 0 {
-		logger.Infof("No pods found with given application: %s\n", opts.Name)
+		logger.Infoln(errdefs.NoPodsFound(opts.Name).Error())
 
 		return nil
 	}
@@ -39,14 +41,7 @@ func (p *PodmanApplication) Start(opts appTypes.StartOptions) error {
 
 // Start implementation helper methods.
 func (p *PodmanApplication) fetchPodsFromRuntime(appName string) ([]types.Pod, error) {
-	pods, err := p.runtime.ListPods(map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list pods: %w", err)
-	}
-
-	return pods, nil
+	return helpers.ListApplicationPods(p.runtime, appName)
 }
 
 func (p *PodmanApplication) fetchPodsToStart(pods []types.Pod, podNames []string) ([]types.Pod, error) {
@@ -62,7 +57,7 @@ func (p *PodmanApplication) confirmAndStartPods(podsToStart []types.Pod, autoYes
 	printLogs := p.shouldPrintLogs(podsToStart, skipLogs)
 
 	if !autoYes {
-		confirmStart, err := utils.ConfirmAction("Are you sure you want to start above pods? ")
+		confirmStart, err := utils.ConfirmAction("Are you sure you want to start the above pods? ")
 		if err != nil {
 			return fmt.Errorf("failed to take user input: %w", err)
 		}
@@ -142,7 +137,7 @@ func (p *PodmanApplication) startPods(podsToStart []types.Pod) error {
 func (p *PodmanApplication) printPodLogs(podsToStart []types.Pod) error {
 	logger.Infof("\n--- Following logs for pod: %s ---\n", podsToStart[0].Name)
 
-	if err := p.runtime.PodLogs(podsToStart[0].Name); err != nil {
+	if err := p.runtime.PodLogs(podsToStart[0].Name, types.LogOptions{}); err != nil {
 		if strings.Contains(err.Error(), "signal: interrupt") || strings.Contains(err.Error(), "context canceled") {
 			logger.Infoln("Log following stopped.")
 
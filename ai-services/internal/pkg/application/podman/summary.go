@@ -0,0 +1,172 @@
+package podman
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// printCreateSummary prints the resources allocated to the created application.
+// Failing to build the summary does not fail the create, it is only logged.
+func (p *PodmanApplication) printCreateSummary(opts types.CreateOptions) {
+	summary, err := p.buildCreateSummary(opts)
+	if err != nil {
+		logger.Warningf("failed to build the create summary: %v\n", err)
+
+		return
+	}
+
+	if strings.ToLower(opts.OutputFormat) == "json" {
+		data, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			logger.Warningf("failed to marshal the create summary: %v\n", err)
+
+			return
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+
+		return
+	}
+
+	logger.Infoln("Summary:")
+	printer := utils.NewTableWriter()
+	printer.SetHeaders("POD", "SPYRE CARDS", "PORTS")
+	for _, pod := range summary.Pods {
+		printer.AppendRow(pod.Name, joinOrNone(pod.SpyreCards), joinOrNone(displayPorts(pod.PortMappings)))
+	}
+	printer.CloseTableWriter()
+
+	for _, pod := range summary.Pods {
+		for _, mapping := range pod.PortMappings {
+			if mapping.Assigned {
+				logger.Infof("Pod '%s': container port %s was assigned host port %s\n", pod.Name, mapping.ContainerPort, mapping.HostPort)
+			}
+		}
+	}
+
+	for _, reduction := range summary.ReducedSpyreCards {
+		logger.Warningf("Pod '%s', container '%s' runs with a reduced Spyre allocation: %d of %d requested spyre cards\n",
+			reduction.Pod, reduction.Container, reduction.Allocated, reduction.Requested)
+	}
+
+	logger.Infoln("Models: " + joinOrNone(summary.Models))
+	logger.Infoln("URLs: " + joinOrNone(summary.URLs))
+	logger.Infoln("-------")
+}
+
+func (p *PodmanApplication) buildCreateSummary(opts types.CreateOptions) (*types.CreateSummary, error) {
+	pods, err := helpers.ListApplicationPods(p.runtime, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &types.CreateSummary{
+		Name:      opts.Name,
+		Template:  opts.TemplateName,
+		Pods:      []types.PodSummary{},
+		Models:    []string{},
+		URLs:      []string{},
+		NextSteps: []templates.NextStep{},
+	}
+
+	// the host IP is only needed to construct the URLs, hence not failing if it cannot be fetched
+	hostIP, err := helpers.DetectHostIP()
+	if err != nil {
+		logger.Infof("unable to fetch the host IP: %v\n", err, logger.VerbosityLevelDebug)
+	}
+	summary.HostIP = hostIP
+
+	envMutex.Lock()
+	defer envMutex.Unlock()
+
+	for _, pod := range pods {
+		podSummary := types.PodSummary{
+			Name:       pod.Name,
+			SpyreCards: append([]string{}, spyreAllocations[pod.Name]...),
+			Ports:      []string{},
+		}
+
+		podSummary.PortMappings = p.podPortMappings(pod.ID, pod.Name)
+		for _, mapping := range podSummary.PortMappings {
+			podSummary.Ports = append(podSummary.Ports, mapping.HostPort+"->"+mapping.ContainerPort)
+			if hostIP != "" {
+				summary.URLs = append(summary.URLs, fmt.Sprintf("http://%s:%s", hostIP, mapping.HostPort))
+			}
+		}
+
+		summary.Pods = append(summary.Pods, podSummary)
+		summary.ReducedSpyreCards = append(summary.ReducedSpyreCards, spyreReductionSummary(pod.Name)...)
+	}
+	slices.Sort(summary.URLs)
+
+	// the models and services are declared by the template, a pod deployed from a file has none
+	if opts.TemplateName != "" {
+		services, err := helpers.ResolvePrimaryURLs(p.runtime, opts.Name, opts.TemplateName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the service URLs: %w", err)
+		}
+		summary.Services = services
+		// the URLs of the declared services take precedence over the ones of all the published ports
+		if urls := availableURLs(services); len(urls) > 0 {
+			summary.URLs = urls
+		}
+
+		models, err := helpers.ListModels(opts.TemplateName, opts.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list models: %w", err)
+		}
+		summary.Models = models
+
+		nextSteps, err := helpers.NextSteps(p.runtime, opts.Name, opts.TemplateName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load next steps: %w", err)
+		}
+		if nextSteps != nil {
+			summary.NextSteps = nextSteps
+		}
+	}
+
+	return summary, nil
+}
+
+// availableURLs returns the URLs of the available services.
+func availableURLs(services []templates.ServiceURL) []string {
+	var urls []string
+	for _, service := range services {
+		if service.Available {
+			urls = append(urls, service.URL)
+		}
+	}
+
+	return urls
+}
+
+// displayPorts formats the port mappings for the summary table, flagging the host ports assigned by podman.
+func displayPorts(mappings []types.PortMapping) []string {
+	ports := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		port := mapping.HostPort + "->" + mapping.ContainerPort
+		if mapping.Assigned {
+			port += " (assigned)"
+		}
+		ports = append(ports, port)
+	}
+
+	return ports
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+
+	return strings.Join(values, ", ")
+}
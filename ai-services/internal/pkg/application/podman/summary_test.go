@@ -0,0 +1,81 @@
+package podman
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestBuildCreateSummaryPorts asserts that the host ports bound by the pods are reported in the summary, the ones
+// assigned by podman for the ports published without a host port, eg:- ui.port not set, being flagged.
+func TestBuildCreateSummaryPorts(t *testing.T) {
+	advertiseIP, assigned := vars.AdvertiseIP, assignedPorts
+	t.Cleanup(func() { vars.AdvertiseIP, assignedPorts = advertiseIP, assigned })
+	vars.AdvertiseIP = "10.0.0.5"
+	assignedPorts = map[string][]string{}
+
+	r := fake.New()
+	r.AddPod(runtimeTypes.Pod{
+		ID:     "chat-bot-id",
+		Name:   "app--chat-bot",
+		Labels: map[string]string{constants.ApplicationAnnotationKey: "app"},
+		Ports:  map[string][]string{"3000/tcp": {"41235"}, "5000/tcp": {"5000"}},
+	})
+	// ui.port is not set, hence rendered as ':3000'
+	recordAssignedPorts("app--chat-bot", map[string]string{"3000": "", "5000": "5000"})
+
+	summary, err := NewPodmanApplication(r).buildCreateSummary(types.CreateOptions{Name: "app"})
+	if err != nil {
+		t.Fatalf("buildCreateSummary() error = %v", err)
+	}
+	if len(summary.Pods) != 1 {
+		t.Fatalf("buildCreateSummary() pods = %+v, want one", summary.Pods)
+	}
+
+	pod := summary.Pods[0]
+	wantMappings := []types.PortMapping{
+		{HostPort: "41235", ContainerPort: "3000/tcp", Assigned: true},
+		{HostPort: "5000", ContainerPort: "5000/tcp"},
+	}
+	if !reflect.DeepEqual(pod.PortMappings, wantMappings) {
+		t.Errorf("port mappings = %+v, want %+v", pod.PortMappings, wantMappings)
+	}
+	if want := []string{"41235->3000/tcp", "5000->5000/tcp"}; !slices.Equal(pod.Ports, want) {
+		t.Errorf("ports = %v, want %v", pod.Ports, want)
+	}
+	if want := []string{"41235->3000/tcp (assigned)", "5000->5000/tcp"}; !slices.Equal(displayPorts(pod.PortMappings), want) {
+		t.Errorf("displayPorts() = %v, want %v", displayPorts(pod.PortMappings), want)
+	}
+	if want := []string{"http://10.0.0.5:41235", "http://10.0.0.5:5000"}; !slices.Equal(summary.URLs, want) {
+		t.Errorf("URLs = %v, want %v", summary.URLs, want)
+	}
+}
+
+func TestIsAssignedPort(t *testing.T) {
+	tests := []struct {
+		name          string
+		assigned      []string
+		containerPort string
+		want          bool
+	}{
+		{name: "with protocol", assigned: []string{"3000"}, containerPort: "3000/tcp", want: true},
+		{name: "same form", assigned: []string{"3000"}, containerPort: "3000", want: true},
+		{name: "other port", assigned: []string{"3000"}, containerPort: "5000/tcp"},
+		{name: "port prefix", assigned: []string{"300"}, containerPort: "3000/tcp"},
+		{name: "none assigned", containerPort: "3000/tcp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAssignedPort(tt.assigned, tt.containerPort); got != tt.want {
+				t.Errorf("isAssignedPort(%v, %q) = %v, want %v", tt.assigned, tt.containerPort, got, tt.want)
+			}
+		})
+	}
+}
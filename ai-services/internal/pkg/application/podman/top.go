@@ -0,0 +1,160 @@
+package podman
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// percentFormat formats the CPU and memory usage.
+	percentFormat = "%.2f%%"
+	percent       = 100
+)
+
+// Top displays the CPU and memory usage of the application containers, or with opts.Spyre the Spyre cards allocated
+// to them along with their state on the host.
+func (p *PodmanApplication) Top(opts types.TopOptions) error {
+	pods, err := helpers.ListApplicationPods(p.runtime, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(pods) == 0 {
+		logger.Infoln(errdefs.NoPodsFound(opts.Name).Error())
+
+		return nil
+	}
+
+	containers := p.runningContainers(pods)
+	if opts.Spyre {
+		return p.printSpyreTop(opts.Name, containers)
+	}
+
+	return p.printContainerTop(containers)
+}
+
+// podContainer is a running container along with the name of its pod.
+type podContainer struct {
+	pod       string
+	container runtimeTypes.Container
+}
+
+// runningContainers returns the running containers of the pods, the pods which cannot be inspected are skipped.
+func (p *PodmanApplication) runningContainers(pods []runtimeTypes.Pod) []podContainer {
+	var containers []podContainer
+	for _, pod := range pods {
+		pInfo, err := p.runtime.InspectPod(pod.ID)
+		if err != nil {
+			logger.Errorf("Failed to do pod inspect: '%s' with error: %v", pod.ID, err)
+
+			continue
+		}
+
+		for _, container := range pInfo.Containers {
+			if container.ID == pInfo.InfraContainerID || !strings.EqualFold(container.Status, "running") {
+				continue
+			}
+			containers = append(containers, podContainer{pod: pInfo.Name, container: container})
+		}
+	}
+
+	return containers
+}
+
+func (p *PodmanApplication) printContainerTop(containers []podContainer) error {
+	ids := make([]string, 0, len(containers))
+	for _, c := range containers {
+		ids = append(ids, c.container.ID)
+	}
+
+	stats := map[string]runtimeTypes.ContainerStats{}
+	if len(ids) > 0 {
+		list, err := p.runtime.ContainerStats(ids)
+		if err != nil {
+			return err
+		}
+		for _, s := range list {
+			stats[s.Name] = s
+		}
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("POD NAME", "CONTAINER", "CPU %", "MEM USAGE / LIMIT", "MEM %")
+	for _, c := range containers {
+		s, ok := stats[c.container.Name]
+		if !ok {
+			printer.AppendRow(c.pod, c.container.Name, "-", "-", "-")
+
+			continue
+		}
+
+		memPercent := 0.0
+		if s.MemLimit > 0 {
+			memPercent = float64(s.MemUsage) / float64(s.MemLimit) * percent
+		}
+		printer.AppendRow(c.pod, c.container.Name, fmt.Sprintf(percentFormat, s.CPUPercent),
+			utils.HumanSize(int64(s.MemUsage))+" / "+utils.HumanSize(int64(s.MemLimit)), fmt.Sprintf(percentFormat, memPercent))
+	}
+
+	return nil
+}
+
+// printSpyreTop prints the Spyre cards allocated to the containers, as recorded in their env at create,
+// along with the state of the cards on the host.
+func (p *PodmanApplication) printSpyreTop(appName string, containers []podContainer) error {
+	type spyreCard struct {
+		state          helpers.SpyreCardState
+		pod, container string
+	}
+
+	var cards []spyreCard
+	for _, c := range containers {
+		container, err := p.runtime.InspectContainer(c.container.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, pciAddress := range strings.Fields(container.Env[string(constants.PCIAddressKey)]) {
+			cards = append(cards, spyreCard{state: helpers.ReadSpyreCardState(pciAddress), pod: c.pod, container: c.container.Name})
+		}
+	}
+
+	if len(cards) == 0 {
+		logger.Infof("No Spyre cards are allocated to the running containers of application: '%s'\n", appName)
+
+		return nil
+	}
+
+	slices.SortFunc(cards, func(a, b spyreCard) int { return strings.Compare(a.state.PCIAddress, b.state.PCIAddress) })
+
+	printer := utils.NewTableWriter()
+	printer.SetHeaders("PCI ADDRESS", "POD NAME", "CONTAINER", "DRIVER", "NUMA NODE")
+	for _, card := range cards {
+		printer.AppendRow(card.state.PCIAddress, card.pod, card.container, dashIfEmpty(card.state.Driver), dashIfEmpty(card.state.NUMANode))
+	}
+	printer.CloseTableWriter()
+
+	// the cards are passed through to the containers via vfio, hence the host has no view of their utilization
+	logger.Infoln("Note: The utilization of the Spyre cards is not exposed on the host while they are bound to vfio-pci.")
+
+	return nil
+}
+
+func dashIfEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
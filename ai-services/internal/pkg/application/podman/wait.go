@@ -0,0 +1,11 @@
+package podman
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Wait blocks until the application pods reach the requested condition or the timeout expires.
+func (p *PodmanApplication) Wait(opts appTypes.WaitOptions) error {
+	return common.WaitForPods(p.runtime, opts)
+}
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"text/template"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
 // CreateOptions contains parameters for creating an application.
@@ -21,6 +24,50 @@ type CreateOptions struct {
 	Values            map[string]any
 	ImagePullPolicy   image.ImagePullPolicy
 	AutoYes           bool
+	// ModelDownloadTimeout and ModelDownloadTotalTimeout bound the download per model and of all the models, zero means no limit.
+	ModelDownloadTimeout      time.Duration
+	ModelDownloadTotalTimeout time.Duration
+	// ModelDir is the host directory the models are downloaded to and mounted from, defaults to vars.ModelDirectory.
+	ModelDir string
+	// QuietModels collapses the output of the model download into a single progress line.
+	QuietModels bool
+	// OutputDir when set, receives the rendered manifest of each pod, with its secrets redacted.
+	OutputDir string
+	// DryRun renders the manifests to OutputDir without changing the host or deploying the pods.
+	DryRun bool
+	// Force changes the SMT level even if other running applications require a different one.
+	Force bool
+	// Strict treats the warnings as errors, e.g. a reduced Spyre allocation fails the create.
+	Strict bool
+	// FromFile deploys the given pod manifest instead of the template.
+	FromFile string
+	// StartPeriods overrides the start period used for the readiness timeout of the given pods.
+	// Key -> pod name, Value -> start period
+	StartPeriods map[string]time.Duration
+	// Labels are custom labels merged into the labels of the pods.
+	Labels map[string]string
+	// OutputFormat prints the create summary in the given format, e.g. json.
+	OutputFormat string
+	// MaxStartupRestarts fails the readiness check early once a container restarted more often during startup.
+	MaxStartupRestarts int
+	// AllowReducedSpyre deploys the containers with fewer Spyre cards than declared, if not enough cards are free.
+	AllowReducedSpyre bool
+	// DeployConcurrency is the maximum number of pods of a layer deployed concurrently.
+	DeployConcurrency int
+	// FromLayer deploys the layers starting at the given 1 based layer, the earlier layers must already be running.
+	FromLayer int
+	// OnlyLayer (re)deploys just the given 1 based layer, the earlier layers must already be running.
+	OnlyLayer int
+	// PauseBetweenLayers waits for Enter after each layer passed readiness, if stdin is a terminal.
+	PauseBetweenLayers bool
+	// LayerDelay waits for the given duration after each layer passed readiness instead.
+	LayerDelay time.Duration
+	// AnnotationOverrides are set on the pods loaded from the template, keyed by pod without the '<app>--' prefix.
+	AnnotationOverrides map[string]map[string]string
+	// EnvFile are the variables of the env file set on the containers, only on EnvFileContainers if any.
+	// They override the env of the template, but not the env computed from the pod annotations.
+	EnvFile           map[string]string
+	EnvFileContainers []string
 
 	// Openshift
 	Timeout time.Duration
@@ -56,17 +103,87 @@ type StopOptions struct {
 type ListOptions struct {
 	ApplicationName string
 	OutputWide      bool
+	// OutputJSON prints the pods as JSON instead of the table.
+	OutputJSON bool
+	// NoTrunc prints the full pod IDs in the wide table instead of the short ones.
+	NoTrunc bool
+	// Since and Until when set, only list the pods created within the given duration, or before it, respectively.
+	Since time.Duration
+	Until time.Duration
+	// Format when set, renders each pod using the template instead of the table.
+	Format *template.Template
+	// Redraw clears the terminal before the pods are printed, so that --watch refreshes them in place.
+	Redraw bool
+	// JSONLines prints the JSON on a single line, so that --watch streams NDJSON snapshots.
+	JSONLines bool
 }
 
 // InfoOptions contains parameters for displaying application info.
 type InfoOptions struct {
 	Name string
+	// Format when set, renders the application info using the template instead of the default output.
+	Format *template.Template
+	// Refresh prints only the health of the pods, so that info --refresh can re-query it periodically.
+	Refresh bool
+	// Redraw clears the terminal before the health is printed, so that --refresh refreshes it in place.
+	Redraw bool
 }
 
 // LogsOptions contains parameters for displaying application logs.
 type LogsOptions struct {
+	Name              string
 	PodName           string
 	ContainerNameOrID string
+	Timestamps        bool
+	// JSON pretty-prints the JSON log lines, keeping only those matching JSONFields.
+	JSON       bool
+	JSONFields map[string]string
+	// TailAll dumps the logs of all the containers without following them, each capped to MaxBytes.
+	TailAll  bool
+	MaxBytes int
+}
+
+// WaitCondition represents the state the pods are waited for.
+type WaitCondition string
+
+const (
+	// WaitConditionHealthy waits until all the containers are running and passing their health checks.
+	WaitConditionHealthy WaitCondition = "healthy"
+	// WaitConditionRunning waits until all the containers are running.
+	WaitConditionRunning WaitCondition = "running"
+	// WaitConditionExited waits until all the containers have exited.
+	WaitConditionExited WaitCondition = "exited"
+)
+
+// Valid checks if the wait condition is supported.
+func (c WaitCondition) Valid() bool {
+	switch c {
+	case WaitConditionHealthy, WaitConditionRunning, WaitConditionExited:
+		return true
+	default:
+		return false
+	}
+}
+
+// RestartOptions contains parameters for restarting an application.
+type RestartOptions struct {
+	Name     string
+	PodNames []string
+	AutoYes  bool
+	// OnUnhealthy only restarts the pods with a container reporting unhealthy, leaving the healthy ones running.
+	OnUnhealthy bool
+	// Timeout bounds the wait for the restarted pods to be healthy again.
+	Timeout time.Duration
+}
+
+// WaitOptions contains parameters for waiting on an application.
+type WaitOptions struct {
+	Name      string
+	PodNames  []string
+	Condition WaitCondition
+	Timeout   time.Duration
+	// MaxRestarts is the number of container restarts tolerated per pod while waiting.
+	MaxRestarts int
 }
 
 // ApplicationInfo represents information about a deployed application.
@@ -74,9 +191,102 @@ type ApplicationInfo struct {
 	Name         string
 	Template     string
 	Version      string
+	Labels       map[string]string
 	Pods         []PodInfo
 	Status       string
 	CreationTime string
+	// Services are the services declared by the template with their URLs.
+	Services []templates.ServiceURL
+	// HostIP is the IP the URLs of the services are constructed with, empty on openshift.
+	HostIP string
+}
+
+// PodListEntry represents a pod listed by the ps command.
+type PodListEntry struct {
+	ApplicationName string   `json:"applicationName"`
+	PodID           string   `json:"podId"`
+	PodName         string   `json:"podName"`
+	Status          string   `json:"status"`
+	Restarts        int      `json:"restarts"`
+	Created         string   `json:"created"`
+	Ports           []string `json:"ports"`
+	Containers      []string `json:"containers"`
+	// Labels holds the custom labels of the pod.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DescribeOptions contains parameters for describing an application.
+type DescribeOptions struct {
+	Name string
+	// Events is the number of most recent events to include.
+	Events int
+}
+
+// ApplicationDescription is the combined diagnostic view of an application.
+type ApplicationDescription struct {
+	Name     string               `json:"name"`
+	Template string               `json:"template"`
+	Version  string               `json:"version"`
+	Info     string               `json:"info,omitempty"`
+	Pods     []PodListEntry       `json:"pods"`
+	Events   []runtimeTypes.Event `json:"events"`
+}
+
+// TopOptions contains parameters for displaying the resource usage of an application.
+type TopOptions struct {
+	Name string
+	// Spyre shows the Spyre cards allocated to the containers instead of their CPU and memory usage.
+	Spyre bool
+}
+
+// EventsOptions contains parameters for displaying the events of an application.
+type EventsOptions struct {
+	Name string
+	// Follow keeps printing the new events as they occur, until interrupted.
+	Follow bool
+}
+
+// CreateSummary summarizes the resources allocated to a created application.
+type CreateSummary struct {
+	Name     string       `json:"name"`
+	Template string       `json:"template,omitempty"`
+	Pods     []PodSummary `json:"pods"`
+	Models   []string     `json:"models"`
+	URLs     []string     `json:"urls"`
+	// HostIP is the IP the URLs are constructed with, refer --advertise-ip.
+	HostIP string `json:"hostIP,omitempty"`
+	// Services are the services declared by the template with their URLs, e.g. the UI of the application.
+	Services []templates.ServiceURL `json:"services,omitempty"`
+	// NextSteps are the steps to be performed after the create, as contributed by the template.
+	NextSteps []templates.NextStep `json:"nextSteps"`
+	// ReducedSpyreCards lists the containers deployed with fewer Spyre cards than declared by the template.
+	ReducedSpyreCards []SpyreReduction `json:"reducedSpyreCards,omitempty"`
+}
+
+// SpyreReduction holds the reduced Spyre card allocation of a container.
+type SpyreReduction struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Requested int    `json:"requested"`
+	Allocated int    `json:"allocated"`
+}
+
+// PodSummary holds the resources allocated to a single pod.
+type PodSummary struct {
+	Name       string   `json:"name"`
+	SpyreCards []string `json:"spyreCards"`
+	Ports      []string `json:"ports"`
+
+	// PortMappings are the host ports bound by the pod, including the ones assigned by podman.
+	PortMappings []PortMapping `json:"portMappings"`
+}
+
+// PortMapping is a host port bound to a container port of a pod.
+type PortMapping struct {
+	HostPort      string `json:"hostPort"`
+	ContainerPort string `json:"containerPort"`
+	// Assigned is set if the host port was not requested, but randomly assigned by podman, e.g. when ui.port is not set.
+	Assigned bool `json:"assigned"`
 }
 
 // PodInfo represents information about a pod.
@@ -84,15 +294,37 @@ type PodInfo struct {
 	Name       string
 	ID         string
 	Status     string
+	Restarts   int
 	Containers []ContainerInfo
 }
 
 // ContainerInfo represents information about a container.
 type ContainerInfo struct {
-	Name   string
-	ID     string
-	Status string
-	Image  string
+	Name         string
+	ID           string
+	Status       string
+	Image        string
+	RestartCount int
 }
 
 // Made with Bob
+
+// DiskUsage is the breakdown of the disk space consumed by an application.
+type DiskUsage struct {
+	Application string           `json:"application"`
+	Images      []DiskUsageEntry `json:"images"`
+	HostPaths   []DiskUsageEntry `json:"hostPaths"`
+	Models      []DiskUsageEntry `json:"models"`
+	// Total is the size of all the entries, Exclusive only of those not shared with other applications,
+	// which is the space reclaimed by deleting the application along with its images.
+	Total     int64 `json:"total"`
+	Exclusive int64 `json:"exclusive"`
+}
+
+// DiskUsageEntry is the disk space consumed by a single image, host directory or model.
+type DiskUsageEntry struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+	// Shared is set if the entry is also used by other applications.
+	Shared bool `json:"shared"`
+}
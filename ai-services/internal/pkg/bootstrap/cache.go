@@ -0,0 +1,184 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+const (
+	// ValidationCacheTTL is the time for which a successful validation of an unchanged host is reused.
+	ValidationCacheTTL = 24 * time.Hour
+
+	validationCacheFilePerm = 0o600
+	validationCacheDirPerm  = 0o755
+)
+
+// validationCachePath is the path of the validation record, a variable so that the tests can keep it in a temporary directory.
+var validationCachePath = constants.ValidationCachePath
+
+// validationCache is the record of the last successful validation of the host.
+type validationCache struct {
+	// Version is the version of the CLI which ran the validation, as the checks may differ between versions.
+	Version string `json:"version"`
+	// Fingerprint identifies the state of the host the validation ran against, see hostFingerprint.
+	Fingerprint string    `json:"fingerprint"`
+	ValidatedAt time.Time `json:"validatedAt"`
+	// Skipped are the checks skipped by the validation.
+	Skipped []string `json:"skipped"`
+	Strict  bool     `json:"strict"`
+}
+
+// ValidateCached validates the environment like Validate, unless the last successful validation can be reused,
+// i.e. it ran within ValidationCacheTTL with the same CLI version against the unchanged host and covered at least
+// the checks requested now. A successful validation is recorded for the next runs. revalidate ignores the record.
+// The validation is only cached for the podman runtime, as the host fingerprint does not apply to a cluster.
+func (p *BootstrapFactory) ValidateCached(ctx context.Context, skip map[string]bool, strict bool, ruleTimeout time.Duration,
+	version string, revalidate bool) error {
+	if p.runtimeType != types.RuntimeTypePodman {
+		return p.Validate(ctx, skip, false, strict, ruleTimeout)
+	}
+
+	fingerprint := hostFingerprint()
+	if !revalidate {
+		if cache, ok := loadValidationCache(); ok && cache.covers(version, fingerprint, skip, strict, time.Now()) {
+			logger.Infof("Skipping the bootstrap validation, the host passed it %s ago (use --revalidate to force it)\n",
+				time.Since(cache.ValidatedAt).Round(time.Second))
+
+			return nil
+		}
+	}
+
+	if err := p.Validate(ctx, skip, false, strict, ruleTimeout); err != nil {
+		return err
+	}
+
+	p.RecordValidation(version, skip, strict)
+
+	return nil
+}
+
+// RecordValidation records a successful validation of the host, so that the following creates can reuse it.
+// A failure to record is not fatal, the validation just runs again the next time. It is a no-op for openshift.
+func (p *BootstrapFactory) RecordValidation(version string, skip map[string]bool, strict bool) {
+	if p.runtimeType != types.RuntimeTypePodman {
+		return
+	}
+
+	cache := validationCache{
+		Version:     version,
+		Fingerprint: hostFingerprint(),
+		ValidatedAt: time.Now(),
+		Skipped:     slices.Sorted(maps.Keys(skip)),
+		Strict:      strict,
+	}
+
+	if err := saveValidationCache(cache); err != nil {
+		logger.Infof("Unable to record the validation: %v\n", err, logger.VerbosityLevelDebug)
+	}
+}
+
+// covers reports whether the cached validation can be reused for the requested one at the given time.
+func (c validationCache) covers(version, fingerprint string, skip map[string]bool, strict bool, now time.Time) bool {
+	switch {
+	case c.Version != version:
+		logger.Infoln("Validation cache miss: the CLI version changed", logger.VerbosityLevelDebug)
+
+		return false
+	case c.Fingerprint != fingerprint:
+		logger.Infoln("Validation cache miss: the host changed", logger.VerbosityLevelDebug)
+
+		return false
+	case now.Sub(c.ValidatedAt) > ValidationCacheTTL || now.Before(c.ValidatedAt):
+		logger.Infoln("Validation cache miss: the validation expired", logger.VerbosityLevelDebug)
+
+		return false
+	case strict && !c.Strict:
+		logger.Infoln("Validation cache miss: the validation was not strict", logger.VerbosityLevelDebug)
+
+		return false
+	}
+
+	// a check skipped by the cached validation must be skipped now too
+	for _, check := range c.Skipped {
+		if !skip[check] {
+			logger.Infof("Validation cache miss: the %s check was skipped\n", check, logger.VerbosityLevelDebug)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func loadValidationCache() (validationCache, bool) {
+	var cache validationCache
+
+	data, err := os.ReadFile(validationCachePath)
+	if err != nil {
+		return cache, false
+	}
+
+	if err := json.Unmarshal(data, &cache); err != nil {
+		logger.Infof("Ignoring the corrupt validation cache: %v\n", err, logger.VerbosityLevelDebug)
+
+		return cache, false
+	}
+
+	return cache, true
+}
+
+func saveValidationCache(cache validationCache) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal the validation cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(validationCachePath), validationCacheDirPerm); err != nil {
+		return fmt.Errorf("failed to create the state directory: %w", err)
+	}
+
+	if err := os.WriteFile(validationCachePath, data, validationCacheFilePerm); err != nil {
+		return fmt.Errorf("failed to write the validation cache: %w", err)
+	}
+
+	return nil
+}
+
+// hostFingerprint identifies the state of the host relevant to the validation: the hostname, the kernel,
+// the boot, as e.g. the loaded kernel modules do not survive a reboot, and the Spyre cards along with their drivers.
+func hostFingerprint() string {
+	parts := []string{}
+
+	hostname, _ := os.Hostname()
+	parts = append(parts, "hostname="+hostname)
+
+	for _, file := range []string{"/proc/sys/kernel/osrelease", "/proc/sys/kernel/random/boot_id"} {
+		content, _ := os.ReadFile(file)
+		parts = append(parts, filepath.Base(file)+"="+strings.TrimSpace(string(content)))
+	}
+
+	cards, _ := helpers.ListSpyreCards()
+	slices.Sort(cards)
+	for _, card := range cards {
+		state := helpers.ReadSpyreCardState(card)
+		parts = append(parts, "spyre="+state.PCIAddress+","+state.Driver)
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
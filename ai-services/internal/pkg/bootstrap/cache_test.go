@@ -0,0 +1,163 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestValidationCacheCovers(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	cache := validationCache{
+		Version:     "v1.2.0",
+		Fingerprint: "host",
+		ValidatedAt: now.Add(-time.Hour),
+		Skipped:     []string{"numa"},
+	}
+
+	tests := []struct {
+		name    string
+		cache   validationCache
+		version string
+		skip    map[string]bool
+		strict  bool
+		now     time.Time
+		want    bool
+	}{
+		{name: "hit", cache: cache, skip: map[string]bool{"numa": true}, want: true},
+		{name: "hit skipping more checks", cache: cache, skip: map[string]bool{"numa": true, "spyre": true}, want: true},
+		{name: "version changed", cache: cache, version: "v1.3.0", skip: map[string]bool{"numa": true}},
+		{name: "host changed", cache: withFingerprint(cache, "other"), skip: map[string]bool{"numa": true}},
+		{name: "expired", cache: cache, skip: map[string]bool{"numa": true}, now: now.Add(ValidationCacheTTL)},
+		{name: "recorded in the future", cache: cache, skip: map[string]bool{"numa": true}, now: now.Add(-2 * time.Hour)},
+		{name: "skipped check requested", cache: cache},
+		{name: "strict requested", cache: cache, skip: map[string]bool{"numa": true}, strict: true},
+		{name: "strict recorded", cache: withStrict(cache), skip: map[string]bool{"numa": true}, strict: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, at := tt.version, tt.now
+			if version == "" {
+				version = cache.Version
+			}
+			if at.IsZero() {
+				at = now
+			}
+
+			if got := tt.cache.covers(version, "host", tt.skip, tt.strict, at); got != tt.want {
+				t.Errorf("covers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func withFingerprint(c validationCache, fingerprint string) validationCache {
+	c.Fingerprint = fingerprint
+
+	return c
+}
+
+func withStrict(c validationCache) validationCache {
+	c.Strict = true
+
+	return c
+}
+
+// TestValidateCached asserts that a successful validation is recorded and reused by the next one, unless revalidate
+// is set, the version changed or the record is unreadable, and that a failed validation is not recorded.
+func TestValidateCached(t *testing.T) {
+	tests := []struct {
+		name       string
+		record     func(t *testing.T, path string)
+		version    string
+		revalidate bool
+		failing    bool
+		wantRuns   int
+		wantErr    bool
+		wantRecord bool
+	}{
+		{name: "no record", wantRuns: 1, wantRecord: true},
+		{name: "hit", record: recordValidation("v1"), wantRecord: true},
+		{name: "revalidate", record: recordValidation("v1"), revalidate: true, wantRuns: 1, wantRecord: true},
+		{name: "version changed", record: recordValidation("v0"), wantRuns: 1, wantRecord: true},
+		{name: "corrupt record", record: writeRecord("{"), wantRuns: 1, wantRecord: true},
+		{name: "failed", failing: true, wantRuns: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := useValidationCache(t)
+			if tt.record != nil {
+				tt.record(t, path)
+			}
+
+			runs := 0
+			useRules(t, newRule("podman", func(context.Context) error {
+				runs++
+				if tt.failing {
+					return errors.New("podman not installed")
+				}
+
+				return nil
+			}))
+
+			err := NewBootstrapFactory(types.RuntimeTypePodman).ValidateCached(context.Background(), nil, false, time.Minute, "v1", tt.revalidate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCached() error = %v, want error %v", err, tt.wantErr)
+			}
+			if runs != tt.wantRuns {
+				t.Errorf("ValidateCached() ran the validation %d times, want %d", runs, tt.wantRuns)
+			}
+
+			cache, ok := loadValidationCache()
+			if ok != tt.wantRecord {
+				t.Fatalf("validation recorded = %v, want %v", ok, tt.wantRecord)
+			}
+			if ok && (cache.Version != "v1" || cache.Fingerprint != hostFingerprint()) {
+				t.Errorf("validation record = %+v, want the version v1 and the host fingerprint", cache)
+			}
+		})
+	}
+}
+
+// useValidationCache keeps the validation record in a temporary directory for the test and returns its path.
+func useValidationCache(t *testing.T) string {
+	t.Helper()
+
+	path := validationCachePath
+	t.Cleanup(func() { validationCachePath = path })
+	validationCachePath = filepath.Join(t.TempDir(), "state", "validation.json")
+
+	return validationCachePath
+}
+
+// recordValidation records a recent validation of this host made by the given version.
+func recordValidation(version string) func(t *testing.T, path string) {
+	return func(t *testing.T, path string) {
+		t.Helper()
+
+		cache := validationCache{Version: version, Fingerprint: hostFingerprint(), ValidatedAt: time.Now().Add(-time.Minute)}
+		if err := saveValidationCache(cache); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func writeRecord(content string) func(t *testing.T, path string) {
+	return func(t *testing.T, path string) {
+		t.Helper()
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
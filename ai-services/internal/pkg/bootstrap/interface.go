@@ -1,6 +1,11 @@
 package bootstrap
 
-import "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+import (
+	"context"
+
+	bootstrapTypes "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
 
 // Bootstrap defines the interface for environment bootstrapping operations.
 // Different runtimes implement this interface to provide
@@ -8,7 +13,13 @@ import "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 type Bootstrap interface {
 	// Configure performs the complete configuration of the environment.
 	// This includes installing dependencies, configuring runtime, and setting up hardware.
-	Configure() error
+	// It returns a summary of the changes applied.
+	// The configuration gives up once the context is done.
+	Configure(ctx context.Context) (*bootstrapTypes.ConfigureSummary, error)
+
+	// Check evaluates each configure step against the current state of the environment
+	// and returns the plan of changes, without executing any privileged command.
+	Check(ctx context.Context) (*bootstrapTypes.ConfigurePlan, error)
 
 	// Type returns the runtime type this bootstrap implementation supports.
 	Type() types.RuntimeType
@@ -0,0 +1,78 @@
+package openshift
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapTypes "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/kubeconfig"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/operators"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/spyrenodes"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/spyrepolicy"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Check evaluates each configure step against the current state of the cluster without applying any change.
+func (o *OpenshiftBootstrap) Check(ctx context.Context) (*bootstrapTypes.ConfigurePlan, error) {
+	plan := &bootstrapTypes.ConfigurePlan{Runtime: types.RuntimeTypeOpenShift}
+
+	if err := kubeconfig.NewKubeconfigRule().Verify(ctx); err != nil {
+		detail := fmt.Sprintf("unable to access the cluster: %v", err)
+		plan.Add("namespaces", bootstrapTypes.PlanActionUnknown, detail)
+		plan.Add("operators", bootstrapTypes.PlanActionUnknown, detail)
+		plan.Add("spyre-cluster-policy", bootstrapTypes.PlanActionUnknown, detail)
+		plan.Add("spyre-device-plugin", bootstrapTypes.PlanActionUnknown, detail)
+
+		return plan, nil
+	}
+
+	client, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	if err := checkNamespace(client, constants.SpyreOperatorNamespace); err != nil {
+		plan.Add("namespaces", bootstrapTypes.PlanActionChange, fmt.Sprintf("would create the operator namespaces: %v", err))
+	} else {
+		plan.Add("namespaces", bootstrapTypes.PlanActionNone, "operator namespaces already exist")
+	}
+
+	if err := operators.NewOperatorRule().Verify(ctx); err != nil {
+		plan.Add("operators", bootstrapTypes.PlanActionChange, fmt.Sprintf("would apply bootstrap YAMLs to install operators: %v", err))
+	} else {
+		plan.Add("operators", bootstrapTypes.PlanActionNone, "all operators are already installed")
+	}
+
+	if err := spyrepolicy.NewSpyrePolicyRule().Verify(ctx); err != nil {
+		plan.Add("spyre-cluster-policy", bootstrapTypes.PlanActionChange, fmt.Sprintf("would configure spyre cluster policy: %v", err))
+	} else {
+		plan.Add("spyre-cluster-policy", bootstrapTypes.PlanActionNone, "spyre cluster policy is already ready")
+	}
+
+	if err := spyrenodes.NewSpyreNodesRule().Verify(ctx); err != nil {
+		plan.Add("spyre-device-plugin", bootstrapTypes.PlanActionChange, fmt.Sprintf("would wait for the spyre device plugin to advertise cards: %v", err))
+	} else {
+		plan.Add("spyre-device-plugin", bootstrapTypes.PlanActionNone, "spyre cards are already advertised on the nodes")
+	}
+
+	return plan, nil
+}
+
+// checkNamespace returns an error if the given namespace does not exist on the cluster.
+func checkNamespace(client *openshift.OpenshiftClient, name string) error {
+	ns := &corev1.Namespace{}
+	if err := client.Client.Get(client.Ctx, k8sClient.ObjectKey{Name: name}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("namespace %s not found", name)
+		}
+
+		return fmt.Errorf("failed to get namespace %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/project-ai-services/ai-services/assets"
+	bootstrapTypes "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
@@ -15,6 +16,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/spyrenodes"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -28,32 +30,33 @@ const (
 	experimentalMode          = "experimentalMode"
 )
 
-func (o *OpenshiftBootstrap) Configure() error {
+func (o *OpenshiftBootstrap) Configure(ctx context.Context) (*bootstrapTypes.ConfigureSummary, error) {
 	client, err := openshift.NewOpenshiftClient()
 	if err != nil {
-		return fmt.Errorf("failed to configure openshift cluster")
+		return nil, fmt.Errorf("failed to configure openshift cluster")
 	}
 
 	// 1. Apply all yamls
 	s := spinner.New("Applying YAMLs")
-	s.Start(client.Ctx)
+	s.Start(ctx)
 
 	// iterate through the directory and apply the YAMLs
-	if err := applyYamls(client.Ctx, client.Client); err != nil {
+	applied, err := applyYamls(ctx, client.Client)
+	if err != nil {
 		s.Fail("failed to apply YAMLs")
 
-		return fmt.Errorf("error occurred while applying YAMLs: %w", err)
+		return nil, fmt.Errorf("error occurred while applying YAMLs: %w", err)
 	}
 	s.Stop("YAMLs Applied")
 
 	s = spinner.New("Waiting for spyre operator to be ready")
-	s.Start(client.Ctx)
+	s.Start(ctx)
 
-	err = waitForSpyreOperator(client.Ctx, client.Client)
+	err = waitForSpyreOperator(ctx, client.Client)
 	if err != nil {
 		s.Stop("spyre operator not ready")
 
-		return fmt.Errorf("spyre operator not ready: %w", err)
+		return nil, fmt.Errorf("spyre operator not ready: %w", err)
 	}
 	s.Stop("Spyre Operator up and ready")
 
@@ -66,19 +69,41 @@ func (o *OpenshiftBootstrap) Configure() error {
 
 	// 2. Configure Spyre cluster policy
 	s = spinner.New("Configuring Spyre Cluster Policy")
-	s.Start(client.Ctx)
+	s.Start(ctx)
 
 	if err := configureSCP(client, s); err != nil {
 		s.Fail("failed to configure spyre cluster policy")
 
-		return fmt.Errorf("error occurred while configuring spyre cluster policy: %w", err)
+		return nil, fmt.Errorf("error occurred while configuring spyre cluster policy: %w", err)
 	}
 	s.Stop("Spyre Cluster Policy configured")
 
-	return nil
+	// 3. Wait for the spyre device plugin to advertise the cards on the nodes
+	s = spinner.New("Waiting for Spyre device plugin to advertise cards on the nodes")
+	s.Start(ctx)
+
+	nodes, cards, err := waitForSpyreNodes(client)
+	if err != nil {
+		s.Fail("spyre device plugin not ready")
+
+		return nil, fmt.Errorf("spyre device plugin not ready: %w", err)
+	}
+	s.Stop(fmt.Sprintf("Spyre device plugin ready (%d card(s) across %d node(s))", cards, nodes))
+
+	return &bootstrapTypes.ConfigureSummary{
+		Runtime: types.RuntimeTypeOpenShift,
+		Changed: true,
+		Openshift: &bootstrapTypes.OpenshiftConfigureSummary{
+			YAMLsApplied:                 applied,
+			SpyreClusterPolicyConfigured: true,
+			SpyreNodes:                   nodes,
+			SpyreCards:                   cards,
+		},
+	}, nil
 }
 
-func applyYamls(ctx context.Context, c k8sClient.Client) error {
+// applyYamls applies the bootstrap YAMLs and returns the number of YAMLs applied.
+func applyYamls(ctx context.Context, c k8sClient.Client) (int, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
 		FS:      &assets.BootstrapFS,
 		Root:    "bootstrap",
@@ -87,16 +112,16 @@ func applyYamls(ctx context.Context, c k8sClient.Client) error {
 
 	yamls, err := tp.LoadYamls()
 	if err != nil {
-		return fmt.Errorf("error loading yamls: %w", err)
+		return 0, fmt.Errorf("error loading yamls: %w", err)
 	}
 
 	for _, yaml := range yamls {
 		if err := utils.ApplyYaml(ctx, yaml, c); err != nil {
-			return fmt.Errorf("failed to apply YAML %s: %w", string(yaml), err)
+			return 0, fmt.Errorf("failed to apply YAML %s: %w", string(yaml), err)
 		}
 	}
 
-	return nil
+	return len(yamls), nil
 }
 
 func configureSCP(client *openshift.OpenshiftClient, s *spinner.Spinner) error {
@@ -247,3 +272,23 @@ func waitForSpyreOperator(ctx context.Context, c k8sClient.Client) error {
 	},
 	)
 }
+
+// waitForSpyreNodes polls the cluster nodes until at least one of them advertises allocatable spyre cards.
+func waitForSpyreNodes(client *openshift.OpenshiftClient) (int, int64, error) {
+	var (
+		nodes int
+		cards int64
+	)
+
+	err := wait.PollUntilContextTimeout(client.Ctx, pollInterval, pollTimeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		nodes, cards, err = spyrenodes.CountSpyreNodes(ctx, client)
+		if err != nil {
+			return false, err
+		}
+
+		return nodes > 0, nil
+	})
+
+	return nodes, cards, err
+}
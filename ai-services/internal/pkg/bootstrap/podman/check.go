@@ -0,0 +1,127 @@
+package podman
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	rtTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// Check evaluates each configure step against the current state of the LPAR without executing any privileged command.
+func (p *PodmanBootstrap) Check(ctx context.Context) (*types.ConfigurePlan, error) {
+	plan := &types.ConfigurePlan{Runtime: rtTypes.RuntimeTypePodman}
+
+	checkPodman(plan)
+	if vars.SkipSpyre {
+		for _, step := range []string{"servicereport", "sentient-group", "sentient-group-membership", "vfio-modules", "card-reconciliation"} {
+			plan.Add(step, types.PlanActionSkip, "spyre card configuration is skipped")
+		}
+
+		return plan, nil
+	}
+	checkSpyreConfiguration(ctx, plan)
+	checkUsergroup(plan)
+	checkVFIO(plan)
+
+	return plan, nil
+}
+
+func checkPodman(plan *types.ConfigurePlan) {
+	if _, err := validators.Podman(); err != nil {
+		plan.Add("podman-install", types.PlanActionChange, "would install podman")
+		plan.Add("podman-socket", types.PlanActionChange, "would start and enable podman.socket")
+
+		return
+	}
+	plan.Add("podman-install", types.PlanActionNone, "podman is already installed")
+
+	if err := validators.PodmanHealthCheck(); err != nil {
+		plan.Add("podman-socket", types.PlanActionChange, "would start and enable podman.socket")
+	} else {
+		plan.Add("podman-socket", types.PlanActionNone, "podman is already configured")
+	}
+}
+
+func checkSpyreConfiguration(ctx context.Context, plan *types.ConfigurePlan) {
+	if err := spyre.NewSpyreRule().Verify(ctx); err != nil {
+		plan.Add("servicereport", types.PlanActionUnknown, fmt.Sprintf("spyre cards are not attached: %v", err))
+
+		return
+	}
+
+	// the validation of the servicereport tool runs a privileged container, hence only the files it writes are read
+	var missing, unreadable []string
+	for _, file := range spyreConfigFiles {
+		found, err := file.present()
+		switch {
+		case err != nil:
+			unreadable = append(unreadable, file.name)
+		case !found:
+			missing = append(missing, file.name)
+		}
+	}
+
+	switch {
+	case len(missing) > 0:
+		plan.Add("servicereport", types.PlanActionChange,
+			fmt.Sprintf("would run servicereport tool to configure spyre cards, missing: %s", strings.Join(missing, ", ")))
+	case len(unreadable) > 0:
+		plan.Add("servicereport", types.PlanActionUnknown,
+			fmt.Sprintf("reading the %s requires privileges", strings.Join(unreadable, ", ")))
+	default:
+		plan.Add("servicereport", types.PlanActionNone, "spyre cards are already configured")
+	}
+}
+
+func checkUsergroup(plan *types.ConfigurePlan) {
+	if err := exec.Command("getent", "group", sentientGroup).Run(); err != nil {
+		plan.Add("sentient-group", types.PlanActionChange, fmt.Sprintf("would create %s group", sentientGroup))
+	} else {
+		plan.Add("sentient-group", types.PlanActionNone, fmt.Sprintf("%s group already exists", sentientGroup))
+	}
+
+	targetUser, err := usergroupTargetUser()
+	if err != nil {
+		plan.Add("sentient-group-membership", types.PlanActionUnknown, err.Error())
+
+		return
+	}
+
+	if isGroupMember(targetUser, sentientGroup) {
+		plan.Add("sentient-group-membership", types.PlanActionNone, fmt.Sprintf("user %s is already a member of the %s group", targetUser, sentientGroup))
+	} else {
+		plan.Add("sentient-group-membership", types.PlanActionChange, fmt.Sprintf("would add user %s to the %s group", targetUser, sentientGroup))
+	}
+}
+
+func checkVFIO(plan *types.ConfigurePlan) {
+	if vfioModuleLoaded() {
+		plan.Add("vfio-modules", types.PlanActionNone, "vfio_pci kernel module is already loaded")
+	} else {
+		plan.Add("vfio-modules", types.PlanActionChange, "would load vfio_pci kernel module")
+	}
+
+	cards, err := helpers.ListSpyreCards()
+	if err != nil {
+		plan.Add("card-reconciliation", types.PlanActionUnknown, fmt.Sprintf("failed to list spyre cards: %v", err))
+
+		return
+	}
+
+	vfioCards := countVFIOCardsSysfs(cards)
+
+	if reload, reason := reloadModulesDecision(vars.ReloadModules, len(cards), vfioCards); reload {
+		plan.Add("card-reconciliation", types.PlanActionChange,
+			fmt.Sprintf("would reload vfio kernel modules (%s), %d of %d spyre cards are bound to vfio-pci", reason, vfioCards, len(cards)))
+	} else {
+		plan.Add("card-reconciliation", types.PlanActionNone,
+			fmt.Sprintf("%s, %d of %d spyre cards are bound to vfio-pci", reason, vfioCards, len(cards)))
+	}
+}
@@ -0,0 +1,41 @@
+package podman
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestCheckSkipSpyre asserts that configure plans to skip all the spyre card steps for CPU-only environments,
+// without probing the cards.
+func TestCheckSkipSpyre(t *testing.T) {
+	orig := vars.SkipSpyre
+	vars.SkipSpyre = true
+	t.Cleanup(func() { vars.SkipSpyre = orig })
+
+	plan, err := NewPodmanBootstrap().Check(context.Background())
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	actions := map[string]types.PlanAction{}
+	for _, step := range plan.Steps {
+		actions[step.Name] = step.Action
+	}
+
+	for _, step := range []string{"podman-install", "podman-socket"} {
+		if action, ok := actions[step]; !ok || action == types.PlanActionSkip {
+			t.Errorf("Check() step %s = %q, want it planned", step, action)
+		}
+	}
+	for _, step := range []string{"servicereport", "sentient-group", "sentient-group-membership", "vfio-modules", "card-reconciliation"} {
+		if action := actions[step]; action != types.PlanActionSkip {
+			t.Errorf("Check() step %s = %q, want %q", step, action, types.PlanActionSkip)
+		}
+	}
+	if undetermined := plan.Undetermined(); len(undetermined) > 0 {
+		t.Errorf("Check() undetermined steps = %v, want none", undetermined)
+	}
+}
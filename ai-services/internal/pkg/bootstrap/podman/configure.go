@@ -4,10 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	rtTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/root"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 const (
@@ -16,12 +19,12 @@ const (
 )
 
 // Configure performs the complete configuration of the Podman environment.
-func (p *PodmanBootstrap) Configure() error {
+func (p *PodmanBootstrap) Configure(ctx context.Context) (*types.ConfigureSummary, error) {
 	rootCheck := root.NewRootRule()
-	if err := rootCheck.Verify(); err != nil {
-		return err
+	if err := rootCheck.Verify(ctx); err != nil {
+		return nil, err
 	}
-	ctx := context.Background()
+	summary := &types.PodmanConfigureSummary{}
 
 	s := spinner.New("Checking podman installation")
 	s.Start(ctx)
@@ -33,8 +36,9 @@ func (p *PodmanBootstrap) Configure() error {
 		if err := installPodman(); err != nil {
 			s.Fail("failed to install podman")
 
-			return err
+			return nil, err
 		}
+		summary.PodmanInstalled = true
 		s.Stop("podman installed successfully")
 	} else {
 		s.Stop("podman already installed")
@@ -48,26 +52,42 @@ func (p *PodmanBootstrap) Configure() error {
 		if err := setupPodman(); err != nil {
 			s.Fail("failed to configure podman")
 
-			return err
+			return nil, err
 		}
+		summary.PodmanSocketEnabled = true
 		s.Stop("podman configured successfully")
 	} else {
 		s.Stop("Podman already configured")
 	}
 
-	s = spinner.New("Checking spyre card configuration")
-	s.Start(ctx)
 	// 2. Spyre cards – run servicereport tool to validate and repair spyre configurations
-	if err := runServiceReport(); err != nil {
-		s.Fail("failed to configure spyre card")
+	if vars.SkipSpyre {
+		logger.Warningf("Skipping the spyre card configuration (servicereport, vfio modules), as requested for CPU-only environments\n")
+		summary.SpyreSkipped = true
+	} else {
+		s = spinner.New("Checking spyre card configuration")
+		s.Start(ctx)
+		if err := runServiceReport(ctx, summary); err != nil {
+			s.Fail("failed to configure spyre card")
 
-		return err
+			return nil, err
+		}
+		s.Stop("Spyre cards configuration validated successfully.")
 	}
-	s.Stop("Spyre cards configuration validated successfully.")
 
 	logger.Infoln("LPAR configured successfully")
 
-	return nil
+	return &types.ConfigureSummary{
+		Runtime: rtTypes.RuntimeTypePodman,
+		Changed: configureChanged(summary),
+		Podman:  summary,
+	}, nil
+}
+
+// configureChanged reports whether configure applied any change on the LPAR.
+func configureChanged(summary *types.PodmanConfigureSummary) bool {
+	return summary.PodmanInstalled || summary.PodmanSocketEnabled || summary.SentientGroupCreated ||
+		summary.SentientGroupMemberAdded || summary.VFIOModulesLoaded || summary.CardCountReconciled
 }
 
 // Made with Bob
@@ -3,21 +3,26 @@ package podman
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
+	"os/user"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
-func runServiceReport() error {
+func runServiceReport(ctx context.Context, summary *types.PodmanConfigureSummary) error {
 	// validate spyre attachment first before running servicereport
 	spyreCheck := spyre.NewSpyreRule()
-	err := spyreCheck.Verify()
+	err := spyreCheck.Verify(ctx)
 	if err != nil {
 		return err
 	}
@@ -30,6 +35,7 @@ func runServiceReport() error {
 	}
 
 	// load vfio kernel modules
+	summary.VFIOModulesLoaded = !vfioModuleLoaded()
 	cmd = `modprobe vfio_pci`
 	_, err = exec.Command("bash", "-c", cmd).Output()
 	if err != nil {
@@ -37,13 +43,16 @@ func runServiceReport() error {
 	}
 	logger.Infoln("VFIO kernel modules loaded on the host", logger.VerbosityLevelDebug)
 
-	if err := helpers.RunServiceReportContainer("servicereport -r -p spyre", "configure"); err != nil {
+	if err := helpers.RunServiceReportContainer(ctx, "servicereport -r -p spyre", "configure"); err != nil {
 		return err
 	}
 
-	if err := configureUsergroup(); err != nil {
+	groupCreated, memberAdded, err := configureUsergroup()
+	if err != nil {
 		return err
 	}
+	summary.SentientGroupCreated = groupCreated
+	summary.SentientGroupMemberAdded = memberAdded
 
 	if err := reloadUdevRules(); err != nil {
 		return err
@@ -54,24 +63,103 @@ func runServiceReport() error {
 		return fmt.Errorf("❌ failed to list spyre cards on LPAR %w", err)
 	}
 	num_spyre_cards := len(cards)
+	summary.SpyreCards = num_spyre_cards
 
 	// check if kernel modules for vfio are loaded
-	if err := checkKernelModulesLoaded(num_spyre_cards); err != nil {
+	reloaded, err := reconcileVFIOCards(num_spyre_cards)
+	if err != nil {
 		return err
 	}
+	summary.CardCountReconciled = reloaded
 
 	return nil
 }
 
-func configureUsergroup() error {
-	cmd_str := `groupadd sentient; usermod -aG sentient $USER`
-	cmd := exec.Command("bash", "-c", cmd_str)
-	out, err := cmd.CombinedOutput()
+// countVFIOCards returns the number of spyre cards bound to the vfio-pci kernel driver.
+func countVFIOCards() (int, error) {
+	vfio_cmd := `lspci -k -d 1014:06a7 | grep "Kernel driver in use: vfio-pci" | wc -l`
+	out, err := exec.Command("bash", "-c", vfio_cmd).Output()
 	if err != nil {
-		return fmt.Errorf("failed to create sentient group and add current user to the sentient group. Error: %w, output: %s", err, string(out))
+		return 0, fmt.Errorf("❌ failed to check vfio cards with kernel modules loaded %w", err)
 	}
 
-	return nil
+	num_vf_cards, err := strconv.Atoi(strings.TrimSuffix(string(out), "\n"))
+	if err != nil {
+		return 0, fmt.Errorf("❌ failed to convert number of virtual spyre cards count from string to integer %w", err)
+	}
+
+	return num_vf_cards, nil
+}
+
+// vfioModuleLoaded checks whether the vfio_pci kernel module is already loaded, as listed in /proc/modules.
+func vfioModuleLoaded() bool {
+	content, err := os.ReadFile(procModulesPath)
+	if err != nil {
+		return false
+	}
+
+	return moduleListed(content, vfioPCIModule)
+}
+
+const sentientGroup = "sentient"
+
+// configureUsergroup creates the sentient group if missing and adds the target user to it.
+// It is idempotent, an existing group or group membership is not treated as an error.
+// Returns whether the sentient group was created and whether the user was added to it.
+func configureUsergroup() (bool, bool, error) {
+	created := false
+	if err := exec.Command("getent", "group", sentientGroup).Run(); err != nil {
+		out, err := exec.Command("groupadd", sentientGroup).CombinedOutput()
+		if err != nil {
+			return false, false, fmt.Errorf("failed to create %s group. Error: %w, output: %s", sentientGroup, err, string(out))
+		}
+		created = true
+		logger.Infof("Created %s group\n", sentientGroup, logger.VerbosityLevelDebug)
+	}
+
+	targetUser, err := usergroupTargetUser()
+	if err != nil {
+		return created, false, err
+	}
+
+	if isGroupMember(targetUser, sentientGroup) {
+		logger.Infof("User %s is already a member of the %s group\n", targetUser, sentientGroup, logger.VerbosityLevelDebug)
+
+		return created, false, nil
+	}
+
+	out, err := exec.Command("usermod", "-aG", sentientGroup, targetUser).CombinedOutput()
+	if err != nil {
+		return created, false, fmt.Errorf("failed to add user %s to the %s group. Error: %w, output: %s", targetUser, sentientGroup, err, string(out))
+	}
+	logger.Infof("Added user %s to the %s group\n", targetUser, sentientGroup, logger.VerbosityLevelDebug)
+
+	return created, true, nil
+}
+
+// usergroupTargetUser resolves the user to be added to the sentient group.
+// When run via sudo, $USER resolves to root, hence the invoking user set in SUDO_USER is preferred over the current user.
+func usergroupTargetUser() (string, error) {
+	if sudoUser := os.Getenv("SUDO_USER"); sudoUser != "" {
+		return sudoUser, nil
+	}
+
+	u, err := user.Current()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve current user: %w", err)
+	}
+
+	return u.Username, nil
+}
+
+// isGroupMember checks whether the given user is a member of the given group.
+func isGroupMember(username, group string) bool {
+	out, err := exec.Command("id", "-nG", username).Output()
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(strings.Fields(string(out)), group)
 }
 
 func reloadUdevRules() error {
@@ -84,30 +172,55 @@ func reloadUdevRules() error {
 	return nil
 }
 
-func checkKernelModulesLoaded(num_spyre_cards int) error {
-	vfio_cmd := `lspci -k -d 1014:06a7 | grep "Kernel driver in use: vfio-pci" | wc -l`
-	out, err := exec.Command("bash", "-c", vfio_cmd).Output()
+// reconcileVFIOCards reloads the vfio kernel modules as decided by reloadModulesDecision, so that all the spyre cards
+// are bound to vfio-pci. It reports whether the modules were reloaded.
+func reconcileVFIOCards(num_spyre_cards int) (bool, error) {
+	num_vf_cards, err := countVFIOCards()
 	if err != nil {
-		return fmt.Errorf("❌ failed to check vfio cards with kernel modules loaded %w", err)
+		return false, err
 	}
 
-	num_vf_cards, err := strconv.Atoi(strings.TrimSuffix(string(out), "\n"))
+	reload, reason := reloadModulesDecision(vars.ReloadModules, num_spyre_cards, num_vf_cards)
+	logger.Infoln(fmt.Sprintf("%s: %d of %d spyre cards are bound to vfio-pci", reason, num_vf_cards, num_spyre_cards))
+	if !reload {
+		return false, nil
+	}
+
+	// reload vfio kernel modules
+	cmd := `rmmod vfio_pci; modprobe vfio_pci`
+	_, err = exec.Command("bash", "-c", cmd).Output()
 	if err != nil {
-		return fmt.Errorf("❌ failed to convert number of virtual spyre cards count from string to integer %w", err)
+		return false, fmt.Errorf("❌ failed to reload vfio kernel modules for spyre %w", err)
 	}
 
-	if num_vf_cards != num_spyre_cards {
-		logger.Infof("failed to detect vfio cards, reloading vfio kernel modules..")
-		// reload vfio kernel modules
-		cmd := `rmmod vfio_pci; modprobe vfio_pci`
-		_, err = exec.Command("bash", "-c", cmd).Output()
-		if err != nil {
-			return fmt.Errorf("❌ failed to reload vfio kernel modules for spyre %w", err)
-		}
-		logger.Infoln("VFIO kernel modules reloaded on the host", logger.VerbosityLevelDebug)
+	num_vf_cards, err = countVFIOCards()
+	if err != nil {
+		return true, err
 	}
+	logger.Infoln(fmt.Sprintf("VFIO kernel modules reloaded on the host: %d of %d spyre cards are bound to vfio-pci",
+		num_vf_cards, num_spyre_cards))
 
-	return nil
+	return true, nil
+}
+
+// reloadModulesDecision decides whether the vfio kernel modules are reloaded under the policy, along with the reason.
+func reloadModulesDecision(policy vars.ReloadModulesPolicy, spyreCards, vfioCards int) (bool, string) {
+	switch policy {
+	case vars.ReloadModulesAlways:
+		return true, "Reloading vfio kernel modules as requested by --reload-modules"
+	case vars.ReloadModulesNever:
+		if vfioCards != spyreCards {
+			return false, "Not reloading vfio kernel modules as requested by --no-reload-modules, although not all cards are bound"
+		}
+
+		return false, "Not reloading vfio kernel modules as requested by --no-reload-modules"
+	default:
+		if vfioCards == spyreCards {
+			return false, "VFIO kernel modules need no reload"
+		}
+
+		return true, "Reloading vfio kernel modules, as not all spyre cards are bound"
+	}
 }
 
 func installPodman() error {
@@ -0,0 +1,66 @@
+package podman
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestReloadModulesDecision(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     vars.ReloadModulesPolicy
+		spyreCards int
+		vfioCards  int
+		want       bool
+		wantReason string
+	}{
+		{name: "auto with all cards bound", policy: vars.ReloadModulesAuto, spyreCards: 4, vfioCards: 4, want: false, wantReason: "need no reload"},
+		{name: "auto with cards unbound", policy: vars.ReloadModulesAuto, spyreCards: 4, vfioCards: 2, want: true, wantReason: "not all spyre cards are bound"},
+		{name: "auto without cards", policy: vars.ReloadModulesAuto, want: false, wantReason: "need no reload"},
+		{name: "unset policy behaves as auto", spyreCards: 2, vfioCards: 0, want: true, wantReason: "not all spyre cards are bound"},
+		{name: "always with all cards bound", policy: vars.ReloadModulesAlways, spyreCards: 4, vfioCards: 4, want: true, wantReason: "--reload-modules"},
+		{name: "always with cards unbound", policy: vars.ReloadModulesAlways, spyreCards: 4, vfioCards: 1, want: true, wantReason: "--reload-modules"},
+		{name: "never with all cards bound", policy: vars.ReloadModulesNever, spyreCards: 4, vfioCards: 4, want: false, wantReason: "--no-reload-modules"},
+		{name: "never with cards unbound", policy: vars.ReloadModulesNever, spyreCards: 4, vfioCards: 1, want: false, wantReason: "not all cards are bound"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := reloadModulesDecision(tt.policy, tt.spyreCards, tt.vfioCards)
+			if got != tt.want {
+				t.Errorf("reloadModulesDecision() = %v, want %v", got, tt.want)
+			}
+			if !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("reloadModulesDecision() reason = %q, want it to contain %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestConfigureChanged(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary types.PodmanConfigureSummary
+		want    bool
+	}{
+		{name: "no-op run", summary: types.PodmanConfigureSummary{SpyreCards: 4}, want: false},
+		{name: "spyre skipped", summary: types.PodmanConfigureSummary{SpyreSkipped: true}, want: false},
+		{name: "podman installed", summary: types.PodmanConfigureSummary{PodmanInstalled: true}, want: true},
+		{name: "podman socket enabled", summary: types.PodmanConfigureSummary{PodmanSocketEnabled: true}, want: true},
+		{name: "sentient group created", summary: types.PodmanConfigureSummary{SentientGroupCreated: true}, want: true},
+		{name: "user added to the sentient group", summary: types.PodmanConfigureSummary{SentientGroupMemberAdded: true}, want: true},
+		{name: "vfio modules loaded", summary: types.PodmanConfigureSummary{VFIOModulesLoaded: true}, want: true},
+		{name: "card count reconciled", summary: types.PodmanConfigureSummary{CardCountReconciled: true}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := configureChanged(&tt.summary); got != tt.want {
+				t.Errorf("configureChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package podman
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+)
+
+const (
+	// procModulesPath lists the loadable kernel modules currently loaded.
+	procModulesPath = "/proc/modules"
+	vfioPCIModule   = "vfio_pci"
+	vfioPCIDriver   = "vfio-pci"
+)
+
+// spyreConfigFile is a host configuration file written by the servicereport tool for the Spyre cards,
+// identified by a marker in its content as the file names differ between the tool versions.
+type spyreConfigFile struct {
+	name   string
+	dir    string
+	marker string
+}
+
+// spyreConfigFiles are the files checked by bootstrap configure --check, which are readable without privileges
+// unlike running the servicereport tool validation.
+var spyreConfigFiles = []spyreConfigFile{
+	{name: "vfio udev rules", dir: "/etc/udev/rules.d", marker: "vfio"},
+	{name: "memlock limits", dir: "/etc/security/limits.d", marker: "memlock"},
+	{name: "vfio module autoload", dir: "/etc/modules-load.d", marker: "vfio"},
+}
+
+// present reports whether a file of the directory contains the marker. An error is returned if the directory
+// or one of its files cannot be read, as the configuration is then undetermined rather than missing.
+func (f spyreConfigFile) present() (bool, error) {
+	entries, err := os.ReadDir(f.dir)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(f.dir, entry.Name()))
+		if err != nil {
+			return false, err
+		}
+
+		if bytes.Contains(content, []byte(f.marker)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// moduleListed reports whether the kernel module is listed in the content of /proc/modules.
+func moduleListed(procModules []byte, module string) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(procModules))
+	for scanner.Scan() {
+		if name, _, _ := strings.Cut(scanner.Text(), " "); name == module {
+			return true
+		}
+	}
+
+	return false
+}
+
+// countVFIOCardsSysfs returns the number of the given spyre cards bound to the vfio-pci driver, as read from sysfs.
+func countVFIOCardsSysfs(cards []string) int {
+	count := 0
+	for _, card := range cards {
+		if helpers.ReadSpyreCardState(card).Driver == vfioPCIDriver {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,83 @@
+package podman
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSpyreConfigFilePresent(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  bool
+	}{
+		{name: "no files", files: map[string]string{}, want: false},
+		{name: "marker present", files: map[string]string{"95-vfio-3.rules": `SUBSYSTEM=="vfio", GROUP="sentient"`}, want: true},
+		{name: "marker absent", files: map[string]string{"10-other.rules": `SUBSYSTEM=="usb"`}, want: false},
+		{name: "marker in one of several files", files: map[string]string{"a.rules": "x", "b.rules": "vfio"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := spyreConfigFile{name: "test", dir: dir, marker: "vfio"}.present()
+			if err != nil {
+				t.Fatalf("present() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("present() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpyreConfigFileMissingDir(t *testing.T) {
+	got, err := spyreConfigFile{dir: filepath.Join(t.TempDir(), "missing"), marker: "vfio"}.present()
+	if err != nil || got {
+		t.Errorf("present() = %v, %v, want false, nil", got, err)
+	}
+}
+
+func TestSpyreConfigFileUnreadable(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("file permissions are not enforced for root")
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "memlock.conf"), []byte("memlock"), 0o000); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := (spyreConfigFile{dir: dir, marker: "memlock"}).present(); err == nil {
+		t.Error("present() error = nil, want an error for an unreadable file")
+	}
+}
+
+func TestModuleListed(t *testing.T) {
+	procModules := []byte("vfio_pci 262144 0 - Live 0x0\nvfio_pci_core 327680 1 vfio_pci, Live 0x0\nvfio 327680 2 Live 0x0\n")
+
+	tests := []struct {
+		module string
+		want   bool
+	}{
+		{module: "vfio_pci", want: true},
+		{module: "vfio", want: true},
+		{module: "vfio_iommu_spapr_tce", want: false},
+		{module: "vfio_pc", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module, func(t *testing.T) {
+			if got := moduleListed(procModules, tt.module); got != tt.want {
+				t.Errorf("moduleListed(%s) = %v, want %v", tt.module, got, tt.want)
+			}
+		})
+	}
+}
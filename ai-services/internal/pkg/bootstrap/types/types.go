@@ -0,0 +1,113 @@
+package types
+
+import "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+
+// ConfigureSummary describes the changes applied by the bootstrap configure.
+type ConfigureSummary struct {
+	Runtime types.RuntimeType `json:"runtime"`
+	// Changed is set if configure applied any change, false indicates a no-op run.
+	Changed   bool                       `json:"changed"`
+	Podman    *PodmanConfigureSummary    `json:"podman,omitempty"`
+	Openshift *OpenshiftConfigureSummary `json:"openshift,omitempty"`
+}
+
+// PodmanConfigureSummary describes the changes applied on the LPAR by the podman bootstrap configure.
+type PodmanConfigureSummary struct {
+	PodmanInstalled      bool `json:"podmanInstalled"`
+	PodmanSocketEnabled  bool `json:"podmanSocketEnabled"`
+	SentientGroupCreated bool `json:"sentientGroupCreated"`
+	// SentientGroupMemberAdded is set if the user was added to the sentient group, which applies on the next login.
+	SentientGroupMemberAdded bool `json:"sentientGroupMemberAdded"`
+	VFIOModulesLoaded        bool `json:"vfioModulesLoaded"`
+	SpyreCards               int  `json:"spyreCards"`
+	// CardCountReconciled is set if the vfio modules were reloaded to match the vfio cards with the spyre cards.
+	CardCountReconciled bool `json:"cardCountReconciled"`
+	// SpyreSkipped is set if the spyre card configuration was skipped via --skip-spyre.
+	SpyreSkipped bool `json:"spyreSkipped"`
+}
+
+// OpenshiftConfigureSummary describes the changes applied on the cluster by the openshift bootstrap configure.
+type OpenshiftConfigureSummary struct {
+	YAMLsApplied                 int  `json:"yamlsApplied"`
+	SpyreClusterPolicyConfigured bool `json:"spyreClusterPolicyConfigured"`
+	// SpyreNodes is the number of nodes advertising spyre cards through the device plugin.
+	SpyreNodes int   `json:"spyreNodes"`
+	SpyreCards int64 `json:"spyreCards"`
+}
+
+// ValidationSummary describes the outcome of the bootstrap validation.
+type ValidationSummary struct {
+	Runtime types.RuntimeType `json:"runtime"`
+	// Passed is set if no check failed, the warnings do not fail the validation unless in strict mode.
+	Passed   bool                `json:"passed"`
+	Checks   ValidationCounts    `json:"checks"`
+	Failures []ValidationFinding `json:"failures"`
+	Warnings []ValidationFinding `json:"warnings"`
+}
+
+// ValidationCounts counts the validation checks by their outcome.
+type ValidationCounts struct {
+	Passed   int `json:"passed"`
+	Failed   int `json:"failed"`
+	Warnings int `json:"warnings"`
+	Skipped  int `json:"skipped"`
+}
+
+// ValidationFinding is a failed or warning validation check.
+type ValidationFinding struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+	Hint    string `json:"hint"`
+}
+
+// BootstrapSummary describes the outcome of the full bootstrap, i.e. the configure followed by the validation.
+type BootstrapSummary struct {
+	Configure *ConfigureSummary  `json:"configure"`
+	Validate  *ValidationSummary `json:"validate"`
+	// ReloginRequired is set if the user has to log in again for the permissions on the vfio cards to apply.
+	ReloginRequired bool `json:"reloginRequired"`
+}
+
+// PlanAction describes what configure would do for a step.
+type PlanAction string
+
+const (
+	// PlanActionNone indicates the step is already in the intended state.
+	PlanActionNone PlanAction = "none"
+	// PlanActionChange indicates configure would apply a change for the step.
+	PlanActionChange PlanAction = "change"
+	// PlanActionUnknown indicates the current state of the step could not be determined.
+	PlanActionUnknown PlanAction = "unknown"
+	// PlanActionSkip indicates configure would skip the step, as requested by the user.
+	PlanActionSkip PlanAction = "skip"
+)
+
+// PlanStep describes the evaluation of a single configure step.
+type PlanStep struct {
+	Name   string     `json:"name"`
+	Action PlanAction `json:"action"`
+	Detail string     `json:"detail"`
+}
+
+// ConfigurePlan describes the changes the bootstrap configure would apply, without applying them.
+type ConfigurePlan struct {
+	Runtime types.RuntimeType `json:"runtime"`
+	Steps   []PlanStep        `json:"steps"`
+}
+
+// Add appends a step to the plan.
+func (p *ConfigurePlan) Add(name string, action PlanAction, detail string) {
+	p.Steps = append(p.Steps, PlanStep{Name: name, Action: action, Detail: detail})
+}
+
+// Undetermined returns the steps whose state could not be determined.
+func (p *ConfigurePlan) Undetermined() []PlanStep {
+	steps := []PlanStep{}
+	for _, step := range p.Steps {
+		if step.Action == PlanActionUnknown {
+			steps = append(steps, step)
+		}
+	}
+
+	return steps
+}
@@ -2,9 +2,14 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	bootstrapTypes "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
@@ -12,11 +17,42 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+const (
+	// rootRule is the rule verified ahead of the others, as they require root privileges.
+	rootRule = "root"
+
+	// DefaultRuleTimeout bounds the verification of a single rule, so that a hung check does not stall the validation.
+	DefaultRuleTimeout = 2 * time.Minute
+)
+
+// validationTally counts the outcome of the validation checks.
+type validationTally struct {
+	passed, warnings, skipped int
+	errors                    []*errdefs.ValidationError
+
+	// strict fails the warning level checks like the error level ones.
+	strict bool
+
+	// findings of the failed and the warning checks, for the summary.
+	failures, warningFindings []bootstrapTypes.ValidationFinding
+}
+
 // Validate runs all validation checks.
-func (p *BootstrapFactory) Validate(skip map[string]bool) error {
-	var validationErrors []error
-	ctx := context.Background()
+// The checks are verified in parallel unless sequential is set, while their results are always reported in the
+// registration order. The root check, if registered, is verified first as the other checks depend on it.
+// Each check is given up after ruleTimeout and reported with a timeout reason, zero means no timeout.
+// The checks are verified with the given context, hence cancelling it interrupts the running checks.
+// In strict mode a failed warning level check fails the validation like an error level one.
+func (p *BootstrapFactory) Validate(ctx context.Context, skip map[string]bool, sequential, strict bool, ruleTimeout time.Duration) error {
+	_, err := p.ValidateWithSummary(ctx, skip, sequential, strict, ruleTimeout)
 
+	return err
+}
+
+// ValidateWithSummary runs all validation checks like Validate, and returns the summary of their outcome,
+// which is returned along with the error if the validation failed.
+func (p *BootstrapFactory) ValidateWithSummary(ctx context.Context, skip map[string]bool, sequential, strict bool,
+	ruleTimeout time.Duration) (*bootstrapTypes.ValidationSummary, error) {
 	var rules []validators.Rule
 
 	rt := vars.RuntimeFactory.GetRuntimeType()
@@ -27,44 +63,163 @@ func (p *BootstrapFactory) Validate(skip map[string]bool) error {
 		rules = validators.OpenshiftRegistry.Rules()
 	}
 
+	tally := &validationTally{strict: strict}
+	pending := make([]validators.Rule, 0, len(rules))
 	for _, rule := range rules {
 		ruleName := rule.Name()
 		if skip[ruleName] {
 			logger.Warningf("%s check skipped; Proceeding without validation may result in deployment failure.", ruleName)
+			tally.skipped++
+
+			continue
+		}
+
+		if ruleName != rootRule {
+			pending = append(pending, rule)
 
 			continue
 		}
 
 		s := spinner.New("Validating " + ruleName + " ...")
 		s.Start(ctx)
-		err := rule.Verify()
-
-		if err != nil {
-			s.Fail(err.Error())
+		if err := verifyRule(ctx, rule, ruleTimeout); err != nil {
+			// exit right away if user is not root as other checks require root privileges
 			s.StopWithHint(err.Error(), rule.Hint())
+			rootErr := errors.New("root privileges are required for validation")
+			tally.failures = append(tally.failures, bootstrapTypes.ValidationFinding{Rule: ruleName, Message: rootErr.Error(), Hint: rule.Hint()})
+			tally.errors = append(tally.errors, &errdefs.ValidationError{Rule: ruleName, Err: rootErr})
 
-			// exit right away if user is not root as other checks require root privileges
-			if ruleName == "root" {
-				return fmt.Errorf("root privileges are required for validation")
-			}
-
-			switch rule.Level() {
-			case constants.ValidationLevelError:
-				s.Fail(err.Error())
-				validationErrors = append(validationErrors, fmt.Errorf("%s: %w", ruleName, err))
-			case constants.ValidationLevelWarning:
-				s.Stop("Warning: " + err.Error())
-			}
-		} else {
-			s.Stop(rule.Message())
+			return tally.summary(rt), tally.errors[0]
+		}
+		s.Stop(rule.Message())
+		tally.passed++
+	}
+
+	if sequential {
+		for _, rule := range pending {
+			s := spinner.New("Validating " + rule.Name() + " ...")
+			s.Start(ctx)
+			tally.report(s, rule, verifyRule(ctx, rule, ruleTimeout))
 		}
+	} else {
+		errs := verifyInParallel(ctx, pending, ruleTimeout)
+		for i, rule := range pending {
+			s := spinner.New("Validating " + rule.Name() + " ...")
+			s.Start(ctx)
+			tally.report(s, rule, errs[i])
+		}
+	}
+
+	// the summary states the outcome in words, so that it does not rely on the colors or the glyphs
+	summary := fmt.Sprintf("%d passed, %d failed, %d warning(s), %d skipped", tally.passed, len(tally.errors), tally.warnings, tally.skipped)
+	if len(tally.errors) > 0 {
+		logger.Infoln("Validation FAILED: " + summary)
+
+		return tally.summary(rt), &errdefs.ValidationFailures{Failures: tally.errors}
+	}
+
+	logger.Infoln("All validations passed: " + summary)
+
+	return tally.summary(rt), nil
+}
+
+// summary returns the summary of the counted checks.
+func (t *validationTally) summary(rt types.RuntimeType) *bootstrapTypes.ValidationSummary {
+	failures := t.failures
+	if failures == nil {
+		failures = []bootstrapTypes.ValidationFinding{}
+	}
+	warnings := t.warningFindings
+	if warnings == nil {
+		warnings = []bootstrapTypes.ValidationFinding{}
 	}
 
-	if len(validationErrors) > 0 {
-		return fmt.Errorf("%d validation check(s) failed", len(validationErrors))
+	return &bootstrapTypes.ValidationSummary{
+		Runtime: rt,
+		Passed:  len(t.errors) == 0,
+		Checks: bootstrapTypes.ValidationCounts{
+			Passed:   t.passed,
+			Failed:   len(t.errors),
+			Warnings: t.warnings,
+			Skipped:  t.skipped,
+		},
+		Failures: failures,
+		Warnings: warnings,
+	}
+}
+
+// verifyInParallel verifies the given rules concurrently and returns their errors in the order of the rules.
+// A single spinner is shown meanwhile, so that the output of the checks does not interleave.
+func verifyInParallel(ctx context.Context, rules []validators.Rule, ruleTimeout time.Duration) []error {
+	errs := make([]error, len(rules))
+	if len(rules) == 0 {
+		return errs
 	}
 
-	logger.Infoln("All validations passed")
+	s := spinner.New(fmt.Sprintf("Running %d validation checks ...", len(rules)))
+	s.Start(ctx)
 
-	return nil
+	var wg sync.WaitGroup
+	for i, rule := range rules {
+		wg.Go(func() {
+			// each goroutine writes only its own slot, hence no locking is needed
+			errs[i] = verifyRule(ctx, rule, ruleTimeout)
+		})
+	}
+	wg.Wait()
+
+	s.Stop(fmt.Sprintf("Completed %d validation checks", len(rules)))
+
+	return errs
+}
+
+// verifyRule verifies the rule, giving up once the timeout elapsed even if the rule does not honour the context.
+func verifyRule(ctx context.Context, rule validators.Rule, timeout time.Duration) error {
+	if timeout <= 0 {
+		return rule.Verify(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// buffered, so that a rule finishing after the timeout does not leak its goroutine
+	done := make(chan error, 1)
+	go func() {
+		done <- rule.Verify(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("check timed out after %s", timeout)
+	}
+}
+
+// report stops the spinner of the rule with its outcome and counts it.
+func (t *validationTally) report(s *spinner.Spinner, rule validators.Rule, err error) {
+	if err == nil {
+		s.Stop(rule.Message())
+		t.passed++
+
+		return
+	}
+
+	level := rule.Level()
+	if t.strict && level == constants.ValidationLevelWarning {
+		level = constants.ValidationLevelError
+		err = fmt.Errorf("%w (warning treated as error in strict mode)", err)
+	}
+
+	switch level {
+	case constants.ValidationLevelError:
+		s.StopWithHint(err.Error(), rule.Hint())
+		t.errors = append(t.errors, &errdefs.ValidationError{Rule: rule.Name(), Err: err})
+		t.failures = append(t.failures, bootstrapTypes.ValidationFinding{Rule: rule.Name(), Message: err.Error(), Hint: rule.Hint()})
+	case constants.ValidationLevelWarning:
+		s.Warn(err.Error())
+		logger.Infof("HINT: %s\n", rule.Hint())
+		t.warnings++
+		t.warningFindings = append(t.warningFindings, bootstrapTypes.ValidationFinding{Rule: rule.Name(), Message: err.Error(), Hint: rule.Hint()})
+	}
 }
@@ -0,0 +1,287 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	bootstrapTypes "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// fakeRule is a validation rule verified by the given func.
+type fakeRule struct {
+	name   string
+	level  constants.ValidationLevel
+	verify func(ctx context.Context) error
+}
+
+func (r *fakeRule) Verify(ctx context.Context) error { return r.verify(ctx) }
+func (r *fakeRule) Message() string                  { return r.name + " ok" }
+func (r *fakeRule) Name() string                     { return r.name }
+func (r *fakeRule) Level() constants.ValidationLevel { return r.level }
+func (r *fakeRule) Hint() string                     { return "fix " + r.name }
+func (r *fakeRule) Description() string              { return "validates " + r.name }
+
+func newRule(name string, verify func(ctx context.Context) error) *fakeRule {
+	return &fakeRule{name: name, level: constants.ValidationLevelError, verify: verify}
+}
+
+func newWarningRule(name string, verify func(ctx context.Context) error) *fakeRule {
+	return &fakeRule{name: name, level: constants.ValidationLevelWarning, verify: verify}
+}
+
+// useRules replaces the podman validation rules with the given ones for the test.
+func useRules(t *testing.T, rules ...validators.Rule) {
+	t.Helper()
+
+	registry, factory := validators.PodmanRegistry, vars.RuntimeFactory
+	t.Cleanup(func() {
+		validators.PodmanRegistry, vars.RuntimeFactory = registry, factory
+	})
+
+	validators.PodmanRegistry = validators.NewValidationRegistry()
+	for _, rule := range rules {
+		validators.PodmanRegistry.Register(rule)
+	}
+	vars.RuntimeFactory = runtime.NewRuntimeFactory(types.RuntimeTypePodman)
+}
+
+// TestValidateParallel asserts that the checks are verified concurrently unless sequential is set. Run with -race,
+// it also asserts that the outcome of the concurrent checks is collected without data races.
+func TestValidateParallel(t *testing.T) {
+	const checks = 4
+
+	for _, sequential := range []bool{false, true} {
+		t.Run(map[bool]string{false: "parallel", true: "sequential"}[sequential], func(t *testing.T) {
+			var mu sync.Mutex
+			running, maxRunning := 0, 0
+			// the checks of the parallel run wait for each other, which would deadlock if they were run one by one
+			var barrier sync.WaitGroup
+			barrier.Add(checks)
+			allStarted := make(chan struct{})
+			go func() {
+				barrier.Wait()
+				close(allStarted)
+			}()
+
+			rules := []validators.Rule{newRule(rootRule, func(context.Context) error { return nil })}
+			for i := range checks {
+				rules = append(rules, newRule(string(rune('a'+i)), func(ctx context.Context) error {
+					mu.Lock()
+					running++
+					maxRunning = max(maxRunning, running)
+					mu.Unlock()
+					defer func() {
+						mu.Lock()
+						running--
+						mu.Unlock()
+					}()
+
+					if !sequential {
+						barrier.Done()
+						select {
+						case <-allStarted:
+						case <-time.After(5 * time.Second):
+							return errors.New("not verified concurrently")
+						}
+					}
+
+					if i%2 == 0 {
+						return errors.New("failed")
+					}
+
+					return nil
+				}))
+			}
+			useRules(t, rules...)
+
+			summary, err := NewBootstrapFactory(types.RuntimeTypePodman).ValidateWithSummary(context.Background(), nil, sequential, false, time.Minute)
+			if err == nil {
+				t.Fatal("ValidateWithSummary() error = nil, want the failed checks")
+			}
+
+			wantMax := checks
+			if sequential {
+				wantMax = 1
+			}
+			if maxRunning != wantMax {
+				t.Errorf("ValidateWithSummary() ran %d checks at once, want %d", maxRunning, wantMax)
+			}
+			if summary.Checks.Passed != 3 || summary.Checks.Failed != 2 {
+				t.Errorf("ValidateWithSummary() checks = %+v, want 3 passed and 2 failed", summary.Checks)
+			}
+		})
+	}
+}
+
+// TestValidateOrderedOutput asserts that the outcome of the checks is reported in the registration order,
+// regardless of the order in which the parallel checks complete.
+func TestValidateOrderedOutput(t *testing.T) {
+	delayed := func(d time.Duration, err error) func(context.Context) error {
+		return func(context.Context) error {
+			time.Sleep(d)
+
+			return err
+		}
+	}
+
+	useRules(t,
+		newRule("first", delayed(30*time.Millisecond, errors.New("first failed"))),
+		newRule("second", delayed(20*time.Millisecond, errors.New("second failed"))),
+		newWarningRule("third", delayed(10*time.Millisecond, errors.New("third warned"))),
+		newRule("fourth", delayed(0, errors.New("fourth failed"))),
+		newRule("fifth", delayed(0, nil)),
+	)
+
+	for _, sequential := range []bool{false, true} {
+		summary, err := NewBootstrapFactory(types.RuntimeTypePodman).ValidateWithSummary(context.Background(), nil, sequential, false, time.Minute)
+		if err == nil {
+			t.Fatal("ValidateWithSummary() error = nil, want the failed checks")
+		}
+
+		rules := func(findings []bootstrapTypes.ValidationFinding) []string {
+			names := []string{}
+			for _, f := range findings {
+				names = append(names, f.Rule)
+			}
+
+			return names
+		}
+		if got, want := rules(summary.Failures), []string{"first", "second", "fourth"}; !slices.Equal(got, want) {
+			t.Errorf("ValidateWithSummary(sequential=%v) failures = %v, want %v", sequential, got, want)
+		}
+		if got, want := rules(summary.Warnings), []string{"third"}; !slices.Equal(got, want) {
+			t.Errorf("ValidateWithSummary(sequential=%v) warnings = %v, want %v", sequential, got, want)
+		}
+	}
+}
+
+// TestValidateRootFirst asserts that the root check is verified ahead of the others, which are not verified at all if it fails.
+func TestValidateRootFirst(t *testing.T) {
+	tests := []struct {
+		name      string
+		rootErr   error
+		wantCalls []string
+	}{
+		{name: "root passes", wantCalls: []string{rootRule, "other"}},
+		{name: "root fails", rootErr: errors.New("not root"), wantCalls: []string{rootRule}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			calls := []string{}
+			record := func(name string, err error) func(context.Context) error {
+				return func(context.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					calls = append(calls, name)
+
+					return err
+				}
+			}
+
+			// the root check is registered last, so that it is only verified first on purpose
+			useRules(t, newRule("other", record("other", nil)), newRule(rootRule, record(rootRule, tt.rootErr)))
+
+			summary, err := NewBootstrapFactory(types.RuntimeTypePodman).ValidateWithSummary(context.Background(), nil, false, false, time.Minute)
+			if (err != nil) != (tt.rootErr != nil) {
+				t.Errorf("ValidateWithSummary() error = %v, want error %v", err, tt.rootErr != nil)
+			}
+			if !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("ValidateWithSummary() verified %v, want %v", calls, tt.wantCalls)
+			}
+			if tt.rootErr != nil && (len(summary.Failures) != 1 || summary.Failures[0].Rule != rootRule) {
+				t.Errorf("ValidateWithSummary() failures = %+v, want the root check only", summary.Failures)
+			}
+		})
+	}
+}
+
+// TestValidateStrict asserts that a failed warning level check only fails the validation, hence the create, in strict mode.
+func TestValidateStrict(t *testing.T) {
+	errMisaligned := errors.New("numa misaligned")
+
+	tests := []struct {
+		name       string
+		strict     bool
+		warningErr error
+		wantErr    bool
+	}{
+		{name: "warning", warningErr: errMisaligned},
+		{name: "warning in strict mode", strict: true, warningErr: errMisaligned, wantErr: true},
+		{name: "no warning"},
+		{name: "no warning in strict mode", strict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useRules(t,
+				newRule("podman", func(context.Context) error { return nil }),
+				newWarningRule("numa", func(context.Context) error { return tt.warningErr }),
+			)
+
+			err := NewBootstrapFactory(types.RuntimeTypePodman).Validate(context.Background(), nil, false, tt.strict, time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestBootstrapSummaryJSON pins the JSON shape of the full bootstrap summary printed by 'bootstrap -o json', which
+// combines the configure summary with the summary of a validation having passed, failed, warning and skipped checks.
+func TestBootstrapSummaryJSON(t *testing.T) {
+	useRules(t,
+		newRule(rootRule, func(context.Context) error { return nil }),
+		newRule("podman", func(context.Context) error { return nil }),
+		newRule("spyre", func(context.Context) error { return errors.New("no spyre cards found") }),
+		newWarningRule("numa", func(context.Context) error { return errors.New("numa misaligned") }),
+		newRule("selinux", func(context.Context) error { return nil }),
+	)
+
+	validation, err := NewBootstrapFactory(types.RuntimeTypePodman).ValidateWithSummary(context.Background(),
+		map[string]bool{"selinux": true}, true, false, time.Minute)
+	if err == nil {
+		t.Fatal("ValidateWithSummary() error = nil, want the spyre check failed")
+	}
+
+	summary := bootstrapTypes.BootstrapSummary{
+		Configure: &bootstrapTypes.ConfigureSummary{
+			Runtime: types.RuntimeTypePodman,
+			Changed: true,
+			Podman: &bootstrapTypes.PodmanConfigureSummary{
+				PodmanInstalled:          true,
+				SentientGroupMemberAdded: true,
+				SpyreCards:               4,
+			},
+		},
+		Validate:        validation,
+		ReloginRequired: true,
+	}
+
+	got, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal summary: %v", err)
+	}
+
+	want, err := os.ReadFile(filepath.Join("testdata", "bootstrap_summary.json"))
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+	if string(got) != strings.TrimSpace(string(want)) {
+		t.Errorf("summary JSON =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -4,14 +4,46 @@ package application
 type CreateFlags struct {
 	// Common flags - valid for all runtimes
 	SkipValidation string
+	NoValidate     string
+	Revalidate     string
 	Template       string
 	Params         string
 	Values         string
+	// ParamsFile is an alias of Values.
+	ParamsFile string
+	ExpandEnv  string
 
 	// Podman-specific flags
-	SkipImageDownload string
-	SkipModelDownload string
-	ImagePullPolicy   string
+	SkipImageDownload  string
+	SkipModelDownload  string
+	ImagePullPolicy    string
+	StartPeriod        string
+	FromFile           string
+	MaxStartupRestarts string
+	Output             string
+	Label              string
+	AllowReducedSpyre  string
+	DeployConcurrency  string
+	FromLayer          string
+	OnlyLayer          string
+	PauseBetweenLayers string
+	LayerDelay         string
+
+	AnnotationsFromFile string
+
+	EnvFile          string
+	EnvFileContainer string
+
+	OutputDir string
+	DryRun    string
+	Force     string
+
+	Strict string
+
+	ModelDownloadTimeout      string
+	ModelDownloadTotalTimeout string
+	ModelDir                  string
+	QuietModels               string
 
 	// OpenShift-specific flags
 	Timeout string
@@ -21,14 +53,45 @@ type CreateFlags struct {
 var Create = CreateFlags{
 	// Common flags
 	SkipValidation: "skip-validation",
+	NoValidate:     "no-validate",
+	Revalidate:     "revalidate",
 	Template:       "template",
 	Params:         "params",
 	Values:         "values",
+	ParamsFile:     "params-file",
+	ExpandEnv:      "expand-env",
 
 	// Podman-specific flags
-	SkipImageDownload: "skip-image-download",
-	SkipModelDownload: "skip-model-download",
-	ImagePullPolicy:   "image-pull-policy",
+	SkipImageDownload:  "skip-image-download",
+	SkipModelDownload:  "skip-model-download",
+	ImagePullPolicy:    "image-pull-policy",
+	StartPeriod:        "start-period",
+	FromFile:           "from-file",
+	MaxStartupRestarts: "max-startup-restarts",
+	Output:             "output",
+	Label:              "label",
+	AllowReducedSpyre:  "allow-reduced-spyre",
+	DeployConcurrency:  "deploy-concurrency",
+	FromLayer:          "from-layer",
+	OnlyLayer:          "only-layer",
+	PauseBetweenLayers: "pause-between-layers",
+	LayerDelay:         "layer-delay",
+
+	AnnotationsFromFile: "annotations-from-file",
+
+	EnvFile:          "env-file",
+	EnvFileContainer: "env-file-container",
+
+	OutputDir: "output-dir",
+	DryRun:    "dry-run",
+	Force:     "force",
+
+	Strict: "strict",
+
+	ModelDownloadTimeout:      "model-download-timeout",
+	ModelDownloadTotalTimeout: "model-download-total-timeout",
+	ModelDir:                  "model-dir",
+	QuietModels:               "quiet-models",
 
 	// OpenShift-specific flags
 	Timeout: "timeout",
@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// createRuntime creates the runtime the pods are completed from, a variable so that the tests can use the fake runtime.
+var createRuntime = runtime.CreateRuntime
+
+// CompleteTemplateNames is the shell completion func for the --template flags, it lists the application templates
+// matching the typed prefix case-insensitively. Each suggestion is described using the template description.
+func CompleteTemplateNames(_ *cobra.Command, _ []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+
+	apps, err := tp.ListApplications(false)
+	if err != nil {
+		cobra.CompErrorln("failed to list the application templates: " + err.Error())
+
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := make([]cobra.Completion, 0, len(apps))
+	for _, app := range apps {
+		if !strings.HasPrefix(strings.ToLower(app), strings.ToLower(toComplete)) {
+			continue
+		}
+
+		description := ""
+		if md, err := tp.LoadMetadata(app, false); err == nil {
+			description = md.Description
+		}
+		completions = append(completions, cobra.CompletionWithDesc(app, description))
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// RegisterTemplateCompletion registers the template name completion for the given flag of the commands.
+func RegisterTemplateCompletion(flag string, cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		_ = cmd.RegisterFlagCompletionFunc(flag, CompleteTemplateNames)
+	}
+}
+
+// CompletePodNames is the shell completion func for the --pod flags, it lists the pods of the application given as
+// the first positional argument. The pods already given to the flag are left out.
+func CompletePodNames(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		cobra.CompDebugln("application name is required to complete the pod names", true)
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	appName := args[0]
+
+	// the runtime factory is not initialized on completion as the persistent pre-run is skipped, hence the flag is read here
+	rt := types.RuntimeTypePodman
+	if f := cmd.Flag("runtime"); f != nil {
+		rt = types.RuntimeType(f.Value.String())
+	}
+
+	r, err := createRuntime(rt, appName)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	pods, err := ListApplicationPods(r, appName)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var given []string
+	if f := cmd.Flag("pod"); f != nil && f.Changed {
+		if sv, ok := f.Value.(interface{ GetSlice() []string }); ok {
+			given = sv.GetSlice()
+		}
+	}
+
+	completions := make([]cobra.Completion, 0, len(pods))
+	for _, pod := range pods {
+		if !strings.HasPrefix(pod.Name, toComplete) || slices.Contains(given, pod.Name) {
+			continue
+		}
+		completions = append(completions, cobra.CompletionWithDesc(pod.Name, pod.Status))
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,130 @@
+package helpers
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// completionNames returns the completed values without their descriptions.
+func completionNames(completions []cobra.Completion) []string {
+	names := make([]string, 0, len(completions))
+	for _, c := range completions {
+		name, _, _ := strings.Cut(c, "\t")
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func TestCompleteTemplateNames(t *testing.T) {
+	tests := []struct {
+		name       string
+		toComplete string
+		want       []string
+	}{
+		{name: "all", toComplete: "", want: []string{"rag"}},
+		{name: "prefix", toComplete: "ra", want: []string{"rag"}},
+		{name: "case insensitive", toComplete: "RA", want: []string{"rag"}},
+		{name: "no match", toComplete: "chat", want: []string{}},
+		// hidden templates are not completed
+		{name: "hidden", toComplete: "rag-", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completions, directive := CompleteTemplateNames(&cobra.Command{}, nil, tt.toComplete)
+			if directive != cobra.ShellCompDirectiveNoFileComp {
+				t.Errorf("CompleteTemplateNames() directive = %v, want %v", directive, cobra.ShellCompDirectiveNoFileComp)
+			}
+			if got := completionNames(completions); !slices.Equal(got, tt.want) {
+				t.Errorf("CompleteTemplateNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	completions, _ := CompleteTemplateNames(&cobra.Command{}, nil, "rag")
+	if _, desc, _ := strings.Cut(completions[0], "\t"); !strings.Contains(desc, "Retrieval Augmented Generation") {
+		t.Errorf("CompleteTemplateNames() description = %q, want the template description", desc)
+	}
+}
+
+func TestCompletePodNames(t *testing.T) {
+	r := fake.New()
+	for _, pod := range []types.Pod{
+		{ID: "1", Name: "app--vllm-server", Status: "Running", Labels: map[string]string{constants.ApplicationAnnotationKey: "app"}},
+		{ID: "2", Name: "app--opensearch", Status: "Running", Labels: map[string]string{constants.ApplicationAnnotationKey: "app"}},
+		{ID: "3", Name: "app--ui", Status: "Exited", Labels: map[string]string{constants.ApplicationAnnotationKey: "app"}},
+		{ID: "4", Name: "other--ui", Status: "Running", Labels: map[string]string{constants.ApplicationAnnotationKey: "other"}},
+		{
+			ID: "5", Name: "team--app--ui", Status: "Running",
+			Labels: map[string]string{constants.ApplicationAnnotationKey: "app", constants.NamespaceLabelKey: "team"},
+		},
+	} {
+		r.AddPod(pod)
+	}
+
+	tests := []struct {
+		name          string
+		args          []string
+		given         []string
+		toComplete    string
+		createErr     error
+		want          []string
+		wantDirective cobra.ShellCompDirective
+	}{
+		{
+			name: "all pods of the application", args: []string{"app"},
+			want: []string{"app--vllm-server", "app--opensearch", "app--ui"}, wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name: "prefix", args: []string{"app"}, toComplete: "app--o",
+			want: []string{"app--opensearch"}, wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name: "already given pods left out", args: []string{"app"}, given: []string{"app--ui"},
+			want: []string{"app--vllm-server", "app--opensearch"}, wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{name: "no application", want: []string{}, wantDirective: cobra.ShellCompDirectiveNoFileComp},
+		{
+			name: "runtime unavailable", args: []string{"app"}, createErr: errors.New("podman socket not found"),
+			want: []string{}, wantDirective: cobra.ShellCompDirectiveError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := createRuntime
+			createRuntime = func(rt types.RuntimeType, namespace string) (runtime.Runtime, error) {
+				if tt.createErr != nil {
+					return nil, tt.createErr
+				}
+
+				return r, nil
+			}
+			t.Cleanup(func() { createRuntime = orig })
+
+			cmd := &cobra.Command{}
+			cmd.Flags().StringSlice("pod", nil, "")
+			for _, pod := range tt.given {
+				_ = cmd.Flags().Set("pod", pod)
+			}
+
+			completions, directive := CompletePodNames(cmd, tt.args, tt.toComplete)
+			if directive != tt.wantDirective {
+				t.Errorf("CompletePodNames() directive = %v, want %v", directive, tt.wantDirective)
+			}
+			if got := completionNames(completions); !slices.Equal(got, tt.want) {
+				t.Errorf("CompletePodNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -9,74 +11,166 @@ import (
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
-const (
+var (
+	// inspectPollInterval is the interval in which a container is inspected while waiting for its readiness.
 	inspectPollInterval = 10 * time.Second
+	// transientInspectRetryInterval is the wait before retrying a failed inspect, a var so that the tests can shorten it.
+	transientInspectRetryInterval = 2 * time.Second
 )
 
-func WaitForContainerReadiness(runtime runtime.Runtime, containerNameOrId string, timeout time.Duration) error {
+const (
+	// maxTransientInspectErrors is the number of consecutive inspect failures tolerated while waiting for readiness,
+	// e.g. while the podman socket is busy or the container is being restarted.
+	maxTransientInspectErrors = 3
+	// crashLoopLogLines is the number of log lines shown when a container crash-loops during startup.
+	crashLoopLogLines = 20
+	// readinessLogLines is the number of log lines shown when a container does not turn ready in time.
+	readinessLogLines = 20
+)
+
+// WaitForContainerReadiness waits until the container is healthy within the specified timeout.
+// It fails early when the container restarted more than maxRestarts times during the wait,
+// along with its last log lines. A negative maxRestarts disables the crash-loop detection.
+// The wait stops as soon as the context is done.
+func WaitForContainerReadiness(ctx context.Context, runtime runtime.Runtime, containerNameOrId string, timeout time.Duration, maxRestarts int) error {
 	var containerStatus *types.Container
-	var err error
+	var inspectErr error
 
 	deadline := time.Now().Add(timeout)
+	baselineRestarts := -1
+	inspectErrors := 0
 
-	for {
+	// the condition paces itself by waiting on the runtime for the container to turn healthy, hence no poll interval
+	err := utils.Poll(ctx, 0, timeout, func() (bool, error) {
 		// fetch the container status
-		containerStatus, err = runtime.InspectContainer(containerNameOrId)
+		status, err := runtime.InspectContainer(containerNameOrId)
+		inspectErr = err
 		if err != nil {
-			return fmt.Errorf("failed to check container status: %w", err)
+			// a missing container will not turn up anymore, while the other failures may be transient
+			inspectErrors++
+			if errors.Is(err, errdefs.ErrContainerNotFound) || inspectErrors > maxTransientInspectErrors || time.Now().After(deadline) {
+				return false, fmt.Errorf("failed to check container status: %w", err)
+			}
+			logger.Infof("failed to inspect the container, retrying (%d/%d): %v\n", inspectErrors, maxTransientInspectErrors, err,
+				logger.VerbosityLevelDebug)
+
+			return false, utils.Sleep(ctx, min(transientInspectRetryInterval, time.Until(deadline)))
 		}
+		inspectErrors = 0
+		containerStatus = status
 
-		healthStatus := containerStatus.Health
+		if status.Health == "" || status.Health == string(constants.Ready) {
+			return true, nil
+		}
 
-		if healthStatus == "" {
-			return nil
+		if baselineRestarts == -1 {
+			baselineRestarts = status.RestartCount
 		}
 
-		if healthStatus == string(constants.Ready) {
-			return nil
+		if restarts := status.RestartCount - baselineRestarts; maxRestarts >= 0 && restarts > maxRestarts {
+			return false, crashLoopError(runtime, containerNameOrId, restarts)
 		}
 
-		// if deadline exceeds, stop the container readiness check
-		if time.Now().After(deadline) {
-			return fmt.Errorf("operation timed out waiting for container readiness")
+		// once the deadline is exceeded, Poll stops the container readiness check
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false, nil
+		}
+
+		// wait for the container to turn healthy until the next inspect, which checks it for a crash loop.
+		// The runtime returns as soon as the container is healthy, instead of after the full poll interval.
+		interval := min(inspectPollInterval, remaining)
+		waitStart := time.Now()
+		err = runtime.WaitContainerHealthy(ctx, containerNameOrId, interval)
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, errdefs.ErrReadinessTimeout) {
+			return false, nil
 		}
 
-		// every 10 seconds inspect the container
-		time.Sleep(inspectPollInterval)
+		// e.g. the container stopped while being restarted, fall back to polling it
+		logger.Infof("failed to wait for the container to be healthy, polling it instead: %v\n", err, logger.VerbosityLevelDebug)
+
+		return false, utils.Sleep(ctx, interval-time.Since(waitStart))
+	})
+	if errors.Is(err, utils.ErrPollTimeout) {
+		// the container could not be inspected anymore until the deadline
+		if inspectErr != nil {
+			return fmt.Errorf("failed to check container status: %w", inspectErr)
+		}
+
+		return fmt.Errorf("%w waiting for container readiness%s", errdefs.ErrReadinessTimeout,
+			readinessFailureDetails(runtime, containerNameOrId, containerStatus))
 	}
+
+	return err
 }
 
-// WaitForContainersCreation waits until all the containers in the provided podID are created within the specified timeout.
-func WaitForContainersCreation(runtime runtime.Runtime, podID string, expectedContainerCount int, timeout time.Duration) error {
-	deadline := time.Now().Add(timeout)
+// crashLoopError builds the error for a container crash-looping during startup, including its last log lines.
+func crashLoopError(runtime runtime.Runtime, containerNameOrId string, restarts int) error {
+	lines, err := runtime.ContainerLogTail(containerNameOrId, crashLoopLogLines)
+	if err != nil {
+		logger.Warningf("failed to fetch the logs of the crash-looping container: %v\n", err)
+
+		return fmt.Errorf("container is crash-looping: restarted %d times during startup", restarts)
+	}
 
-	for {
+	return fmt.Errorf("container is crash-looping: restarted %d times during startup, last %d log lines:\n%s",
+		restarts, len(lines), strings.Join(lines, "\n"))
+}
+
+// readinessFailureDetails describes why the container did not turn ready, i.e. the outcome of its last health check
+// and its last log lines, e.g. to reveal a model failing to load. The latest state of the container is inspected,
+// falling back to the given one. Empty string is returned if nothing could be fetched.
+func readinessFailureDetails(runtime runtime.Runtime, containerNameOrId string, lastStatus *types.Container) string {
+	if status, err := runtime.InspectContainer(containerNameOrId); err == nil {
+		lastStatus = status
+	}
+
+	var details strings.Builder
+	if lastStatus != nil && lastStatus.HealthLog != "" {
+		fmt.Fprintf(&details, "\nlast health check (%s): %s", lastStatus.Health, lastStatus.HealthLog)
+	}
+
+	lines, err := runtime.ContainerLogTail(containerNameOrId, readinessLogLines)
+	if err != nil {
+		logger.Warningf("failed to fetch the logs of the container: %v\n", err)
+	} else if len(lines) > 0 {
+		fmt.Fprintf(&details, "\nlast %d log lines:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+
+	return details.String()
+}
+
+// WaitForContainersCreation waits until all the containers in the provided podID are created within the specified timeout,
+// or until the given context is done.
+func WaitForContainersCreation(ctx context.Context, runtime runtime.Runtime, podID string, expectedContainerCount int, timeout time.Duration) error {
+	// every 10 seconds inspect the pod
+	err := utils.Poll(ctx, inspectPollInterval, timeout, func() (bool, error) {
 		// fetch the pod info
 		pInfo, err := runtime.InspectPod(podID)
 		if err != nil {
-			return fmt.Errorf("failed to do pod inspect for podID: %s with error: %w", podID, err)
+			return false, fmt.Errorf("failed to do pod inspect for podID: %s with error: %w", podID, err)
 		}
 
 		// if the expected count is reached, then all the containers are created
 		// Note: Adding +1 to the expectedContainerCount as there is an additional 'infra' container added to all pods by podman
-		if len(pInfo.Containers) == expectedContainerCount+1 {
-			return nil
-		}
-
-		// if deadline exceeds, stop the container creation check
-		if time.Now().After(deadline) {
-			return fmt.Errorf("operation timed out waiting for container creation")
-		}
-
-		// every 10 seconds inspect the pod
-		time.Sleep(inspectPollInterval)
+		return len(pInfo.Containers) == expectedContainerCount+1, nil
+	})
+	if errors.Is(err, utils.ErrPollTimeout) {
+		return fmt.Errorf("%w waiting for container creation", errdefs.ErrReadinessTimeout)
 	}
+
+	return err
 }
 
 func FetchContainerStartPeriod(runtime runtime.Runtime, containerNameOrId string) (time.Duration, error) {
@@ -151,11 +245,11 @@ func FindFreeSpyreCards() ([]string, error) {
 	return free_spyre_dev_id_list, nil
 }
 
-func RunServiceReportContainer(runCmd string, mode string) error {
+func RunServiceReportContainer(ctx context.Context, runCmd string, mode string) error {
 	var svc_tool_cmd *exec.Cmd
 	switch mode {
 	case "configure":
-		svc_tool_cmd = exec.Command(
+		svc_tool_cmd = exec.CommandContext(ctx,
 			"podman",
 			"run",
 			"--privileged",
@@ -170,7 +264,7 @@ func RunServiceReportContainer(runCmd string, mode string) error {
 			"bash", "-c", runCmd,
 		)
 	case "validate":
-		svc_tool_cmd = exec.Command(
+		svc_tool_cmd = exec.CommandContext(ctx,
 			"podman",
 			"run",
 			"--privileged",
@@ -218,11 +312,9 @@ func ParseSkipChecks(skipChecks []string) map[string]bool {
 func CheckExistingPodsForApplication(runtime runtime.Runtime, appName string) ([]string, error) {
 	//nolint:prealloc // as capacity is unknown and depends on runtime.ListPods response
 	var podsToSkip []string
-	pods, err := runtime.ListPods(map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
-	})
+	pods, err := ListApplicationPods(runtime, appName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list pods: %w", err)
+		return nil, err
 	}
 
 	if len(pods) == 0 {
@@ -0,0 +1,291 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestMain(m *testing.M) {
+	inspectPollInterval = time.Millisecond
+	transientInspectRetryInterval = time.Millisecond
+
+	os.Exit(m.Run())
+}
+
+func newReadinessRuntime(health string) *fake.Runtime {
+	r := fake.New()
+	r.AddPod(types.Pod{ID: "pod-id", Name: "pod"}, types.Container{ID: "c-id", Name: "c", Status: "running", Health: health})
+
+	return r
+}
+
+func TestWaitForContainerReadiness(t *testing.T) {
+	tests := []struct {
+		name        string
+		health      string
+		maxRestarts int
+		// onInspect changes the container on each inspect.
+		onInspect func(c *types.Container)
+		timeout   time.Duration
+		wantErr   error
+		wantMsg   string
+	}{
+		{name: "no health check", timeout: time.Second},
+		{name: "already healthy", health: "healthy", timeout: time.Second},
+		{
+			name: "turns healthy", health: "starting", maxRestarts: -1, timeout: time.Second,
+			onInspect: func() func(c *types.Container) {
+				inspects := 0
+
+				return func(c *types.Container) {
+					if inspects++; inspects > 3 {
+						c.Health = "healthy"
+					}
+				}
+			}(),
+		},
+		{name: "never healthy", health: "starting", maxRestarts: -1, timeout: 20 * time.Millisecond, wantErr: errdefs.ErrReadinessTimeout},
+		{
+			name: "crash loop", health: "starting", maxRestarts: 1, timeout: time.Second, wantMsg: "container is crash-looping",
+			onInspect: func(c *types.Container) { c.RestartCount++ },
+		},
+		{
+			name: "restarts tolerated without crash loop detection", health: "starting", maxRestarts: -1, timeout: 20 * time.Millisecond,
+			onInspect: func(c *types.Container) { c.RestartCount++ }, wantErr: errdefs.ErrReadinessTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReadinessRuntime(tt.health)
+			r.OnInspectContainer = tt.onInspect
+
+			err := WaitForContainerReadiness(context.Background(), r, "c-id", tt.timeout, tt.maxRestarts)
+			if tt.wantErr == nil && tt.wantMsg == "" {
+				if err != nil {
+					t.Fatalf("WaitForContainerReadiness() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) || !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("WaitForContainerReadiness() error = %v, want %v %q", err, tt.wantErr, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestWaitForContainerReadinessInspectErrors(t *testing.T) {
+	t.Run("missing container", func(t *testing.T) {
+		err := WaitForContainerReadiness(context.Background(), fake.New(), "missing", time.Second, -1)
+		if !errors.Is(err, errdefs.ErrContainerNotFound) {
+			t.Errorf("WaitForContainerReadiness() error = %v, want %v", err, errdefs.ErrContainerNotFound)
+		}
+	})
+
+	t.Run("transient failures then healthy", func(t *testing.T) {
+		r := newReadinessRuntime("starting")
+		r.FailTimes("InspectContainer:c-id", maxTransientInspectErrors, errors.New("socket busy"))
+		inspects := 0
+		r.OnInspectContainer = func(c *types.Container) {
+			if inspects++; inspects > 1 {
+				c.Health = "healthy"
+			}
+		}
+
+		if err := WaitForContainerReadiness(context.Background(), r, "c-id", time.Second, -1); err != nil {
+			t.Errorf("WaitForContainerReadiness() error = %v, want the transient failures tolerated", err)
+		}
+	})
+
+	t.Run("too many transient failures", func(t *testing.T) {
+		errBusy := errors.New("socket busy")
+		r := newReadinessRuntime("starting")
+		r.FailTimes("InspectContainer:c-id", maxTransientInspectErrors+1, errBusy)
+
+		err := WaitForContainerReadiness(context.Background(), r, "c-id", time.Second, -1)
+		if !errors.Is(err, errBusy) {
+			t.Errorf("WaitForContainerReadiness() error = %v, want %v", err, errBusy)
+		}
+	})
+
+	t.Run("transient failures then crash loop", func(t *testing.T) {
+		r := newReadinessRuntime("starting")
+		r.FailTimes("InspectContainer:c-id", 2, errors.New("container is being restarted"))
+		r.OnInspectContainer = func(c *types.Container) { c.RestartCount++ }
+
+		err := WaitForContainerReadiness(context.Background(), r, "c-id", time.Second, 1)
+		if err == nil || !strings.Contains(err.Error(), "container is crash-looping") {
+			t.Errorf("WaitForContainerReadiness() error = %v, want the crash loop reported", err)
+		}
+	})
+
+	t.Run("transient failure past the deadline", func(t *testing.T) {
+		errBusy := errors.New("socket busy")
+		r := newReadinessRuntime("starting")
+		r.Fail("InspectContainer:c-id", errBusy)
+
+		err := WaitForContainerReadiness(context.Background(), r, "c-id", 10*time.Millisecond, -1)
+		if !errors.Is(err, errBusy) {
+			t.Errorf("WaitForContainerReadiness() error = %v, want %v", err, errBusy)
+		}
+	})
+}
+
+func TestWaitForContainerReadinessCancelled(t *testing.T) {
+	r := newReadinessRuntime("starting")
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := WaitForContainerReadiness(ctx, r, "c-id", time.Hour, -1)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitForContainerReadiness() error = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("WaitForContainerReadiness() returned %s after the cancellation, want right away", elapsed)
+	}
+}
+
+func TestWaitForContainersCreationCancelled(t *testing.T) {
+	// the infra container is never created, hence the containers of the pod are awaited until cancelled
+	r := fake.New()
+	r.AddPod(types.Pod{ID: "pod-id", Name: "pod"}, types.Container{ID: "c-id", Name: "c", Status: "created"})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := WaitForContainersCreation(ctx, r, "pod-id", 1, time.Hour)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitForContainersCreation() error = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("WaitForContainersCreation() returned %s after the cancellation, want right away", elapsed)
+	}
+}
+
+// TestWaitForContainerReadinessWaitPaths asserts that the readiness is awaited with the wait of the runtime,
+// falling back to polling the container if the wait fails.
+func TestWaitForContainerReadinessWaitPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		waitErr error
+	}{
+		{name: "runtime wait"},
+		{name: "polling fallback", waitErr: errors.New("container is stopped")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReadinessRuntime("starting")
+			if tt.waitErr != nil {
+				r.Fail("WaitContainerHealthy:c-id", tt.waitErr)
+			}
+			inspects := 0
+			r.OnInspectContainer = func(c *types.Container) {
+				if inspects++; inspects > 3 {
+					c.Health = "healthy"
+				}
+			}
+
+			if err := WaitForContainerReadiness(context.Background(), r, "c-id", time.Second, -1); err != nil {
+				t.Fatalf("WaitForContainerReadiness() error = %v", err)
+			}
+
+			waits := countCalls(r, "WaitContainerHealthy:c-id")
+			if waits == 0 {
+				t.Errorf("WaitForContainerReadiness() did not wait on the runtime")
+			}
+			// the runtime wait returns once the container is healthy, while polling inspects the container each time
+			if tt.waitErr == nil && waits != 1 {
+				t.Errorf("WaitForContainerReadiness() waited %d times on the runtime, want once", waits)
+			}
+			if tt.waitErr != nil && waits < 3 {
+				t.Errorf("WaitForContainerReadiness() waited %d times on the runtime, want a wait before each poll", waits)
+			}
+		})
+	}
+}
+
+// TestWaitForContainerReadinessFailureDetails asserts that a readiness timeout reports the last health check and
+// the last log lines of an unhealthy container, whatever could be fetched of them.
+func TestWaitForContainerReadinessFailureDetails(t *testing.T) {
+	logs := make([]string, readinessLogLines+5)
+	for i := range logs {
+		logs[i] = "loading shard"
+	}
+	logs[len(logs)-1] = "torch.OutOfMemoryError: CUDA out of memory"
+
+	tests := []struct {
+		name      string
+		healthLog string
+		logs      []string
+		logsErr   error
+		wantMsgs  []string
+		wantNot   []string
+	}{
+		{
+			name:      "health log and logs",
+			healthLog: "exit code 7: curl: (7) Failed to connect to localhost port 8000",
+			logs:      logs,
+			wantMsgs: []string{
+				"last health check (unhealthy): exit code 7: curl: (7) Failed to connect to localhost port 8000",
+				"last 20 log lines:\n", "torch.OutOfMemoryError: CUDA out of memory",
+			},
+		},
+		{name: "logs only", logs: []string{"starting"}, wantMsgs: []string{"last 1 log lines:\nstarting"}, wantNot: []string{"last health check"}},
+		{
+			name: "logs unavailable", healthLog: "exit code 1: not ready", logsErr: errors.New("no such container"),
+			wantMsgs: []string{"last health check (unhealthy): exit code 1: not ready"}, wantNot: []string{"log lines"},
+		},
+		{name: "nothing fetched", wantNot: []string{"last health check", "log lines"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReadinessRuntime("unhealthy")
+			r.OnInspectContainer = func(c *types.Container) { c.HealthLog = tt.healthLog }
+			r.Logs = map[string][]string{"c-id": tt.logs}
+			if tt.logsErr != nil {
+				r.Fail("ContainerLogTail:c-id", tt.logsErr)
+			}
+
+			err := WaitForContainerReadiness(context.Background(), r, "c-id", 20*time.Millisecond, -1)
+			if !errors.Is(err, errdefs.ErrReadinessTimeout) {
+				t.Fatalf("WaitForContainerReadiness() error = %v, want %v", err, errdefs.ErrReadinessTimeout)
+			}
+			for _, want := range tt.wantMsgs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("WaitForContainerReadiness() error = %q, want it to contain %q", err, want)
+				}
+			}
+			for _, notWant := range tt.wantNot {
+				if strings.Contains(err.Error(), notWant) {
+					t.Errorf("WaitForContainerReadiness() error = %q, want it not to contain %q", err, notWant)
+				}
+			}
+		})
+	}
+}
+
+// countCalls returns the number of calls of the operation recorded by the fake runtime.
+func countCalls(r *fake.Runtime, op string) int {
+	count := 0
+	for _, call := range r.Calls() {
+		if call == op {
+			count++
+		}
+	}
+
+	return count
+}
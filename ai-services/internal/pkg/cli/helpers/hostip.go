@@ -0,0 +1,76 @@
+package helpers
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// routeProbeAddress is an address outside of the host, used to look up the interface of the default route.
+// No packet is sent to it.
+const routeProbeAddress = "192.0.2.1:80"
+
+var (
+	// dial connects the socket used to look up the default route and interfaceAddrs lists the addresses of the host
+	// interfaces, they are variables so that the tests can mock the network interfaces.
+	dial           = net.Dial
+	interfaceAddrs = net.InterfaceAddrs
+)
+
+// DetectHostIP returns the IP under which the applications are reachable, it is used for all the URLs printed by the CLI.
+// The --advertise-ip override wins, otherwise the IP of the interface of the default route is used, which is the routable
+// one on a host with several interfaces. Hosts without a default route fall back to the first non-loopback IPv4 address.
+func DetectHostIP() (string, error) {
+	if vars.AdvertiseIP != "" {
+		if net.ParseIP(vars.AdvertiseIP) == nil {
+			return "", fmt.Errorf("invalid advertise IP: '%s'", vars.AdvertiseIP)
+		}
+
+		return vars.AdvertiseIP, nil
+	}
+
+	ip, err := defaultRouteIP()
+	if err == nil {
+		return ip, nil
+	}
+	logger.Infof("unable to find the IP of the default route: %v\n", err, logger.VerbosityLevelDebug)
+
+	return interfaceIP()
+}
+
+// defaultRouteIP returns the source IP the kernel selects for the traffic leaving the host.
+func defaultRouteIP() (string, error) {
+	// connecting a UDP socket only selects the source address from the routing table, nothing is sent
+	conn, err := dial("udp4", routeProbeAddress)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	addr, ok := conn.LocalAddr().(*net.UDPAddr)
+	if !ok || addr.IP.IsLoopback() || addr.IP.IsUnspecified() {
+		return "", fmt.Errorf("no routable source address, got: %v", conn.LocalAddr())
+	}
+
+	return addr.IP.String(), nil
+}
+
+// interfaceIP returns the first non-loopback IPv4 address of the host interfaces.
+func interfaceIP() (string, error) {
+	addrs, err := interfaceAddrs()
+	if err != nil {
+		return "", err
+	}
+
+	for _, address := range addrs {
+		if ipnet, ok := address.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
+			if ipnet.IP.To4() != nil {
+				return ipnet.IP.String(), nil
+			}
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// routeConn is the socket connected to look up the default route, bound to the given source address.
+type routeConn struct {
+	net.Conn
+	local net.Addr
+}
+
+func (c routeConn) LocalAddr() net.Addr { return c.local }
+func (c routeConn) Close() error        { return nil }
+
+// useInterfaces mocks the network interfaces of the host: the source address of the default route, nil if the host
+// has no default route, and the addresses of its interfaces.
+func useInterfaces(t *testing.T, routeIP net.IP, addrs ...string) {
+	t.Helper()
+
+	origDial, origAddrs := dial, interfaceAddrs
+	t.Cleanup(func() { dial, interfaceAddrs = origDial, origAddrs })
+
+	dial = func(network, address string) (net.Conn, error) {
+		if routeIP == nil {
+			return nil, errors.New("connect: network is unreachable")
+		}
+
+		return routeConn{local: &net.UDPAddr{IP: routeIP, Port: 40000}}, nil
+	}
+	interfaceAddrs = func() ([]net.Addr, error) {
+		ifAddrs := make([]net.Addr, 0, len(addrs))
+		for _, addr := range addrs {
+			ip, ipNet, err := net.ParseCIDR(addr)
+			if err != nil {
+				t.Fatalf("invalid interface address %s: %v", addr, err)
+			}
+			ifAddrs = append(ifAddrs, &net.IPNet{IP: ip, Mask: ipNet.Mask})
+		}
+
+		return ifAddrs, nil
+	}
+}
+
+func TestDetectHostIP(t *testing.T) {
+	interfaces := []string{"127.0.0.1/8", "fe80::1/64", "192.168.122.1/24", "10.20.30.40/16"}
+
+	tests := []struct {
+		name        string
+		advertiseIP string
+		routeIP     net.IP
+		addrs       []string
+		want        string
+		wantErr     string
+	}{
+		{name: "default route", routeIP: net.ParseIP("10.20.30.40"), addrs: interfaces, want: "10.20.30.40"},
+		{name: "advertise ip override", advertiseIP: "172.16.0.9", routeIP: net.ParseIP("10.20.30.40"), addrs: interfaces, want: "172.16.0.9"},
+		{name: "invalid advertise ip", advertiseIP: "10.20.30", routeIP: net.ParseIP("10.20.30.40"), wantErr: "invalid advertise IP: '10.20.30'"},
+		{name: "no default route", addrs: interfaces, want: "192.168.122.1"},
+		{name: "loopback route", routeIP: net.ParseIP("127.0.0.1"), addrs: interfaces, want: "192.168.122.1"},
+		{name: "only loopback and ipv6", addrs: []string{"127.0.0.1/8", "fe80::1/64"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			advertiseIP := vars.AdvertiseIP
+			vars.AdvertiseIP = tt.advertiseIP
+			t.Cleanup(func() { vars.AdvertiseIP = advertiseIP })
+			useInterfaces(t, tt.routeIP, tt.addrs...)
+
+			got, err := DetectHostIP()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("DetectHostIP() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectHostIP() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectHostIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,18 +1,45 @@
 package helpers
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+const (
+	// downloadStopGracePeriod is the time given to the download container to stop once the download is cancelled.
+	downloadStopGracePeriod = 10 * time.Second
+	// quietDownloadOutputLines is the number of last output lines of a failed quiet download included in its error.
+	quietDownloadOutputLines = 20
+)
+
+// ModelDownloadOptions bounds the time spent on downloading the models, zero means no limit.
+type ModelDownloadOptions struct {
+	// Timeout is the time allowed per model, including its retries.
+	Timeout time.Duration
+	// TotalTimeout is the time allowed for all the models together.
+	TotalTimeout time.Duration
+	// Quiet captures the output of the downloads instead of printing it, the output of a failed download is
+	// included in its error.
+	Quiet bool
+}
+
 func ListModels(template, appName string) ([]string, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 	tmpls, err := tp.LoadAllTemplates(template)
@@ -43,7 +70,56 @@ func ListModels(template, appName string) ([]string, error) {
 	return modelList, nil
 }
 
-func DownloadModel(model, targetDir string) error {
+// TemplateModel is a model along with the application templates requiring it.
+type TemplateModel struct {
+	Model     string   `json:"model"`
+	Templates []string `json:"templates"`
+}
+
+// ListTemplatesModels lists the union of the models of the given application templates, sorted by the model name.
+// A model shared by several templates is listed once, along with all of them.
+func ListTemplatesModels(templateNames []string) ([]TemplateModel, error) {
+	byModel := map[string]*TemplateModel{}
+	for _, template := range templateNames {
+		models, err := ListModels(template, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list models of %s: %w", template, err)
+		}
+
+		for _, model := range models {
+			entry, ok := byModel[model]
+			if !ok {
+				entry = &TemplateModel{Model: model}
+				byModel[model] = entry
+			}
+			// a model may be declared by several pods of the same template
+			if !slices.Contains(entry.Templates, template) {
+				entry.Templates = append(entry.Templates, template)
+			}
+		}
+	}
+
+	out := make([]TemplateModel, 0, len(byModel))
+	for _, model := range slices.Sorted(maps.Keys(byModel)) {
+		out = append(out, *byModel[model])
+	}
+
+	return out, nil
+}
+
+// DownloadModel downloads the model into the target directory, the download is aborted once ctx is done.
+func DownloadModel(ctx context.Context, model, targetDir string) error {
+	return downloadModel(ctx, model, targetDir, false)
+}
+
+var (
+	// downloadModel downloads a single model, a variable so that the tests can inject a download which stalls.
+	downloadModel = runModelDownload
+	// downloadCommand creates the command running the download container, a variable so that the tests can stub its output.
+	downloadCommand = exec.CommandContext
+)
+
+func runModelDownload(ctx context.Context, model, targetDir string, quiet bool) error {
 	// check for target model directory, if not present create it
 	if _, err := os.Stat(targetDir); os.IsNotExist(err) {
 		err := os.MkdirAll(targetDir, os.ModePerm)
@@ -51,12 +127,17 @@ func DownloadModel(model, targetDir string) error {
 			return fmt.Errorf("failed to create target model directory: %w", err)
 		}
 	}
-	logger.Infof("Downloading model %s to %s\n", model, targetDir)
+	logger.Infof("Downloading model %s to %s\n", model, targetDir, quietVerbosity(quiet))
 	command := "podman"
+	// a tty turns the output into progress bars, hence the captured output of a quiet download is not attached to one
+	interactive := "-ti"
+	if quiet {
+		interactive = "-i"
+	}
 	// All arguments must be passed as a slice of strings
 	args := []string{
 		"run",
-		"-ti",
+		interactive,
 		"-v",
 		fmt.Sprintf("%s:/models:Z", targetDir),
 		vars.ToolImage,
@@ -66,15 +147,92 @@ func DownloadModel(model, targetDir string) error {
 		"--local-dir",
 		fmt.Sprintf("/models/%s", model),
 	}
-	cmd := exec.Command(command, args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Stdin = os.Stdin
-	err := cmd.Run()
-	if err != nil {
-		return fmt.Errorf("failed to execute command: %w", err)
+	cmd := downloadCommand(ctx, command, args...)
+	// podman run proxies the signal to the download, which leaves the partially downloaded files
+	// behind for the next attempt to resume from
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(syscall.SIGTERM)
 	}
-	logger.Infoln("Model downloaded successfully")
+	cmd.WaitDelay = downloadStopGracePeriod
+	if quiet {
+		var output bytes.Buffer
+		cmd.Stdout = &output
+		cmd.Stderr = &output
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to execute command: %w, output:\n%s", err, lastLines(output.String(), quietDownloadOutputLines))
+		}
+	} else {
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Stdin = os.Stdin
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to execute command: %w", err)
+		}
+	}
+	metrics.ModelsDownloaded.Inc()
+	logger.Infoln("Model downloaded successfully", quietVerbosity(quiet))
 
 	return nil
 }
+
+// DownloadModels downloads the models one after the other into the target directory, retrying the failed downloads.
+// A retry resumes the download, as hf keeps the partially downloaded files in the local dir of the model.
+// If a timeout of opts is exceeded, the download is cancelled and a ModelDownloadError of the stalled model is returned.
+// onModel when set, is called before each model is downloaded.
+func DownloadModels(ctx context.Context, models []string, targetDir string, opts ModelDownloadOptions, onModel func(model string)) error {
+	if opts.TotalTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, opts.TotalTimeout,
+			fmt.Errorf("overall model download timeout of %s exceeded", opts.TotalTimeout))
+		defer cancel()
+	}
+
+	for _, model := range models {
+		if onModel != nil {
+			onModel(model)
+		}
+
+		if err := downloadModelWithTimeout(ctx, model, targetDir, opts.Timeout, opts.Quiet); err != nil {
+			return &errdefs.ModelDownloadError{Model: model, Err: fmt.Errorf("failed to download model %s: %w", model, err)}
+		}
+	}
+
+	return nil
+}
+
+func downloadModelWithTimeout(ctx context.Context, model, targetDir string, timeout time.Duration, quiet bool) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, fmt.Errorf("model download timeout of %s exceeded", timeout))
+		defer cancel()
+	}
+
+	return utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
+		err := downloadModel(ctx, model, targetDir, quiet)
+		if err != nil && ctx.Err() != nil {
+			// the download got cancelled, there is no time left for another attempt
+			return utils.PermanentError(fmt.Errorf("%w: %w", context.Cause(ctx), err))
+		}
+
+		return err
+	})
+}
+
+// quietVerbosity returns the verbosity of the per-model messages, which are only shown in debug mode when quiet.
+func quietVerbosity(quiet bool) int {
+	if quiet {
+		return logger.VerbosityLevelDebug
+	}
+
+	return 0
+}
+
+// lastLines returns the last n lines of the output.
+func lastLines(output string, n int) string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return strings.Join(lines, "\n")
+}
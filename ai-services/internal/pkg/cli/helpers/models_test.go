@@ -0,0 +1,212 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestDownloadModelsTimeout downloads the models with an injected download which stalls on the given models until
+// it is cancelled, asserting that the stalled model is reported and the models after it are not downloaded.
+func TestDownloadModelsTimeout(t *testing.T) {
+	models := []string{"org/embedding", "org/llm", "org/reranker"}
+
+	tests := []struct {
+		name      string
+		stalled   []string
+		opts      ModelDownloadOptions
+		want      []string
+		wantModel string
+		wantErr   string
+	}{
+		{name: "no timeout", want: models},
+		{
+			name: "per-model timeout", stalled: []string{"org/llm"}, opts: ModelDownloadOptions{Timeout: 20 * time.Millisecond},
+			want: models[:2], wantModel: "org/llm", wantErr: "model download timeout of 20ms exceeded",
+		},
+		{
+			name: "overall timeout", stalled: []string{"org/reranker"}, opts: ModelDownloadOptions{Timeout: time.Minute, TotalTimeout: 20 * time.Millisecond},
+			want: models, wantModel: "org/reranker", wantErr: "overall model download timeout of 20ms exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryCount := vars.RetryCount
+			download := downloadModel
+			t.Cleanup(func() { vars.RetryCount, downloadModel = retryCount, download })
+			vars.RetryCount = 3
+
+			var downloaded []string
+			downloadModel = func(ctx context.Context, model, targetDir string, quiet bool) error {
+				downloaded = append(downloaded, model)
+
+				if slices.Contains(tt.stalled, model) {
+					<-ctx.Done()
+
+					return errors.New("signal: terminated")
+				}
+
+				return nil
+			}
+
+			err := DownloadModels(context.Background(), models, t.TempDir(), tt.opts, nil)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("DownloadModels() error = %v", err)
+				}
+			} else {
+				var downloadErr *errdefs.ModelDownloadError
+				if !errors.As(err, &downloadErr) || downloadErr.Model != tt.wantModel {
+					t.Fatalf("DownloadModels() error = %v, want a ModelDownloadError of %s", err, tt.wantModel)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("DownloadModels() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+			}
+
+			// a cancelled download is not retried
+			if !slices.Equal(downloaded, tt.want) {
+				t.Errorf("DownloadModels() downloaded %v, want %v", downloaded, tt.want)
+			}
+		})
+	}
+}
+
+func TestListTemplatesModels(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates []string
+		want      []TemplateModel
+	}{
+		{
+			name:      "single template",
+			templates: []string{"rag"},
+			want: []TemplateModel{
+				{Model: "BAAI/bge-reranker-v2-m3", Templates: []string{"rag"}},
+				{Model: "ibm-granite/granite-3.3-8b-instruct", Templates: []string{"rag"}},
+				{Model: "ibm-granite/granite-embedding-278m-multilingual", Templates: []string{"rag"}},
+			},
+		},
+		{
+			name:      "shared models",
+			templates: []string{"rag", "rag-dev"},
+			want: []TemplateModel{
+				{Model: "BAAI/bge-reranker-v2-m3", Templates: []string{"rag", "rag-dev"}},
+				{Model: "ibm-granite/granite-3.3-8b-instruct", Templates: []string{"rag", "rag-dev"}},
+				{Model: "ibm-granite/granite-embedding-278m-multilingual", Templates: []string{"rag", "rag-dev"}},
+			},
+		},
+		{
+			name:      "template listed twice",
+			templates: []string{"rag", "rag"},
+			want: []TemplateModel{
+				{Model: "BAAI/bge-reranker-v2-m3", Templates: []string{"rag"}},
+				{Model: "ibm-granite/granite-3.3-8b-instruct", Templates: []string{"rag"}},
+				{Model: "ibm-granite/granite-embedding-278m-multilingual", Templates: []string{"rag"}},
+			},
+		},
+		{name: "no templates", want: []TemplateModel{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListTemplatesModels(tt.templates)
+			if err != nil {
+				t.Fatalf("ListTemplatesModels() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListTemplatesModels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ListTemplatesModels([]string{"rag", "chatbot"}); err == nil || !strings.Contains(err.Error(), "chatbot") {
+		t.Errorf("ListTemplatesModels() error = %v, want the unknown template reported", err)
+	}
+}
+
+// TestDownloadModelsQuiet downloads the models with a stubbed download container printing hf-like progress,
+// asserting that with --quiet-models neither the progress nor the per-model messages are shown, and that the last
+// lines of the output of a failed download are included in its error instead.
+func TestDownloadModelsQuiet(t *testing.T) {
+	models := []string{"org/embedding", "org/llm", "org/reranker"}
+
+	tests := []struct {
+		name  string
+		quiet bool
+		fail  bool
+	}{
+		{name: "verbose"},
+		{name: "quiet", quiet: true},
+		{name: "quiet failure", quiet: true, fail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryCount, command, stdout := vars.RetryCount, downloadCommand, os.Stdout
+			t.Cleanup(func() { vars.RetryCount, downloadCommand, os.Stdout = retryCount, command, stdout })
+			vars.RetryCount = 0
+
+			script := "for i in 1 2 3; do echo \"Fetching files: ${i}0%\"; done"
+			if tt.fail {
+				script += "; echo 'HTTP Error 401: Unauthorized'; exit 1"
+			}
+			downloadCommand = func(ctx context.Context, _ string, _ ...string) *exec.Cmd {
+				return exec.CommandContext(ctx, "sh", "-c", script)
+			}
+
+			dir := t.TempDir()
+			out, err := os.Create(filepath.Join(dir, "stdout"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			os.Stdout = out
+			logPath := filepath.Join(dir, "ai-services.log")
+			if err := logger.SetLogFile(logPath, 1); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(logger.CloseLogFile)
+
+			err = DownloadModels(context.Background(), models, filepath.Join(dir, "models"), ModelDownloadOptions{Quiet: tt.quiet}, nil)
+			if tt.fail {
+				if err == nil || !strings.Contains(err.Error(), "Fetching files: 30%\nHTTP Error 401: Unauthorized") {
+					t.Errorf("DownloadModels() error = %v, want the last lines of the output of the download", err)
+				}
+			} else if err != nil {
+				t.Fatalf("DownloadModels() error = %v", err)
+			}
+
+			printed, err := os.ReadFile(out.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			logged, err := os.ReadFile(logPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantProgress, wantMessages := len(models)*3, len(models)
+			if tt.quiet {
+				wantProgress, wantMessages = 0, 0
+			}
+			if got := strings.Count(string(printed), "Fetching files:"); got != wantProgress {
+				t.Errorf("printed %d progress lines, want %d:\n%s", got, wantProgress, printed)
+			}
+			if got := strings.Count(string(logged), "Downloading model "); got != wantMessages {
+				t.Errorf("logged %d per-model messages, want %d:\n%s", got, wantMessages, logged)
+			}
+		})
+	}
+}
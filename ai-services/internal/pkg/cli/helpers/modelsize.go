@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/update"
+)
+
+const (
+	// EnvHFEndpoint overrides the Hugging Face endpoint queried for the model sizes, same as for the hf cli.
+	EnvHFEndpoint     = "HF_ENDPOINT"
+	defaultHFEndpoint = "https://huggingface.co"
+	// modelSizeQueryTimeout is the time allowed to query the size of a model from the hub.
+	modelSizeQueryTimeout = 10 * time.Second
+)
+
+// ModelSizeSource is where the size of a model was taken from.
+type ModelSizeSource string
+
+const (
+	// ModelSizeSourceLocal is the size of the model already downloaded into the model directory.
+	ModelSizeSourceLocal ModelSizeSource = "local"
+	// ModelSizeSourceHub is the size of the model files listed by the Hugging Face hub.
+	ModelSizeSourceHub ModelSizeSource = "hub"
+	// ModelSizeSourceUnknown means the size could not be determined, e.g. in offline mode.
+	ModelSizeSourceUnknown ModelSizeSource = "unknown"
+)
+
+// hubModelInfo is the subset of the model info returned by the hub API.
+type hubModelInfo struct {
+	Siblings []struct {
+		Size int64 `json:"size"`
+	} `json:"siblings"`
+}
+
+// EstimateModelSize returns the estimated download size of the model in bytes along with its source.
+// The size of a model verified in modelDir is used as is, else the size of its files is queried from the hub
+// unless offline mode is set. An unknown size is returned as 0, the failure to query is logged at debug level.
+func EstimateModelSize(ctx context.Context, modelDir, model string) (int64, ModelSizeSource) {
+	if result := VerifyModel(modelDir, model); result.Status == ModelStatusOK {
+		return result.Size, ModelSizeSourceLocal
+	}
+
+	if update.Offline {
+		return 0, ModelSizeSourceUnknown
+	}
+
+	size, err := queryHubModelSize(ctx, model)
+	if err != nil {
+		logger.Infof("Unable to query the size of model %s: %v\n", model, err, logger.VerbosityLevelDebug)
+
+		return 0, ModelSizeSourceUnknown
+	}
+
+	return size, ModelSizeSourceHub
+}
+
+// queryHubModelSize sums up the size of the files of the model listed by the hub, all of them being downloaded by hf download.
+func queryHubModelSize(ctx context.Context, model string) (int64, error) {
+	endpoint := os.Getenv(EnvHFEndpoint)
+	if endpoint == "" {
+		endpoint = defaultHFEndpoint
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, modelSizeQueryTimeout)
+	defer cancel()
+
+	// the model id is of the form '<org>/<name>', hence only its parts are escaped
+	parts := strings.Split(model, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	reqURL := fmt.Sprintf("%s/api/models/%s?blobs=true", strings.TrimSuffix(endpoint, "/"), strings.Join(parts, "/"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the hub: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status from the hub: %s", resp.Status)
+	}
+
+	var info hubModelInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return 0, fmt.Errorf("failed to parse the model info: %w", err)
+	}
+
+	var size int64
+	for _, sibling := range info.Siblings {
+		size += sibling.Size
+	}
+
+	return size, nil
+}
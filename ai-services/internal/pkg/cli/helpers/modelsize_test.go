@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/update"
+)
+
+// TestEstimateModelSize asserts that the size of a verified local model is preferred, the hub is queried otherwise
+// unless in offline mode, and a failed query reports an unknown size.
+func TestEstimateModelSize(t *testing.T) {
+	const (
+		localModel = "org/local"
+		hubModel   = "org/hub"
+	)
+
+	var queried []string
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queried = append(queried, r.URL.Path)
+		if r.URL.Path != "/api/models/"+hubModel || r.URL.Query().Get("blobs") != "true" {
+			http.NotFound(w, r)
+
+			return
+		}
+		_, _ = w.Write([]byte(`{"siblings": [{"size": 100}, {"size": 23}, {}]}`))
+	}))
+	t.Cleanup(hub.Close)
+	t.Setenv(EnvHFEndpoint, hub.URL+"/")
+
+	modelDir := t.TempDir()
+	writeVerifiedModel(t, modelDir, localModel, "weights")
+	// the local size is the one of the verified model directory
+	localSize := VerifyModel(modelDir, localModel).Size
+
+	tests := []struct {
+		name       string
+		model      string
+		offline    bool
+		wantSize   int64
+		wantSource ModelSizeSource
+		wantQuery  bool
+	}{
+		{name: "local", model: localModel, wantSize: localSize, wantSource: ModelSizeSourceLocal},
+		{name: "local offline", model: localModel, offline: true, wantSize: localSize, wantSource: ModelSizeSourceLocal},
+		{name: "hub", model: hubModel, wantSize: 123, wantSource: ModelSizeSourceHub, wantQuery: true},
+		{name: "hub offline", model: hubModel, offline: true, wantSource: ModelSizeSourceUnknown},
+		{name: "hub failure", model: "org/missing", wantSource: ModelSizeSourceUnknown, wantQuery: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offline := update.Offline
+			t.Cleanup(func() { update.Offline = offline })
+			update.Offline = tt.offline
+			queried = nil
+
+			size, source := EstimateModelSize(context.Background(), modelDir, tt.model)
+			if size != tt.wantSize || source != tt.wantSource {
+				t.Errorf("EstimateModelSize() = %d, %s, want %d, %s", size, source, tt.wantSize, tt.wantSource)
+			}
+			if got := len(queried) > 0; got != tt.wantQuery {
+				t.Errorf("EstimateModelSize() queried the hub = %v (%v), want %v", got, queried, tt.wantQuery)
+			}
+		})
+	}
+}
+
+// writeVerifiedModel writes a model made of a single file along with the checksum recorded by hf download.
+func writeVerifiedModel(t *testing.T, modelDir, model, content string) {
+	t.Helper()
+
+	path := filepath.Join(modelDir, model)
+	cacheDir := filepath.Join(path, hfDownloadCacheDir)
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256([]byte(content))
+	metadata := "0123456789abcdef\n" + hex.EncodeToString(sum[:]) + "\n1700000000\n"
+	if err := os.WriteFile(filepath.Join(path, "model.safetensors"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cacheDir, "model.safetensors"+hfMetadataSuffix), []byte(metadata), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
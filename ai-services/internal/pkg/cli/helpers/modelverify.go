@@ -0,0 +1,208 @@
+package helpers
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // git identifies the blobs by their sha1, it is not used for security
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// ModelStatus is the outcome of the verification of a downloaded model.
+type ModelStatus string
+
+const (
+	// ModelStatusOK means all the files of the model match their checksums.
+	ModelStatusOK ModelStatus = "ok"
+	// ModelStatusUnverified means the model is present, but it carries no checksums, e.g. as it got copied manually.
+	ModelStatusUnverified ModelStatus = "unverified"
+	// ModelStatusMissing means the model directory does not exist or is empty.
+	ModelStatusMissing ModelStatus = "missing"
+	// ModelStatusIncomplete means the download of the model got interrupted.
+	ModelStatusIncomplete ModelStatus = "incomplete"
+	// ModelStatusCorrupt means a file of the model is missing or does not match its checksum.
+	ModelStatusCorrupt ModelStatus = "corrupt"
+)
+
+const (
+	// hfDownloadCacheDir holds the metadata hf download keeps per file of a model downloaded with --local-dir.
+	hfDownloadCacheDir = ".cache/huggingface/download"
+	hfMetadataSuffix   = ".metadata"
+	hfIncompleteSuffix = ".incomplete"
+	// the etag of a file stored in git LFS is its sha256, while the one of a regular file is its git blob sha1
+	sha256HexLength = 64
+	sha1HexLength   = 40
+	// hfMetadataEtagLine is the line of the etag in a metadata file, after the commit hash.
+	hfMetadataEtagLine = 2
+)
+
+// ModelVerification is the outcome of the verification of a model.
+type ModelVerification struct {
+	Model  string      `json:"model"`
+	Path   string      `json:"path"`
+	Status ModelStatus `json:"status"`
+	// Size is the total size of the files of the model in bytes.
+	Size   int64  `json:"size"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Failed reports whether the model cannot be used as is, i.e. it needs to be downloaded again.
+func (v ModelVerification) Failed() bool {
+	return v.Status == ModelStatusMissing || v.Status == ModelStatusIncomplete || v.Status == ModelStatusCorrupt
+}
+
+// VerifyModel verifies the model downloaded into modelDir by DownloadModel, without accessing the network.
+// Each file is checked against the checksum recorded by hf download, i.e. the sha256 of the LFS files, e.g. the weights,
+// and the git blob sha1 of the other files.
+func VerifyModel(modelDir, model string) ModelVerification {
+	result := ModelVerification{Model: model, Path: filepath.Join(modelDir, model)}
+
+	entries, err := os.ReadDir(result.Path)
+	if err != nil || len(entries) == 0 {
+		result.Status = ModelStatusMissing
+		result.Detail = "model directory does not exist or is empty"
+
+		return result
+	}
+
+	size, err := utils.DirSize(result.Path)
+	if err != nil {
+		result.Status = ModelStatusCorrupt
+		result.Detail = fmt.Sprintf("failed to read the model files: %v", err)
+
+		return result
+	}
+	result.Size = size
+
+	verified, err := verifyModelFiles(result.Path)
+	switch {
+	case errors.Is(err, errIncompleteDownload):
+		result.Status = ModelStatusIncomplete
+		result.Detail = err.Error()
+	case err != nil:
+		result.Status = ModelStatusCorrupt
+		result.Detail = err.Error()
+	case verified == 0:
+		result.Status = ModelStatusUnverified
+		result.Detail = "no checksums found, the model was not downloaded with 'ai-services application model download'"
+	default:
+		result.Status = ModelStatusOK
+		result.Detail = fmt.Sprintf("%d files verified", verified)
+	}
+
+	return result
+}
+
+var errIncompleteDownload = errors.New("incomplete download")
+
+// verifyModelFiles verifies the files of the model against the checksums recorded in the hf download cache.
+// It returns the count of the verified files.
+func verifyModelFiles(modelPath string) (int, error) {
+	cacheDir := filepath.Join(modelPath, hfDownloadCacheDir)
+	verified := 0
+
+	err := filepath.WalkDir(cacheDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		if strings.HasSuffix(path, hfIncompleteSuffix) {
+			return fmt.Errorf("%w: '%s' is partially downloaded", errIncompleteDownload, strings.TrimSuffix(d.Name(), hfIncompleteSuffix))
+		}
+
+		if !strings.HasSuffix(path, hfMetadataSuffix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(cacheDir, strings.TrimSuffix(path, hfMetadataSuffix))
+		if err != nil {
+			return err
+		}
+
+		etag, err := readEtag(path)
+		if err != nil {
+			return fmt.Errorf("failed to read the checksum of '%s': %w", rel, err)
+		}
+
+		if err := verifyFileChecksum(filepath.Join(modelPath, rel), etag); err != nil {
+			return fmt.Errorf("'%s': %w", rel, err)
+		}
+		verified++
+
+		return nil
+	})
+
+	return verified, err
+}
+
+// readEtag reads the etag of a file from its hf download metadata, made of the commit hash, the etag and the timestamp.
+func readEtag(metadataPath string) (string, error) {
+	f, err := os.Open(metadataPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if line == hfMetadataEtagLine {
+			return strings.Trim(strings.TrimSpace(scanner.Text()), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("malformed metadata file")
+}
+
+// verifyFileChecksum compares the file against the etag, if the etag is not a known checksum the file is only required to exist.
+func verifyFileChecksum(path, etag string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("file is missing: %w", err)
+	}
+
+	var h hash.Hash
+	switch len(etag) {
+	case sha256HexLength:
+		h = sha256.New()
+	case sha1HexLength:
+		h = sha1.New() //nolint:gosec // see the import
+		fmt.Fprintf(h, "blob %d\x00", info.Size())
+	default:
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to read the file: %w", err)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != etag {
+		return fmt.Errorf("checksum mismatch, expected %s, got %s", etag, sum)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// namespaceRegex matches the namespaces, which are DNS labels as on openshift.
+var namespaceRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// ValidateNamespace validates the namespace given via --namespace. The namespace qualifies the pod names and the host
+// directory of the applications as '<namespace>--<app>', hence it must not contain '--' itself.
+func ValidateNamespace(namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+
+	if !namespaceRegex.MatchString(namespace) || strings.Contains(namespace, "--") {
+		return fmt.Errorf("invalid namespace '%s': it must consist of lower case alphanumeric characters or single '-', "+
+			"and start and end with an alphanumeric character", namespace)
+	}
+
+	return nil
+}
+
+// ApplicationPodFilters returns the pod list filters for the given application within the current namespace.
+// If appName is empty, the filters select all the applications within the current namespace.
+func ApplicationPodFilters(appName string) map[string][]string {
+	labels := []string{}
+	if appName != "" {
+		labels = append(labels, fmt.Sprintf("%s=%s", constants.ApplicationAnnotationKey, appName))
+	}
+	if vars.Namespace != "" {
+		labels = append(labels, fmt.Sprintf("%s=%s", constants.NamespaceLabelKey, vars.Namespace))
+	}
+
+	filters := map[string][]string{}
+	if len(labels) > 0 {
+		filters["label"] = labels
+	}
+
+	return filters
+}
+
+// ListApplicationPods lists the pods of the given application which belong to the current namespace.
+func ListApplicationPods(r runtime.Runtime, appName string) ([]types.Pod, error) {
+	pods, err := r.ListPods(ApplicationPodFilters(appName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// label filters cannot express the absence of a label, hence drop the pods of other namespaces here
+	filtered := make([]types.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if BelongsToNamespace(pod.Labels) {
+			filtered = append(filtered, pod)
+		}
+	}
+
+	return filtered, nil
+}
+
+// BelongsToNamespace reports whether a resource with the given labels belongs to the current namespace.
+func BelongsToNamespace(labels map[string]string) bool {
+	return labels[constants.NamespaceLabelKey] == vars.Namespace
+}
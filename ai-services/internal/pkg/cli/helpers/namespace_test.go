@@ -0,0 +1,29 @@
+package helpers
+
+import "testing"
+
+func TestValidateNamespace(t *testing.T) {
+	tests := []struct {
+		namespace string
+		wantErr   bool
+	}{
+		{namespace: ""},
+		{namespace: "team"},
+		{namespace: "team-a"},
+		{namespace: "a1"},
+		{namespace: "team--a", wantErr: true},
+		{namespace: "Team", wantErr: true},
+		{namespace: "-team", wantErr: true},
+		{namespace: "team-", wantErr: true},
+		{namespace: "team_a", wantErr: true},
+		{namespace: "team/a", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.namespace, func(t *testing.T) {
+			if err := ValidateNamespace(tt.namespace); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNamespace(%q) error = %v, want error %v", tt.namespace, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// pciDevicesPath is the sysfs directory of the PCI devices, a variable so that the tests can point it to a fake sysfs.
+var pciDevicesPath = "/sys/bus/pci/devices"
+
+// SpyreCardState is the host side state of a Spyre card, as exposed by sysfs.
+type SpyreCardState struct {
+	PCIAddress string
+	// Driver is the kernel driver the card is bound to, vfio-pci for a card usable by the containers.
+	Driver string
+	// NUMANode is the NUMA node the card is attached to, -1 if the platform does not report it.
+	NUMANode string
+}
+
+// ReadSpyreCardState reads the state of the Spyre card with the given PCI address from sysfs.
+// The attributes which are not exposed are left empty, so that the callers can show the others.
+func ReadSpyreCardState(pciAddress string) SpyreCardState {
+	devicePath := filepath.Join(pciDevicesPath, canonicalPCIAddress(pciAddress))
+	state := SpyreCardState{PCIAddress: pciAddress}
+
+	if driver, err := os.Readlink(filepath.Join(devicePath, "driver")); err == nil {
+		state.Driver = filepath.Base(driver)
+	}
+
+	if numaNode, err := os.ReadFile(filepath.Join(devicePath, "numa_node")); err == nil {
+		state.NUMANode = strings.TrimSpace(string(numaNode))
+	}
+
+	return state
+}
+
+// canonicalPCIAddress returns the address in the 'domain:bus:device.function' form of sysfs,
+// as lspci leaves out the domain if it is 0000.
+func canonicalPCIAddress(pciAddress string) string {
+	if strings.Count(pciAddress, ":") == 1 {
+		return "0000:" + pciAddress
+	}
+
+	return pciAddress
+}
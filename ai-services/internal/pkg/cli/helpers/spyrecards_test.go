@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestReadSpyreCardState reads the state of the Spyre cards from a fake sysfs, the attributes which are not exposed
+// being left empty.
+func TestReadSpyreCardState(t *testing.T) {
+	sysfs := t.TempDir()
+	devicesPath := filepath.Join(sysfs, "bus", "pci", "devices")
+	driversPath := filepath.Join(sysfs, "bus", "pci", "drivers")
+
+	// addDevice adds the PCI device to the fake sysfs, bound to the driver and attached to the NUMA node if given.
+	addDevice := func(address, driver, numaNode string) {
+		devicePath := filepath.Join(devicesPath, address)
+		if err := os.MkdirAll(devicePath, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if driver != "" {
+			if err := os.MkdirAll(filepath.Join(driversPath, driver), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Symlink(filepath.Join("..", "..", "drivers", driver), filepath.Join(devicePath, "driver")); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if numaNode != "" {
+			if err := os.WriteFile(filepath.Join(devicePath, "numa_node"), []byte(numaNode+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	addDevice("0000:1a:00.0", "vfio-pci", "1")
+	addDevice("0001:2b:00.0", "", "-1")
+	addDevice("0000:3c:00.0", "", "")
+
+	orig := pciDevicesPath
+	pciDevicesPath = devicesPath
+	t.Cleanup(func() { pciDevicesPath = orig })
+
+	tests := []struct {
+		name       string
+		pciAddress string
+		want       SpyreCardState
+	}{
+		{
+			name:       "bound to vfio",
+			pciAddress: "0000:1a:00.0",
+			want:       SpyreCardState{PCIAddress: "0000:1a:00.0", Driver: "vfio-pci", NUMANode: "1"},
+		},
+		{
+			name:       "address without domain",
+			pciAddress: "1a:00.0",
+			want:       SpyreCardState{PCIAddress: "1a:00.0", Driver: "vfio-pci", NUMANode: "1"},
+		},
+		{
+			name:       "unbound without numa node",
+			pciAddress: "0001:2b:00.0",
+			want:       SpyreCardState{PCIAddress: "0001:2b:00.0", NUMANode: "-1"},
+		},
+		{
+			name:       "no attributes",
+			pciAddress: "0000:3c:00.0",
+			want:       SpyreCardState{PCIAddress: "0000:3c:00.0"},
+		},
+		{
+			name:       "missing device",
+			pciAddress: "0000:4d:00.0",
+			want:       SpyreCardState{PCIAddress: "0000:4d:00.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReadSpyreCardState(tt.pciAddress); got != tt.want {
+				t.Errorf("ReadSpyreCardState() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
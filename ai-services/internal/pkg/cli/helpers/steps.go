@@ -9,30 +9,66 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
-	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 const (
-	nextStepsMDFile = "next.md"
-	nextStepsTitle  = "Next Steps"
+	nextStepsTitle = "Next Steps"
 
 	infoMDFile = "info.md"
 	infoTitle  = "Info"
 )
 
+// stepParams returns the params the steps of the application are rendered with, the pod names and the host directory
+// of the application are qualified by the current namespace, refer vars.QualifiedAppName.
+func stepParams(app string) map[string]string {
+	return map[string]string{
+		"AppName":   app,
+		"PodPrefix": vars.QualifiedAppName(app),
+		"AppDir":    vars.ApplicationDir(app),
+	}
+}
+
+// PrintNextSteps prints the next steps of the application, the same ones emitted by 'application create -o json'.
 func PrintNextSteps(runtime runtime.Runtime, app, appTemplate string) error {
-	params := map[string]string{"AppName": app}
-	if err := renderStepsMarkdown(runtime, appTemplate, params, nextStepsMDFile, nextStepsTitle); err != nil {
+	steps, err := NextSteps(runtime, app, appTemplate)
+	if err != nil {
 		logger.Infof("Unable to load steps: %v\n", err)
 
 		return nil
 	}
 
+	if len(steps) == 0 {
+		return nil
+	}
+
+	logger.Infoln(nextStepsTitle + ":")
+	logger.Infoln("-------")
+	logger.Infoln(formatNextSteps(steps))
+
 	return nil
 }
 
+// formatNextSteps formats the next steps as a list for the human readable output,
+// each step followed by its command or suffixed by its URL.
+func formatNextSteps(steps []templates.NextStep) string {
+	items := make([]string, 0, len(steps))
+	for _, step := range steps {
+		item := "- " + step.Description
+		if step.URL != "" {
+			item += ": " + step.URL
+		}
+		if step.Command != "" {
+			item += "\n`" + step.Command + "`"
+		}
+		items = append(items, item)
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
 func PrintInfo(runtime runtime.Runtime, app, appTemplate string) error {
-	params := map[string]string{"AppName": app}
+	params := stepParams(app)
 	if err := renderStepsMarkdown(runtime, appTemplate, params, infoMDFile, infoTitle); err != nil {
 		logger.Infof("Unable to load steps: %v\n", err)
 
@@ -48,7 +84,7 @@ func populateHostValues(runtime runtime.Runtime, params map[string]string, varsD
 		switch host.Type {
 		case "ip":
 			// get the host IP
-			hostIP, err := utils.GetHostIP()
+			hostIP, err := DetectHostIP()
 			if err != nil {
 				return fmt.Errorf("unable to fetch the host IP: %w", err)
 			}
@@ -167,20 +203,39 @@ func fetchDataSpecificInfo(data any, format string, defaultValue *string) (strin
 }
 
 func renderStepsMarkdown(runtime runtime.Runtime, appTemplate string, params map[string]string, mdFile, title string) error {
+	rendered, err := renderMarkdown(runtime, appTemplate, params, mdFile)
+	if err != nil || rendered == "" {
+		return err
+	}
+
+	logger.Infoln(title + ":")
+	logger.Infoln("-------")
+	logger.Infoln(rendered)
+
+	return nil
+}
+
+// RenderInfo returns the rendered info.md of the application, empty if the template does not provide one.
+func RenderInfo(runtime runtime.Runtime, app, appTemplate string) (string, error) {
+	return renderMarkdown(runtime, appTemplate, stepParams(app), infoMDFile)
+}
+
+// NextSteps returns the next steps of the application in a machine-readable form, empty if the template does not provide them.
+func NextSteps(runtime runtime.Runtime, app, appTemplate string) ([]templates.NextStep, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
 		Runtime: runtime.Type(),
 	})
 
-	tmpls, err := tp.LoadMdFiles(appTemplate)
-	if err != nil {
-		return nil
+	params := stepParams(app)
+	if err := populateParams(runtime, tp, appTemplate, params); err != nil {
+		return nil, err
 	}
 
-	tmpl, ok := tmpls[mdFile]
-	if !ok {
-		return nil
-	}
+	return tp.LoadNextSteps(appTemplate, params)
+}
 
+// populateParams populates the params with the values set in the vars file of the template.
+func populateParams(runtime runtime.Runtime, tp templates.Template, appTemplate string, params map[string]string) error {
 	varsData, err := tp.LoadVarsFile(appTemplate, params)
 	if err != nil {
 		return fmt.Errorf("failed to load vars file: %w", err)
@@ -201,14 +256,37 @@ func renderStepsMarkdown(runtime runtime.Runtime, appTemplate string, params map
 		return fmt.Errorf("failed to populate container values: %w", err)
 	}
 
+	// populate the URLs of the services declared in the app metadata
+	if err := populateServiceURLs(runtime, params, appTemplate); err != nil {
+		return fmt.Errorf("failed to populate service URLs: %w", err)
+	}
+
+	return nil
+}
+
+func renderMarkdown(runtime runtime.Runtime, appTemplate string, params map[string]string, mdFile string) (string, error) {
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
+		Runtime: runtime.Type(),
+	})
+
+	tmpls, err := tp.LoadMdFiles(appTemplate)
+	if err != nil {
+		return "", nil
+	}
+
+	tmpl, ok := tmpls[mdFile]
+	if !ok {
+		return "", nil
+	}
+
+	if err := populateParams(runtime, tp, appTemplate, params); err != nil {
+		return "", err
+	}
+
 	var rendered bytes.Buffer
 	if err := tmpl.Execute(&rendered, params); err != nil {
-		return fmt.Errorf("failed to execute info.md: %w", err)
+		return "", fmt.Errorf("failed to execute %s: %w", mdFile, err)
 	}
 
-	logger.Infoln(title + ":")
-	logger.Infoln("-------")
-	logger.Infoln(rendered.String())
-
-	return nil
+	return rendered.String(), nil
 }
@@ -0,0 +1,43 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+)
+
+func TestFormatNextSteps(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []templates.NextStep
+		want  string
+	}{
+		{name: "no steps", want: ""},
+		{
+			name:  "command",
+			steps: []templates.NextStep{{Description: "Start the ingestion", Command: "ai-services application start app --pod=app--ingest-docs"}},
+			want:  "- Start the ingestion\n`ai-services application start app --pod=app--ingest-docs`",
+		},
+		{
+			name:  "url",
+			steps: []templates.NextStep{{Description: "Chatbot UI", URL: "http://10.0.0.1:3000"}},
+			want:  "- Chatbot UI: http://10.0.0.1:3000",
+		},
+		{
+			name: "steps are separated by a blank line",
+			steps: []templates.NextStep{
+				{Description: "Move the documents", Command: "mv <documents> /docs/"},
+				{Description: "Chatbot UI", URL: "http://10.0.0.1:3000"},
+			},
+			want: "- Move the documents\n`mv <documents> /docs/`\n\n- Chatbot UI: http://10.0.0.1:3000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatNextSteps(tt.steps); got != tt.want {
+				t.Errorf("formatNextSteps() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
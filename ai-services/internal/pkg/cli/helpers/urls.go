@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// ResolvePrimaryURLs resolves the URLs of the services declared in the metadata of the application template,
+// from the live port bindings of their pods on podman, or from their routes on openshift.
+// It is shared by create and info, so that both always print the same URLs.
+func ResolvePrimaryURLs(runtime runtime.Runtime, app, appTemplate string) ([]templates.ServiceURL, error) {
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	appMetadata, err := tp.LoadMetadata(appTemplate, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the app metadata: %w", err)
+	}
+
+	if len(appMetadata.Services) == 0 {
+		return nil, nil
+	}
+
+	if runtime.Type() == types.RuntimeTypeOpenShift {
+		return resolveRouteURLs(runtime, appMetadata.Services)
+	}
+
+	return resolvePortURLs(runtime, app, appMetadata.Services)
+}
+
+func resolvePortURLs(runtime runtime.Runtime, app string, services []templates.ServiceMetadata) ([]templates.ServiceURL, error) {
+	hostIP, err := DetectHostIP()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the host IP: %w", err)
+	}
+
+	// several services can be exposed by the same pod, hence inspect each pod only once
+	pods := map[string]*types.Pod{}
+	urls := make([]templates.ServiceURL, 0, len(services))
+
+	for _, service := range services {
+		serviceURL := templates.ServiceURL{Name: service.Name, Description: service.Description}
+
+		// pods are named as '<qualified app name>--<pod>' in the templates
+		podName := fmt.Sprintf("%s--%s", vars.QualifiedAppName(app), service.Pod)
+		pod, ok := pods[podName]
+		if !ok {
+			pod, err = inspectPodIfExists(runtime, podName)
+			if err != nil {
+				return nil, err
+			}
+			pods[podName] = pod
+		}
+
+		if pod != nil && hostIP != "" {
+			if hostPorts := pod.Ports[fmt.Sprintf("%d/tcp", service.Port)]; len(hostPorts) > 0 {
+				serviceURL.URL = fmt.Sprintf("http://%s:%s", hostIP, hostPorts[0])
+				serviceURL.Available = strings.EqualFold(pod.State, "running")
+			}
+		}
+
+		urls = append(urls, serviceURL)
+	}
+
+	return urls, nil
+}
+
+func resolveRouteURLs(runtime runtime.Runtime, services []templates.ServiceMetadata) ([]templates.ServiceURL, error) {
+	routes, err := runtime.ListRoutes()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the routes: %w", err)
+	}
+
+	urls := make([]templates.ServiceURL, 0, len(services))
+	for _, service := range services {
+		serviceURL := templates.ServiceURL{Name: service.Name, Description: service.Description}
+		for _, route := range routes {
+			if route.Name == service.Route {
+				serviceURL.URL = "http://" + route.HostPort
+				serviceURL.Available = true
+
+				break
+			}
+		}
+
+		urls = append(urls, serviceURL)
+	}
+
+	return urls, nil
+}
+
+// inspectPodIfExists inspects the pod, nil is returned if it does not exist.
+func inspectPodIfExists(runtime runtime.Runtime, podName string) (*types.Pod, error) {
+	exists, err := runtime.PodExists(podName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if pod exists: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	pod, err := runtime.InspectPod(podName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect Pod '%s': %w", podName, err)
+	}
+
+	return pod, nil
+}
+
+// populateServiceURLs populates the URL of each service declared in the app metadata within the params,
+// e.g. the URL of the 'ui' service as UI_URL. The URL is empty if the port of the service is not published.
+func populateServiceURLs(runtime runtime.Runtime, params map[string]string, appTemplate string) error {
+	urls, err := ResolvePrimaryURLs(runtime, params["AppName"], appTemplate)
+	if err != nil {
+		return err
+	}
+
+	for _, serviceURL := range urls {
+		params[strings.ToUpper(serviceURL.Name)+"_URL"] = serviceURL.URL
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestResolvePrimaryURLs(t *testing.T) {
+	advertiseIP := vars.AdvertiseIP
+	vars.AdvertiseIP = "10.0.0.5"
+	t.Cleanup(func() { vars.AdvertiseIP = advertiseIP })
+
+	ui := templates.ServiceURL{Name: "ui", Description: "Chatbot UI"}
+	backend := templates.ServiceURL{Name: "backend", Description: "Chatbot Backend"}
+	withURL := func(u templates.ServiceURL, url string, available bool) templates.ServiceURL {
+		u.URL, u.Available = url, available
+
+		return u
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		runtime  types.RuntimeType
+		pod      *types.Pod
+		routes   []types.Route
+		want     []templates.ServiceURL
+	}{
+		{
+			name: "default ports", template: "rag",
+			pod:  &types.Pod{State: "Running", Ports: map[string][]string{"3000/tcp": {"3000"}, "5000/tcp": {"5000"}}},
+			want: []templates.ServiceURL{withURL(ui, "http://10.0.0.5:3000", true), withURL(backend, "http://10.0.0.5:5000", true)},
+		},
+		{
+			name: "custom ports", template: "rag",
+			pod:  &types.Pod{State: "Running", Ports: map[string][]string{"3000/tcp": {"8080"}, "5000/tcp": {"8081"}}},
+			want: []templates.ServiceURL{withURL(ui, "http://10.0.0.5:8080", true), withURL(backend, "http://10.0.0.5:8081", true)},
+		},
+		{
+			name: "pod stopped", template: "rag",
+			pod:  &types.Pod{State: "Exited", Ports: map[string][]string{"3000/tcp": {"8080"}, "5000/tcp": {"8081"}}},
+			want: []templates.ServiceURL{withURL(ui, "http://10.0.0.5:8080", false), withURL(backend, "http://10.0.0.5:8081", false)},
+		},
+		{
+			name: "port not published", template: "rag",
+			pod:  &types.Pod{State: "Running", Ports: map[string][]string{"3000/tcp": {"8080"}}},
+			want: []templates.ServiceURL{withURL(ui, "http://10.0.0.5:8080", true), backend},
+		},
+		{
+			name: "pod missing", template: "rag",
+			want: []templates.ServiceURL{ui, backend},
+		},
+		{
+			name: "openshift routes", template: "rag-dev", runtime: types.RuntimeTypeOpenShift,
+			routes: []types.Route{{Name: "ui", HostPort: "ui-app.apps.example.com"}},
+			want:   []templates.ServiceURL{withURL(ui, "http://ui-app.apps.example.com", true), backend},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			if tt.runtime != "" {
+				r.RuntimeType = tt.runtime
+			}
+			r.Routes = tt.routes
+			if tt.pod != nil {
+				pod := *tt.pod
+				pod.ID, pod.Name = "chat-bot-id", "app--chat-bot"
+				r.AddPod(pod)
+			}
+
+			got, err := ResolvePrimaryURLs(r, "app", tt.template)
+			if err != nil {
+				t.Fatalf("ResolvePrimaryURLs() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolvePrimaryURLs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
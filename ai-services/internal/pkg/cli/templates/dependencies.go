@@ -0,0 +1,96 @@
+package templates
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ValidateDependencies verifies that the layers of podTemplateExecutions satisfy the dependencies declared via dependsOn,
+// i.e. each pod template is executed in a later layer than all the pod templates it depends on.
+// The pods of a layer are deployed in parallel, hence a dependency within the same layer is a violation as well.
+func ValidateDependencies(appMetadata *AppMetadata) error {
+	if len(appMetadata.DependsOn) == 0 {
+		return nil
+	}
+
+	layerOf := map[string]int{}
+	for i, layer := range appMetadata.PodTemplateExecutions {
+		for _, podTemplate := range layer {
+			layerOf[podTemplate] = i
+		}
+	}
+
+	for _, podTemplate := range slices.Sorted(maps.Keys(appMetadata.DependsOn)) {
+		if _, ok := layerOf[podTemplate]; !ok {
+			return fmt.Errorf("dependsOn refers to '%s' which is not specified in podTemplateExecutions", podTemplate)
+		}
+
+		for _, dependency := range appMetadata.DependsOn[podTemplate] {
+			if _, ok := layerOf[dependency]; !ok {
+				return fmt.Errorf("'%s' depends on '%s' which is not specified in podTemplateExecutions", podTemplate, dependency)
+			}
+		}
+	}
+
+	if cycle := findDependencyCycle(appMetadata.DependsOn); len(cycle) > 0 {
+		return fmt.Errorf("dependsOn has a cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	for _, podTemplate := range slices.Sorted(maps.Keys(appMetadata.DependsOn)) {
+		for _, dependency := range appMetadata.DependsOn[podTemplate] {
+			if layerOf[dependency] >= layerOf[podTemplate] {
+				return fmt.Errorf("'%s' in layer %d depends on '%s' in layer %d, it must be moved to a later layer than its dependencies",
+					podTemplate, layerOf[podTemplate]+1, dependency, layerOf[dependency]+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findDependencyCycle returns the pod templates forming a cycle in the dependency graph, if any,
+// with the first pod template repeated at the end.
+func findDependencyCycle(dependsOn map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	var path []string
+
+	var visit func(podTemplate string) []string
+	visit = func(podTemplate string) []string {
+		switch state[podTemplate] {
+		case visited:
+			return nil
+		case visiting:
+			start := slices.Index(path, podTemplate)
+
+			return append(slices.Clone(path[start:]), podTemplate)
+		}
+
+		state[podTemplate] = visiting
+		path = append(path, podTemplate)
+		for _, dependency := range dependsOn[podTemplate] {
+			if cycle := visit(dependency); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[podTemplate] = visited
+
+		return nil
+	}
+
+	for _, podTemplate := range slices.Sorted(maps.Keys(dependsOn)) {
+		if cycle := visit(podTemplate); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
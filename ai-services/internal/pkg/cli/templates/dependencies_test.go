@@ -0,0 +1,103 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestValidateDependencies asserts that the layers of podTemplateExecutions are verified against the declared dependsOn,
+// for valid, misordered and cyclic dependency graphs.
+func TestValidateDependencies(t *testing.T) {
+	layers := [][]string{
+		{"opensearch.yaml.tmpl", "vllm-server.yaml.tmpl"},
+		{"clean-docs.yaml.tmpl"},
+		{"ingest-docs.yaml.tmpl", "chat-bot.yaml.tmpl"},
+	}
+
+	tests := []struct {
+		name      string
+		layers    [][]string
+		dependsOn map[string][]string
+		wantErr   string
+	}{
+		{
+			name:   "no dependencies",
+			layers: layers,
+		},
+		{
+			name:   "valid",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"clean-docs.yaml.tmpl":  {"opensearch.yaml.tmpl"},
+				"ingest-docs.yaml.tmpl": {"opensearch.yaml.tmpl", "vllm-server.yaml.tmpl"},
+				"chat-bot.yaml.tmpl":    {"clean-docs.yaml.tmpl"},
+			},
+		},
+		{
+			name:   "misordered",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"opensearch.yaml.tmpl": {"chat-bot.yaml.tmpl"},
+			},
+			wantErr: "'opensearch.yaml.tmpl' in layer 1 depends on 'chat-bot.yaml.tmpl' in layer 3",
+		},
+		{
+			name:   "same layer",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"chat-bot.yaml.tmpl": {"ingest-docs.yaml.tmpl"},
+			},
+			wantErr: "'chat-bot.yaml.tmpl' in layer 3 depends on 'ingest-docs.yaml.tmpl' in layer 3",
+		},
+		{
+			name:   "cycle",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"opensearch.yaml.tmpl": {"chat-bot.yaml.tmpl"},
+				"chat-bot.yaml.tmpl":   {"clean-docs.yaml.tmpl"},
+				"clean-docs.yaml.tmpl": {"opensearch.yaml.tmpl"},
+			},
+			wantErr: "dependsOn has a cycle: chat-bot.yaml.tmpl -> clean-docs.yaml.tmpl -> opensearch.yaml.tmpl -> chat-bot.yaml.tmpl",
+		},
+		{
+			name:   "self dependency",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"chat-bot.yaml.tmpl": {"chat-bot.yaml.tmpl"},
+			},
+			wantErr: "dependsOn has a cycle: chat-bot.yaml.tmpl -> chat-bot.yaml.tmpl",
+		},
+		{
+			name:   "unknown pod template",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"ui.yaml.tmpl": {"opensearch.yaml.tmpl"},
+			},
+			wantErr: "dependsOn refers to 'ui.yaml.tmpl' which is not specified in podTemplateExecutions",
+		},
+		{
+			name:   "unknown dependency",
+			layers: layers,
+			dependsOn: map[string][]string{
+				"chat-bot.yaml.tmpl": {"backend.yaml.tmpl"},
+			},
+			wantErr: "'chat-bot.yaml.tmpl' depends on 'backend.yaml.tmpl' which is not specified in podTemplateExecutions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDependencies(&AppMetadata{PodTemplateExecutions: tt.layers, DependsOn: tt.dependsOn})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateDependencies() error = %v, want nil", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateDependencies() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
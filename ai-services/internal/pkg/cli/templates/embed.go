@@ -16,6 +16,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 
 	"go.yaml.in/yaml/v3"
 	"helm.sh/helm/v4/pkg/chart"
@@ -162,8 +163,11 @@ func (e *embedTemplateProvider) LoadPodTemplateWithValues(app, file, appName str
 	params := map[string]any{
 		"Values":          values,
 		"AppName":         appName,
+		"PodPrefix":       vars.QualifiedAppName(appName),
+		"AppDir":          vars.ApplicationDir(appName),
 		"AppTemplateName": "",
 		"Version":         "",
+		"hostPaths":       map[string]string{},
 	}
 
 	return e.LoadPodTemplate(app, file, params)
@@ -181,19 +185,13 @@ func (e *embedTemplateProvider) LoadValues(app string, valuesFileOverrides []str
 		return nil, fmt.Errorf("failed to parse values.yaml: %w", err)
 	}
 
-	// Load user provided file overrides
+	// Load user provided file overrides, the undeclared keys are reported by the create command, refer UndeclaredValues
 	for _, overridePath := range valuesFileOverrides {
-		overrideData, err := os.ReadFile(overridePath)
+		overrideValues, err := ReadValuesFile(overridePath)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read override file %s: %w", overridePath, err)
-		}
-		overrideValues := map[string]interface{}{}
-		if err := yaml.Unmarshal(overrideData, &overrideValues); err != nil {
-			return nil, fmt.Errorf("failed to parse override file %s: %w", overridePath, err)
-		}
-		for key, val := range overrideValues {
-			utils.SetNestedValue(values, key, val)
+			return nil, err
 		}
+		utils.MergeValues(values, overrideValues)
 	}
 
 	// validate CLI Overrides before applying since we are adding them directly
@@ -209,6 +207,43 @@ func (e *embedTemplateProvider) LoadValues(app string, valuesFileOverrides []str
 	return values, nil
 }
 
+// ReadValuesFile reads a values file, either YAML or JSON.
+func ReadValuesFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read override file %s: %w", path, err)
+	}
+
+	values := map[string]any{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse override file %s: %w", path, err)
+	}
+
+	return values, nil
+}
+
+// UndeclaredValues returns the keys set by each of the values files, which are not declared by the values of the template
+// and hence not used by it, e.g. due to a typo. The files are merged in order, same as by LoadValues.
+func UndeclaredValues(tp Template, app string, valuesFiles []string) (map[string][]string, error) {
+	values, err := tp.LoadValues(app, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	undeclared := map[string][]string{}
+	for _, valuesFile := range valuesFiles {
+		overrides, err := ReadValuesFile(valuesFile)
+		if err != nil {
+			return nil, err
+		}
+		if keys := utils.MergeValues(values, overrides); len(keys) > 0 {
+			undeclared[valuesFile] = keys
+		}
+	}
+
+	return undeclared, nil
+}
+
 // LoadMetadata loads the metadata for a given application template.
 // if runtime is empty then it loads the app Metadata.
 // if set it loads the runtime specific metadata.
@@ -225,6 +260,10 @@ func (e *embedTemplateProvider) LoadMetadata(app string, isRuntime bool) (*AppMe
 		return nil, fmt.Errorf("read metadata: %w", err)
 	}
 
+	if err := ValidateMetadata(data); err != nil {
+		return nil, fmt.Errorf("invalid metadata %s: %w", p, err)
+	}
+
 	var appMetadata AppMetadata
 	if err := yaml.Unmarshal(data, &appMetadata); err != nil {
 		return nil, err
@@ -288,6 +327,35 @@ func (e *embedTemplateProvider) LoadVarsFile(app string, params map[string]strin
 	return &vars, nil
 }
 
+func (e *embedTemplateProvider) LoadNextSteps(app string, params map[string]string) ([]NextStep, error) {
+	path := fmt.Sprintf("%s/%s/%s/steps/next_steps.yaml", e.root, app, e.Runtime())
+
+	data, err := e.fs.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("read next steps: %w", err)
+	}
+
+	var rendered bytes.Buffer
+	tmpl, err := template.New("nextStepsTemplate").Option("missingkey=zero").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s: %w", path, err)
+	}
+	if err := tmpl.Execute(&rendered, params); err != nil {
+		return nil, fmt.Errorf("failed to execute template %s: %w", path, err)
+	}
+
+	var steps []NextStep
+	if err := yaml.Unmarshal(rendered.Bytes(), &steps); err != nil {
+		return nil, fmt.Errorf("unable to read YAML as next steps: %w", err)
+	}
+
+	return steps, nil
+}
+
 func (e *embedTemplateProvider) LoadChart(app string) (chart.Charter, error) {
 	if e.Runtime() != string(types.RuntimeTypeOpenShift) {
 		return nil, errors.New("unsupported runtime type")
@@ -0,0 +1,189 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestLoadPodTemplateNamespace asserts that the pods and the host directory of an application are qualified by its
+// namespace, so that applications of the same name in different namespaces do not collide on the host.
+func TestLoadPodTemplateNamespace(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespace    string
+		wantPod      string
+		wantHostPath string
+	}{
+		{
+			name:         "default namespace",
+			wantPod:      "app--opensearch",
+			wantHostPath: "/var/lib/ai-services/applications/app/volumes/opensearch",
+		},
+		{
+			name:         "namespace",
+			namespace:    "team",
+			wantPod:      "team--app--opensearch",
+			wantHostPath: "/var/lib/ai-services/applications/team--app/volumes/opensearch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := vars.Namespace
+			vars.Namespace = tt.namespace
+			t.Cleanup(func() { vars.Namespace = orig })
+
+			tp := NewEmbedTemplateProvider(EmbedOptions{})
+			podSpec, err := tp.LoadPodTemplateWithValues("rag", "opensearch.yaml.tmpl", "app", nil, nil)
+			if err != nil {
+				t.Fatalf("LoadPodTemplateWithValues() error = %v", err)
+			}
+
+			if podSpec.Name != tt.wantPod {
+				t.Errorf("pod name = %q, want %q", podSpec.Name, tt.wantPod)
+			}
+			if got := podSpec.Labels["ai-services.io/application"]; got != "app" {
+				t.Errorf("application label = %q, want the plain application name", got)
+			}
+			if len(podSpec.Spec.Volumes) == 0 || podSpec.Spec.Volumes[0].HostPath == nil {
+				t.Fatalf("pod volumes = %+v, want the opensearch host path", podSpec.Spec.Volumes)
+			}
+			if got := podSpec.Spec.Volumes[0].HostPath.Path; got != tt.wantHostPath {
+				t.Errorf("host path = %q, want %q", got, tt.wantHostPath)
+			}
+		})
+	}
+}
+
+func writeValuesFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// TestLoadValuesPrecedence asserts that the values files override the template defaults in order,
+// and the --params overrides take precedence over all of them.
+func TestLoadValuesPrecedence(t *testing.T) {
+	first := writeValuesFile(t, "ui:\n  port: \"3000\"\nbackend:\n  port: \"5000\"\nopensearch:\n  memoryLimit: 8Gi\n")
+	second := writeValuesFile(t, `{"ui": {"port": "3001"}, "backend.port": "5001"}`)
+
+	tests := []struct {
+		name         string
+		valuesFiles  []string
+		params       map[string]string
+		wantUIPort   string
+		wantBackend  string
+		wantMemory   string
+		wantLogLevel string
+	}{
+		{name: "template defaults", wantUIPort: "", wantBackend: "0", wantMemory: "4Gi"},
+		{name: "values file", valuesFiles: []string{first}, wantUIPort: "3000", wantBackend: "5000", wantMemory: "8Gi"},
+		{name: "later values file wins", valuesFiles: []string{first, second}, wantUIPort: "3001", wantBackend: "5001", wantMemory: "8Gi"},
+		{
+			name: "params win over values files", valuesFiles: []string{first, second}, params: map[string]string{"ui.port": "3002"},
+			wantUIPort: "3002", wantBackend: "5001", wantMemory: "8Gi",
+		},
+		{name: "params without values files", params: map[string]string{"backend.port": "5002"}, wantUIPort: "", wantBackend: "5002", wantMemory: "4Gi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := NewEmbedTemplateProvider(EmbedOptions{}).LoadValues("rag", tt.valuesFiles, tt.params)
+			if err != nil {
+				t.Fatalf("LoadValues() error = %v", err)
+			}
+
+			ui, _ := values["ui"].(map[string]any)
+			backend, _ := values["backend"].(map[string]any)
+			opensearch, _ := values["opensearch"].(map[string]any)
+			if ui["port"] != tt.wantUIPort || backend["port"] != tt.wantBackend || opensearch["memoryLimit"] != tt.wantMemory {
+				t.Errorf("LoadValues() ui.port = %v, backend.port = %v, opensearch.memoryLimit = %v, want %v, %v, %v",
+					ui["port"], backend["port"], opensearch["memoryLimit"], tt.wantUIPort, tt.wantBackend, tt.wantMemory)
+			}
+			// the siblings of the overridden values are retained
+			if backend["log_level"] != "INFO" {
+				t.Errorf("LoadValues() backend.log_level = %v, want the default INFO", backend["log_level"])
+			}
+		})
+	}
+}
+
+func TestLoadValuesUndeclared(t *testing.T) {
+	valuesFile := writeValuesFile(t, "ui:\n  prot: \"3000\"\nbackend:\n  port: \"5000\"\n")
+	tp := NewEmbedTemplateProvider(EmbedOptions{})
+
+	// the undeclared keys of the values files are tolerated, while unknown --params are rejected
+	if _, err := tp.LoadValues("rag", []string{valuesFile}, nil); err != nil {
+		t.Errorf("LoadValues() error = %v, want the undeclared keys of the values file tolerated", err)
+	}
+	if _, err := tp.LoadValues("rag", nil, map[string]string{"ui.prot": "3000"}); err == nil {
+		t.Error("LoadValues() error = nil, want the unknown param rejected")
+	}
+
+	undeclared, err := UndeclaredValues(tp, "rag", []string{valuesFile})
+	if err != nil {
+		t.Fatalf("UndeclaredValues() error = %v", err)
+	}
+	if got := undeclared[valuesFile]; len(got) != 1 || got[0] != "ui.prot" {
+		t.Errorf("UndeclaredValues() = %v, want [ui.prot]", got)
+	}
+}
+
+func TestLoadNextSteps(t *testing.T) {
+	params := map[string]string{
+		"AppName":     "app",
+		"PodPrefix":   "team-a--app",
+		"AppDir":      "/var/lib/ai-services/applications/team-a--app",
+		"UI_URL":      "http://10.0.0.1:3000",
+		"BACKEND_URL": "",
+	}
+
+	tests := []struct {
+		app         string
+		runtime     types.RuntimeType
+		wantCommand string
+		wantURL     string
+	}{
+		{app: "rag", runtime: types.RuntimeTypePodman, wantCommand: "ai-services application start app --pod=team-a--app--ingest-docs", wantURL: "http://10.0.0.1:3000"},
+		{app: "rag-dev", runtime: types.RuntimeTypePodman, wantCommand: "mv <documents> /var/lib/ai-services/applications/team-a--app/docs/", wantURL: "http://10.0.0.1:3000"},
+		{app: "rag-dev", runtime: types.RuntimeTypeOpenShift, wantURL: "http://10.0.0.1:3000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.app+"/"+string(tt.runtime), func(t *testing.T) {
+			steps, err := NewEmbedTemplateProvider(EmbedOptions{Runtime: tt.runtime}).LoadNextSteps(tt.app, params)
+			if err != nil {
+				t.Fatalf("LoadNextSteps() error = %v", err)
+			}
+
+			var commands, urls []string
+			for _, step := range steps {
+				if step.Description == "" {
+					t.Errorf("LoadNextSteps() step %+v has no description", step)
+				}
+				if step.Command != "" {
+					commands = append(commands, step.Command)
+				}
+				if step.URL != "" {
+					urls = append(urls, step.URL)
+				}
+			}
+			if tt.wantCommand != "" && !slices.Contains(commands, tt.wantCommand) {
+				t.Errorf("LoadNextSteps() commands = %v, want %q", commands, tt.wantCommand)
+			}
+			if !slices.Contains(urls, tt.wantURL) {
+				t.Errorf("LoadNextSteps() urls = %v, want %q", urls, tt.wantURL)
+			}
+		})
+	}
+}
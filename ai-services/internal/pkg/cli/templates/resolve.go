@@ -0,0 +1,87 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// maxSuggestionDistance is the max edit distance for a template name to be suggested on a mistyped name.
+const maxSuggestionDistance = 2
+
+// ErrTemplateNotFound is returned when the application template does not exist.
+var ErrTemplateNotFound = errors.New("application template does not exist")
+
+// ResolveTemplate resolves the given name to the canonical application template name, hidden templates included.
+// The name is matched exactly first and then case-insensitively, so 'RAG', 'Rag' and 'rag' all resolve to 'rag'.
+// The canonical name (as defined by the template directory and its metadata) must be used for everything
+// beyond resolution, i.e. loading the template, labelling the pods and displaying it in the output.
+// If no template matches, the returned error lists the available templates and suggests the closest one, if any.
+func ResolveTemplate(tp Template, name string) (string, error) {
+	appTemplateNames, err := tp.ListApplications(true)
+	if err != nil {
+		return "", fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	if slices.Contains(appTemplateNames, name) {
+		return name, nil
+	}
+
+	for _, appTemplateName := range appTemplateNames {
+		if strings.EqualFold(appTemplateName, name) {
+			return appTemplateName, nil
+		}
+	}
+
+	// only the visible templates are listed and suggested
+	visibleTemplateNames, err := tp.ListApplications(false)
+	if err != nil {
+		return "", fmt.Errorf("failed to list templates: %w", err)
+	}
+	slices.Sort(visibleTemplateNames)
+
+	msg := fmt.Sprintf("'%s'", name)
+	if suggestion := closestName(name, visibleTemplateNames); suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean '%s'?)", suggestion)
+	}
+
+	return "", fmt.Errorf("%w: %s, available templates: %s", ErrTemplateNotFound, msg, strings.Join(visibleTemplateNames, ", "))
+}
+
+// closestName returns the name nearest to the given one within the max suggestion distance, empty if none.
+func closestName(name string, names []string) string {
+	closest, closestDistance := "", maxSuggestionDistance+1
+	for _, n := range names {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(n)); d < closestDistance {
+			closest, closestDistance = n, d
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,124 @@
+package templates
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolveTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErrs []string
+	}{
+		{name: "exact", template: "rag", want: "rag"},
+		{name: "case insensitive", template: "RAG", want: "rag"},
+		{name: "hidden", template: "rag-dev", want: "rag-dev"},
+		{
+			name:     "mistyped",
+			template: "rga",
+			wantErrs: []string{"'rga'", "did you mean 'rag'?", "available templates: rag"},
+		},
+		{
+			name:     "unknown",
+			template: "chatbot",
+			wantErrs: []string{"'chatbot', available templates: rag"},
+		},
+	}
+
+	tp := NewEmbedTemplateProvider(EmbedOptions{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveTemplate(tp, tt.template)
+			if len(tt.wantErrs) == 0 {
+				if err != nil || got != tt.want {
+					t.Errorf("ResolveTemplate() = %q, %v, want %q", got, err, tt.want)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrTemplateNotFound) {
+				t.Fatalf("ResolveTemplate() error = %v, want %v", err, ErrTemplateNotFound)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("ResolveTemplate() error = %q, want it to contain %q", err, want)
+				}
+			}
+			// hidden templates are neither listed nor suggested
+			if strings.Contains(err.Error(), "rag-dev") {
+				t.Errorf("ResolveTemplate() error = %q, want the hidden templates omitted", err)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "rag", b: "rag", want: 0},
+		{a: "rag", b: "rga", want: 2},
+		{a: "rag", b: "rags", want: 1},
+		{a: "", b: "rag", want: 3},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClosestName(t *testing.T) {
+	names := []string{"rag", "summarize", "translate"}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "rab", want: "rag"},
+		{name: "RGA", want: "rag"},
+		{name: "sumarize", want: "summarize"},
+		{name: "translation", want: ""},
+		{name: "chatbot", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := closestName(tt.name, names); got != tt.want {
+				t.Errorf("closestName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResolveTemplateCase asserts that the template name resolves case-insensitively to the canonical name of the
+// template directory and metadata, so that 'RAG', 'rag' and 'Rag' deploy the same template and display it alike.
+func TestResolveTemplateCase(t *testing.T) {
+	tp := NewEmbedTemplateProvider(EmbedOptions{})
+	for _, name := range []string{"rag", "RAG", "Rag"} {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveTemplate(tp, name)
+			if err != nil {
+				t.Fatalf("ResolveTemplate() error = %v", err)
+			}
+			if got != "rag" {
+				t.Errorf("ResolveTemplate() = %q, want %q", got, "rag")
+			}
+
+			md, err := tp.LoadMetadata(got, false)
+			if err != nil {
+				t.Fatalf("LoadMetadata() error = %v", err)
+			}
+			if md.Name != got {
+				t.Errorf("metadata name = %q, want the canonical name %q", md.Name, got)
+			}
+		})
+	}
+}
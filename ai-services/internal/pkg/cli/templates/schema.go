@@ -0,0 +1,102 @@
+package templates
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const metadataSchemaURL = "metadata.schema.json"
+
+//go:embed metadata.schema.json
+var metadataSchemaJSON []byte
+
+var (
+	schemaMessagePrinter = message.NewPrinter(language.English)
+
+	metadataSchema     *jsonschema.Schema
+	metadataSchemaErr  error
+	metadataSchemaOnce sync.Once
+)
+
+// compileMetadataSchema compiles the embedded metadata schema only once.
+func compileMetadataSchema() (*jsonschema.Schema, error) {
+	metadataSchemaOnce.Do(func() {
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(metadataSchemaJSON))
+		if err != nil {
+			metadataSchemaErr = fmt.Errorf("parse metadata schema: %w", err)
+
+			return
+		}
+
+		compiler := jsonschema.NewCompiler()
+		if err := compiler.AddResource(metadataSchemaURL, doc); err != nil {
+			metadataSchemaErr = fmt.Errorf("add metadata schema: %w", err)
+
+			return
+		}
+
+		metadataSchema, metadataSchemaErr = compiler.Compile(metadataSchemaURL)
+	})
+
+	return metadataSchema, metadataSchemaErr
+}
+
+// ValidateMetadata validates the raw metadata.yaml content against the metadata schema.
+// Every violation is reported along with the path of the offending field, e.g. '/services/0/port'.
+func ValidateMetadata(data []byte) error {
+	schema, err := compileMetadataSchema()
+	if err != nil {
+		return err
+	}
+
+	jsonData, err := k8syaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("convert metadata to json: %w", err)
+	}
+
+	inst, err := jsonschema.UnmarshalJSON(bytes.NewReader(jsonData))
+	if err != nil {
+		return fmt.Errorf("parse metadata: %w", err)
+	}
+
+	err = schema.Validate(inst)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return fmt.Errorf("validate metadata: %w", err)
+	}
+
+	return fmt.Errorf("metadata does not match schema:\n%s", strings.Join(schemaViolations(validationErr), "\n"))
+}
+
+// schemaViolations flattens the validation error into the leaf violations, formatted as '<field path>: <message>'.
+func schemaViolations(err *jsonschema.ValidationError) []string {
+	if len(err.Causes) == 0 {
+		field := "/" + strings.Join(err.InstanceLocation, "/")
+		msg := err.ErrorKind.LocalizedString(schemaMessagePrinter)
+
+		return []string{fmt.Sprintf("  - %s: %s", field, msg)}
+	}
+
+	violations := []string{}
+	for _, cause := range err.Causes {
+		violations = append(violations, schemaViolations(cause)...)
+	}
+	sort.Strings(violations)
+
+	return violations
+}
@@ -0,0 +1,70 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestValidateMetadata validates the metadata fixtures under testdata/metadata against the metadata schema,
+// asserting that a violation is reported along with the path of the offending field.
+func TestValidateMetadata(t *testing.T) {
+	tests := []struct {
+		fixture string
+		wantErr string
+	}{
+		{fixture: "valid-app.yaml"},
+		{fixture: "valid-runtime.yaml"},
+		{fixture: "missing-name.yaml", wantErr: "/: missing property 'name'"},
+		{fixture: "unknown-field.yaml", wantErr: "/: additional properties 'smtlevel' not allowed"},
+		{fixture: "invalid-version.yaml", wantErr: "/version: "},
+		{fixture: "invalid-smt-level.yaml", wantErr: "/smtLevel: "},
+		{fixture: "invalid-pod-template.yaml", wantErr: "/podTemplateExecutions/0/0: "},
+		{fixture: "empty-layer.yaml", wantErr: "/podTemplateExecutions/1: "},
+		{fixture: "invalid-service-port.yaml", wantErr: "/services/0/port: "},
+		{fixture: "invalid-timeout.yaml", wantErr: "/openshift/timeout: "},
+		{fixture: "malformed-yaml.yaml", wantErr: "convert metadata to json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("testdata", "metadata", tt.fixture))
+			if err != nil {
+				t.Fatalf("read fixture: %v", err)
+			}
+
+			err = ValidateMetadata(data)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateMetadata() error = %v, want nil", err)
+				}
+
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateMetadata() error = nil, want %q", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateMetadata() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestLoadMetadataEmbedded asserts that the metadata of every embedded application template matches the schema.
+func TestLoadMetadataEmbedded(t *testing.T) {
+	tp := NewEmbedTemplateProvider(EmbedOptions{})
+	apps, err := tp.ListApplications(true)
+	if err != nil {
+		t.Fatalf("ListApplications() error = %v", err)
+	}
+
+	for _, app := range apps {
+		for _, isRuntime := range []bool{false, true} {
+			if _, err := tp.LoadMetadata(app, isRuntime); err != nil {
+				t.Errorf("LoadMetadata(%s, %t) error = %v", app, isRuntime, err)
+			}
+		}
+	}
+}
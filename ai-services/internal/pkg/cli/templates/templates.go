@@ -10,13 +10,38 @@ import (
 )
 
 type AppMetadata struct {
-	Name                  string           `yaml:"name,omitempty"`
-	Description           string           `yaml:"description,omitempty"`
-	Hidden                bool             `yaml:"hidden,omitempty"`
-	Version               string           `yaml:"version,omitempty"`
-	SMTLevel              *int             `yaml:"smtLevel,omitempty"`
-	PodTemplateExecutions [][]string       `yaml:"podTemplateExecutions"`
-	Openshift             OpenshiftRuntime `yaml:"openshift,omitempty"`
+	Name                  string            `yaml:"name,omitempty"`
+	Description           string            `yaml:"description,omitempty"`
+	Hidden                bool              `yaml:"hidden,omitempty"`
+	Version               string            `yaml:"version,omitempty"`
+	SMTLevel              *int              `yaml:"smtLevel,omitempty"`
+	PodTemplateExecutions [][]string        `yaml:"podTemplateExecutions"`
+	PrimaryPod            string            `yaml:"primaryPod,omitempty"`
+	Services              []ServiceMetadata `yaml:"services,omitempty"`
+	Openshift             OpenshiftRuntime  `yaml:"openshift,omitempty"`
+	// DependsOn declares the pod templates each pod template depends on, refer ValidateDependencies.
+	DependsOn map[string][]string `yaml:"dependsOn,omitempty"`
+}
+
+// ServiceMetadata declares a service of the application which is exposed to the user, e.g. its UI.
+type ServiceMetadata struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	// Pod is the pod exposing the service on podman, without the '<app>--' prefix.
+	Pod string `yaml:"pod,omitempty"`
+	// Port is the container port of the service on podman.
+	Port int `yaml:"port,omitempty"`
+	// Route is the name of the route of the service on openshift.
+	Route string `yaml:"route,omitempty"`
+}
+
+// ServiceURL is the URL of a service of the application, resolved from its ServiceMetadata.
+type ServiceURL struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+	// Available is false if the pod of the service is not running or its port is not published.
+	Available bool `json:"available"`
 }
 
 type OpenshiftRuntime struct {
@@ -29,6 +54,13 @@ type Vars struct {
 	Hosts      []HostVar      `yaml:"hosts,omitempty"`
 }
 
+// NextStep is a step to be performed after the application is created, rendered from steps/next_steps.yaml.
+type NextStep struct {
+	Description string `json:"description"       yaml:"description"`
+	Command     string `json:"command,omitempty" yaml:"command,omitempty"`
+	URL         string `json:"url,omitempty"     yaml:"url,omitempty"`
+}
+
 type PodVar struct {
 	Name    string  `yaml:"name,omitempty"`
 	Format  string  `yaml:"format,omitempty"`
@@ -66,6 +98,8 @@ type Template interface {
 	LoadMdFiles(app string) (map[string]*template.Template, error)
 	// LoadVarsFile loads the var template file
 	LoadVarsFile(app string, params map[string]string) (*Vars, error)
+	// LoadNextSteps loads and renders the next steps of the application, empty if the template does not provide them
+	LoadNextSteps(app string, params map[string]string) ([]NextStep, error)
 	// LoadVarsFile loads the Chart
 	LoadChart(app string) (chart.Charter, error)
 	// LoadYamls loads the yaml in assests dir
@@ -5,4 +5,11 @@ const (
 	ModelAnnotationKey       = "ai-services.io/model"
 	PodStartAnnotationkey    = "ai-services.io/start"
 	PodPortsAnnotationKey    = "ai-services.io/ports"
+	NamespaceLabelKey        = "ai-services.io/namespace"
+	// LabelKeyPrefix is reserved for the labels managed by ai-services, custom labels cannot use it.
+	LabelKeyPrefix = "ai-services.io/"
+	// HostPathAnnotationPrefix declares an app scoped host directory as 'ai-services.io/hostpath.<name>: <subpath>'.
+	HostPathAnnotationPrefix = "ai-services.io/hostpath."
+	// ResourcesAnnotationPrefix declares a container resource limit as 'ai-services.io/resources.<container>.<memory|cpu>: <quantity>'.
+	ResourcesAnnotationPrefix = "ai-services.io/resources."
 )
@@ -5,6 +5,12 @@ const (
 	PodStartOff            = "off"
 	ApplicationsPath       = "/var/lib/ai-services/applications"
 	SpyreOperatorNamespace = "spyre-operator"
+	// SpyreResourceName is the extended resource advertised on the nodes by the Spyre device plugin.
+	SpyreResourceName = "ibm.com/spyre_pf"
+	// SpyreLockPath is the lock file serializing the Spyre card allocation of concurrent creates.
+	SpyreLockPath = "/var/lib/ai-services/spyre.lock"
+	// ValidationCachePath records the last successful bootstrap validation of the host.
+	ValidationCachePath = "/var/lib/ai-services/validation.json"
 )
 
 type ValidationLevel int
@@ -14,6 +20,18 @@ const (
 	ValidationLevelError
 )
 
+// String returns the human readable name of the validation level.
+func (l ValidationLevel) String() string {
+	switch l {
+	case ValidationLevelWarning:
+		return "warning"
+	case ValidationLevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
 // HealthStatus represents the type for Container Health status.
 type HealthStatus string
 
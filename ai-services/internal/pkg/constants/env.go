@@ -4,4 +4,6 @@ type Env string
 
 const (
 	PCIAddressKey Env = "AIU_PCIE_IDS"
+	// WorldSizeKey is the tensor parallel world size, overridden when the container gets fewer Spyre cards than declared.
+	WorldSizeKey Env = "AIU_WORLD_SIZE"
 )
@@ -0,0 +1,134 @@
+// Package errdefs defines the typed errors used across the codebase, so that the callers can classify a failure
+// with errors.As irrespective of how often it got wrapped, e.g. to map it to an exit code or to print it as JSON.
+// The typed errors keep the message of the wrapped error, their fields carry the context of the failure.
+package errdefs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OpConnect is the RuntimeError operation of connecting to the container runtime.
+const OpConnect = "connect"
+
+// NoPodsFoundMessage is the canonical message printed by the commands when an application has no pods.
+const NoPodsFoundMessage = "No pods found for application"
+
+// ErrReadinessTimeout is returned when the pods or containers are not ready in time.
+var ErrReadinessTimeout = errors.New("operation timed out")
+
+// ErrNoPodsFound matches any NoPodsFoundError with errors.Is.
+var ErrNoPodsFound = errors.New("no pods found")
+
+// ErrContainerNotFound is returned by the runtime when the inspected container does not exist, as opposed to
+// the transient failures of the inspect itself.
+var ErrContainerNotFound = errors.New("container not found")
+
+// ValidationError is returned when a bootstrap validation rule fails, its message is prefixed with the rule name.
+type ValidationError struct {
+	Rule string
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Rule, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationFailures aggregates the failed validation rules of a run.
+type ValidationFailures struct {
+	Failures []*ValidationError
+}
+
+func (e *ValidationFailures) Error() string {
+	return fmt.Sprintf("%d validation check(s) failed", len(e.Failures))
+}
+
+// Unwrap exposes the failed rules, so that errors.As finds the individual ValidationError.
+func (e *ValidationFailures) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure)
+	}
+
+	return errs
+}
+
+// RuntimeError is returned when an operation of the container runtime fails, Pod is set if it failed for a pod.
+type RuntimeError struct {
+	Op  string
+	Pod string
+	Err error
+}
+
+func (e *RuntimeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RuntimeError) Unwrap() error {
+	return e.Err
+}
+
+// TemplateError is returned when an application template cannot be resolved, loaded or rendered.
+type TemplateError struct {
+	Template string
+	Err      error
+}
+
+func (e *TemplateError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// SpyreError is returned when the Spyre cards required by an application cannot be allocated.
+type SpyreError struct {
+	Required  int
+	Available int
+	Err       error
+}
+
+func (e *SpyreError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SpyreError) Unwrap() error {
+	return e.Err
+}
+
+// ModelDownloadError is returned when a model cannot be downloaded.
+type ModelDownloadError struct {
+	Model string
+	Err   error
+}
+
+func (e *ModelDownloadError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ModelDownloadError) Unwrap() error {
+	return e.Err
+}
+
+// NoPodsFoundError is returned when an application has no pods, its message is the canonical NoPodsFoundMessage.
+type NoPodsFoundError struct {
+	Application string
+}
+
+// NoPodsFound returns the NoPodsFoundError of the given application.
+func NoPodsFound(appName string) error {
+	return &NoPodsFoundError{Application: appName}
+}
+
+func (e *NoPodsFoundError) Error() string {
+	return fmt.Sprintf("%s: %s", NoPodsFoundMessage, e.Application)
+}
+
+func (e *NoPodsFoundError) Is(target error) bool {
+	return target == ErrNoPodsFound
+}
@@ -0,0 +1,88 @@
+package errdefs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestErrorsAs asserts that the typed errors are found with errors.As once wrapped, keep the message of the wrapped
+// error and do not hide it from errors.Is.
+func TestErrorsAs(t *testing.T) {
+	cause := errors.New("boom")
+	validation := &ValidationError{Rule: "podman", Err: cause}
+	noPods := NoPodsFound("app")
+
+	tests := []struct {
+		name    string
+		err     error
+		want    error
+		wantMsg string
+	}{
+		{
+			name:    "validation",
+			err:     fmt.Errorf("bootstrap failed: %w", validation),
+			want:    validation,
+			wantMsg: "bootstrap failed: podman: boom",
+		},
+		{
+			name:    "validation failures",
+			err:     fmt.Errorf("bootstrap failed: %w", &ValidationFailures{Failures: []*ValidationError{validation}}),
+			want:    validation,
+			wantMsg: "bootstrap failed: 1 validation check(s) failed",
+		},
+		{
+			name:    "runtime",
+			err:     fmt.Errorf("create failed: %w", &RuntimeError{Op: "deploy", Pod: "app--vllm", Err: cause}),
+			want:    &RuntimeError{Op: "deploy", Pod: "app--vllm", Err: cause},
+			wantMsg: "create failed: boom",
+		},
+		{
+			name:    "template",
+			err:     fmt.Errorf("create failed: %w", &TemplateError{Template: "rag", Err: cause}),
+			want:    &TemplateError{Template: "rag", Err: cause},
+			wantMsg: "create failed: boom",
+		},
+		{
+			name:    "spyre",
+			err:     fmt.Errorf("create failed: %w", &SpyreError{Required: 4, Available: 2, Err: cause}),
+			want:    &SpyreError{Required: 4, Available: 2, Err: cause},
+			wantMsg: "create failed: boom",
+		},
+		{
+			name:    "model download",
+			err:     fmt.Errorf("create failed: %w", &ModelDownloadError{Model: "ibm-granite/granite", Err: cause}),
+			want:    &ModelDownloadError{Model: "ibm-granite/granite", Err: cause},
+			wantMsg: "create failed: boom",
+		},
+		{
+			name:    "no pods found",
+			err:     fmt.Errorf("stop failed: %w", noPods),
+			want:    noPods,
+			wantMsg: "stop failed: No pods found for application: app",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := reflect.New(reflect.TypeOf(tt.want))
+			if !errors.As(tt.err, target.Interface()) {
+				t.Fatalf("errors.As(%v, %T) = false", tt.err, tt.want)
+			}
+			if got := target.Elem().Interface(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("errors.As() = %#v, want %#v", got, tt.want)
+			}
+			if got := tt.err.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMsg)
+			}
+			if tt.want != noPods && !errors.Is(tt.err, cause) {
+				t.Errorf("errors.Is(%v, %v) = false, want the cause to stay visible", tt.err, cause)
+			}
+		})
+	}
+
+	if !errors.Is(fmt.Errorf("stop failed: %w", noPods), ErrNoPodsFound) {
+		t.Errorf("errors.Is(NoPodsFound(), ErrNoPodsFound) = false")
+	}
+}
@@ -0,0 +1,88 @@
+package image
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+)
+
+// ImageDetails describes a template image along with its state on the host.
+type ImageDetails struct {
+	Image   string    `json:"image"`
+	Present bool      `json:"present"`
+	ID      string    `json:"id,omitempty"`
+	Digest  string    `json:"digest,omitempty"`
+	Size    int64     `json:"size,omitempty"`
+	Created time.Time `json:"created,omitzero"`
+	// UsedBy lists the deployed applications whose containers run the image.
+	UsedBy []string `json:"usedBy"`
+}
+
+// DescribeImages looks up the given images on the host and cross-references them with the containers of the deployed applications.
+func DescribeImages(r runtime.Runtime, images []string) ([]ImageDetails, error) {
+	localImages, err := r.ListImages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	usedBy, err := imageUsage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]ImageDetails, 0, len(images))
+	for _, name := range images {
+		d := ImageDetails{Image: name, UsedBy: []string{}}
+		for _, local := range localImages {
+			if !slices.Contains(local.RepoTags, name) {
+				continue
+			}
+			d.Present = true
+			d.ID = local.ID
+			d.Size = local.Size
+			d.Created = local.Created
+			if len(local.RepoDigests) > 0 {
+				d.Digest = local.RepoDigests[0]
+			}
+			d.UsedBy = append(d.UsedBy, usedBy[local.ID]...)
+
+			break
+		}
+		details = append(details, d)
+	}
+
+	return details, nil
+}
+
+// imageUsage returns the deployed applications keyed by the ID of the images used by their containers.
+func imageUsage(r runtime.Runtime) (map[string][]string, error) {
+	pods, err := helpers.ListApplicationPods(r, "")
+	if err != nil {
+		return nil, err
+	}
+
+	usage := map[string][]string{}
+	for _, pod := range pods {
+		app := pod.Labels[constants.ApplicationAnnotationKey]
+		for _, c := range pod.Containers {
+			container, err := r.InspectContainer(c.ID)
+			if err != nil {
+				// the container could be removed in the meantime, its image is then not in use anymore
+				logger.Infof("failed to inspect container %s: %v\n", c.ID, err, logger.VerbosityLevelDebug)
+
+				continue
+			}
+			if container.ImageID == "" || slices.Contains(usage[container.ImageID], app) {
+				continue
+			}
+			usage[container.ImageID] = append(usage[container.ImageID], app)
+		}
+	}
+
+	return usage, nil
+}
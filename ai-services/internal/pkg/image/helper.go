@@ -1,11 +1,15 @@
 package image
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"slices"
+	"sync"
+	"sync/atomic"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/registry"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
@@ -15,13 +19,10 @@ import (
 func ListImages(template, appName string) ([]string, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 
-	// fetch list of app templates
-	apps, err := tp.ListApplications(true)
+	// resolve the canonical app template name
+	template, err := templates.ResolveTemplate(tp, template)
 	if err != nil {
-		return nil, fmt.Errorf("error listing templates: %w", err)
-	}
-	if found := slices.Contains(apps, template); !found {
-		return nil, fmt.Errorf("provided template name is wrong. Please provide a valid template name")
+		return nil, err
 	}
 
 	// load all the pod templates for given template
@@ -49,15 +50,108 @@ func ListImages(template, appName string) ([]string, error) {
 	return utils.UniqueSlice(images), nil
 }
 
+// PullStatus is the outcome of pulling a single image.
+type PullStatus string
+
+const (
+	PullStatusPulled PullStatus = "pulled"
+	PullStatusFailed PullStatus = "failed"
+	// PullStatusSkipped is reported for the images not attempted once a pull failed in fail fast mode.
+	PullStatusSkipped PullStatus = "skipped"
+)
+
+// PullResult reports the outcome of pulling an image.
+type PullResult struct {
+	Image  string     `json:"image"`
+	Status PullStatus `json:"status"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// PullImages pulls the given images from registry using the given number of parallel workers, the images not yet
+// attempted once the given context is done are skipped.
+// Before pulling, it logs into the registries for which credentials are configured, refer registry.ResolveCredentials.
+// All the images are attempted even if some of them fail to pull, unless failFast is set, in which case the images
+// not yet attempted after the first failure are skipped. The results are returned in the order of the given images,
+// along with the aggregated errors of the failed pulls.
+func PullImages(ctx context.Context, runtime runtime.Runtime, images []string, workers int, failFast bool) ([]PullResult, error) {
+	return pullImagesWithResults(ctx, runtime, images, workers, failFast)
+}
+
 // pullImageFromRegistry pulls the required images from registry.
-func pullImageFromRegistry(runtime runtime.Runtime, images []string) error {
-	for _, image := range images {
-		logger.Infoln("Downloading image: " + image + "...")
-		if err := utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
-			return runtime.PullImage(image)
-		}); err != nil {
-			return fmt.Errorf("failed to download image: %w", err)
+// Images are pulled by a bounded pool of workers and the errors of all the failed pulls are aggregated.
+func pullImageFromRegistry(ctx context.Context, runtime runtime.Runtime, images []string, workers int) error {
+	_, err := pullImagesWithResults(ctx, runtime, images, workers, false)
+
+	return err
+}
+
+func pullImagesWithResults(ctx context.Context, runtime runtime.Runtime, images []string, workers int, failFast bool) ([]PullResult, error) {
+	if len(images) == 0 {
+		return nil, nil
+	}
+
+	if err := registry.EnsureLogin(images); err != nil {
+		return nil, fmt.Errorf("failed to login to registry: %w", err)
+	}
+
+	workers = min(max(workers, 1), len(images))
+
+	// each worker writes the result of the images it picked at their index, hence no locking is needed
+	results := make([]PullResult, len(images))
+	errs := make([]error, len(images))
+	indexCh := make(chan int, len(images))
+	for i := range images {
+		indexCh <- i
+	}
+	close(indexCh)
+
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Bool
+	)
+
+	for range workers {
+		wg.Go(func() {
+			for i := range indexCh {
+				results[i] = PullResult{Image: images[i], Status: PullStatusPulled}
+				if (failFast && failed.Load()) || ctx.Err() != nil {
+					results[i].Status = PullStatusSkipped
+
+					continue
+				}
+
+				if err := pullImage(ctx, runtime, images[i]); err != nil {
+					failed.Store(true)
+					errs[i] = err
+					results[i].Status = PullStatusFailed
+					results[i].Error = err.Error()
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, fmt.Errorf("image pull cancelled: %w", err))
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// pullImage pulls a single image with retries, the retries stop once the given context is done.
+// A pull failing on the registry credentials is not retried, as retrying cannot fix them.
+func pullImage(ctx context.Context, runtime runtime.Runtime, image string) error {
+	logger.Infoln("Downloading image: " + image + "...")
+	if err := utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
+		err := runtime.PullImage(image)
+		if registry.IsAuthError(err) {
+			return utils.PermanentError(err)
 		}
+
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to download image %s: %w", image, registry.WrapAuthError(image, err))
 	}
 
 	return nil
@@ -0,0 +1,149 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/registry"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestPullImages(t *testing.T) {
+	// no registry credentials are configured, hence the pulls rely on the existing podman login
+	t.Setenv(registry.EnvRegistryUsername, "")
+	t.Setenv(registry.EnvRegistryCredentialsFile, filepath.Join(t.TempDir(), "registry-credentials.yaml"))
+
+	retryCount := vars.RetryCount
+	t.Cleanup(func() { vars.RetryCount = retryCount })
+	vars.RetryCount = 0
+
+	images := []string{"quay.io/a:1", "quay.io/b:1", "quay.io/c:1", "quay.io/d:1"}
+
+	tests := []struct {
+		name       string
+		workers    int
+		failFast   bool
+		fail       []string
+		wantStatus []PullStatus
+	}{
+		{name: "all pulled", workers: 2, wantStatus: []PullStatus{PullStatusPulled, PullStatusPulled, PullStatusPulled, PullStatusPulled}},
+		{
+			name: "failures are aggregated", workers: 3, fail: []string{"quay.io/b:1", "quay.io/d:1"},
+			wantStatus: []PullStatus{PullStatusPulled, PullStatusFailed, PullStatusPulled, PullStatusFailed},
+		},
+		{
+			name: "fail fast skips the remaining images", workers: 1, failFast: true, fail: []string{"quay.io/b:1"},
+			wantStatus: []PullStatus{PullStatusPulled, PullStatusFailed, PullStatusSkipped, PullStatusSkipped},
+		},
+		{
+			name: "more workers than images", workers: 10, fail: []string{"quay.io/a:1"},
+			wantStatus: []PullStatus{PullStatusFailed, PullStatusPulled, PullStatusPulled, PullStatusPulled},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			for _, image := range tt.fail {
+				r.Fail("PullImage:"+image, errors.New("manifest unknown"))
+			}
+
+			results, err := PullImages(context.Background(), r, images, tt.workers, tt.failFast)
+
+			status := make([]PullStatus, 0, len(results))
+			for i, result := range results {
+				if result.Image != images[i] {
+					t.Errorf("PullImages() result %d is for %s, want %s", i, result.Image, images[i])
+				}
+				if (result.Status == PullStatusFailed) != (result.Error != "") {
+					t.Errorf("PullImages() result %+v, want the error set for a failed pull only", result)
+				}
+				status = append(status, result.Status)
+			}
+			if !reflect.DeepEqual(status, tt.wantStatus) {
+				t.Errorf("PullImages() status = %v, want %v", status, tt.wantStatus)
+			}
+
+			if len(tt.fail) == 0 {
+				if err != nil {
+					t.Errorf("PullImages() error = %v", err)
+				}
+
+				return
+			}
+			for _, image := range tt.fail {
+				if err == nil || !strings.Contains(err.Error(), image) {
+					t.Errorf("PullImages() error = %v, want it to report %s", err, image)
+				}
+			}
+		})
+	}
+}
+
+// TestPullImagesCancelled asserts that the images are not attempted once the context is done.
+func TestPullImagesCancelled(t *testing.T) {
+	t.Setenv(registry.EnvRegistryUsername, "")
+	t.Setenv(registry.EnvRegistryCredentialsFile, filepath.Join(t.TempDir(), "registry-credentials.yaml"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := fake.New()
+	results, err := PullImages(ctx, r, []string{"quay.io/a:1", "quay.io/b:1"}, 2, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("PullImages() error = %v, want %v", err, context.Canceled)
+	}
+	for _, result := range results {
+		if result.Status != PullStatusSkipped {
+			t.Errorf("PullImages() result %+v, want the image skipped", result)
+		}
+	}
+	if calls := r.Calls(); len(calls) != 0 {
+		t.Errorf("runtime calls = %v, want no image pulled", calls)
+	}
+}
+
+// TestPullImageAuthError asserts that a pull rejected by the registry fails right away, while the other failures
+// are retried.
+func TestPullImageAuthError(t *testing.T) {
+	retryCount, retryInterval := vars.RetryCount, vars.RetryInterval
+	t.Cleanup(func() { vars.RetryCount, vars.RetryInterval = retryCount, retryInterval })
+	vars.RetryCount, vars.RetryInterval = 3, time.Millisecond
+
+	const image = "quay.io/private/app:1"
+
+	tests := []struct {
+		name      string
+		err       error
+		wantPulls int
+		wantAuth  bool
+	}{
+		{name: "unauthorized", err: errors.New("reading manifest 1 in quay.io/private/app: unauthorized: access to the requested resource is not authorized"), wantPulls: 1, wantAuth: true},
+		{name: "forbidden", err: errors.New("initializing source: received unexpected HTTP status: 403 Forbidden"), wantPulls: 1, wantAuth: true},
+		{name: "transient failure retried", err: errors.New("connection reset by peer"), wantPulls: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := fake.New()
+			r.Fail("PullImage:"+image, tt.err)
+
+			err := pullImage(context.Background(), r, image)
+			if err == nil {
+				t.Fatal("pullImage() error = nil, want the pull failed")
+			}
+			if got := errors.Is(err, registry.ErrAuthenticationRequired); got != tt.wantAuth {
+				t.Errorf("pullImage() error = %v, want authentication required %t", err, tt.wantAuth)
+			}
+			if pulls := len(r.Calls()); pulls != tt.wantPulls {
+				t.Errorf("pull attempts = %d, want %d", pulls, tt.wantPulls)
+			}
+		})
+	}
+}
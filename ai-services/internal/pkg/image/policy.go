@@ -1,6 +1,7 @@
 package image
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -17,6 +18,9 @@ const (
 	PullNever        ImagePullPolicy = "Never"
 )
 
+// DefaultPullWorkers is the number of images pulled in parallel unless set otherwise.
+const DefaultPullWorkers = 4
+
 // Valid checks for supported ImagePullPolicy values.
 func (p ImagePullPolicy) Valid() bool {
 	return p == PullAlways || p == PullNever || p == PullIfNotPresent
@@ -27,6 +31,9 @@ type ImagePull struct {
 	Runtime          runtime.Runtime
 	Policy           ImagePullPolicy
 	App, AppTemplate string
+	// Workers is the number of images pulled in parallel, images are pulled serially if not set.
+	// NewImagePull sets it to DefaultPullWorkers.
+	Workers int
 }
 
 // NewImagePull factory method to return ImagePull object.
@@ -36,16 +43,18 @@ func NewImagePull(runtime runtime.Runtime, policy ImagePullPolicy, app, appTempl
 		Policy:      policy,
 		App:         app,
 		AppTemplate: appTemplate,
+		Workers:     DefaultPullWorkers,
 	}
 }
 
 // Run runs a particular imagePullPolicy method type based on the policy set within the ImagePull object.
-func (p ImagePull) Run() error {
+// The pulls are stopped once the given context is done.
+func (p ImagePull) Run(ctx context.Context) error {
 	switch p.Policy {
 	case PullAlways:
-		return p.always()
+		return p.always(ctx)
 	case PullIfNotPresent:
-		return p.ifNotPresent()
+		return p.ifNotPresent(ctx)
 	case PullNever:
 		return p.never()
 	default:
@@ -54,7 +63,7 @@ func (p ImagePull) Run() error {
 }
 
 // always -> pulls all the images for a given app template.
-func (p ImagePull) always() error {
+func (p ImagePull) always(ctx context.Context) error {
 	// Fetch all images required for a given template
 	images, err := ListImages(p.AppTemplate, p.App)
 	if err != nil {
@@ -65,11 +74,11 @@ func (p ImagePull) always() error {
 	logger.Infoln("Downloading container images required for application template " + p.AppTemplate + ":")
 
 	// Pull all the images
-	return pullImageFromRegistry(p.Runtime, images)
+	return pullImageFromRegistry(ctx, p.Runtime, images, p.Workers)
 }
 
 // ifNotPresent -> pulls only the missing images for a given app template.
-func (p ImagePull) ifNotPresent() error {
+func (p ImagePull) ifNotPresent(ctx context.Context) error {
 	// Fetch all images required for a given template
 	images, err := ListImages(p.AppTemplate, p.App)
 	if err != nil {
@@ -83,7 +92,7 @@ func (p ImagePull) ifNotPresent() error {
 	}
 
 	// Pull only those images which does not exist
-	return pullImageFromRegistry(p.Runtime, notFoundImages)
+	return pullImageFromRegistry(ctx, p.Runtime, notFoundImages, p.Workers)
 }
 
 // never -> never pulls any image.
@@ -0,0 +1,63 @@
+package image
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/registry"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/fake"
+)
+
+// TestImagePullParallel asserts that the images of a template are pulled by more than one worker at once, each
+// pull being held until a concurrent one starts, or given up on after a second if the images are pulled serially.
+func TestImagePullParallel(t *testing.T) {
+	t.Setenv(registry.EnvRegistryUsername, "")
+	t.Setenv(registry.EnvRegistryCredentialsFile, filepath.Join(t.TempDir(), "registry-credentials.yaml"))
+
+	var (
+		mu                    sync.Mutex
+		inFlight, maxInFlight int
+		concurrent            = make(chan struct{})
+	)
+
+	r := fake.New()
+	r.OnPullImage = func(image string) error {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+			if maxInFlight == 2 {
+				close(concurrent)
+			}
+		}
+		mu.Unlock()
+
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		select {
+		case <-concurrent:
+		case <-time.After(time.Second):
+		}
+
+		return nil
+	}
+
+	p := NewImagePull(r, PullAlways, "app", "rag")
+	if p.Workers != DefaultPullWorkers {
+		t.Errorf("NewImagePull() workers = %d, want %d", p.Workers, DefaultPullWorkers)
+	}
+	if err := p.Run(context.Background()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if maxInFlight < 2 {
+		t.Errorf("concurrent pulls = %d, want the images pulled in parallel", maxInFlight)
+	}
+}
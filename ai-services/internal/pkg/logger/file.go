@@ -0,0 +1,166 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// EnvLogDir sets the default directory of the log file, used when --log-file is not provided.
+	EnvLogDir = "AI_SERVICES_LOG_DIR"
+	// DefaultLogFileName is the name of the log file created under the default log directory.
+	DefaultLogFileName = "ai-services.log"
+	// DefaultLogFileMaxSizeMB is the size after which the log file is rotated.
+	DefaultLogFileMaxSizeMB = 10
+
+	// number of rotated log files kept around, i.e. <file>.1 ... <file>.N.
+	logFileBackups = 3
+	bytesPerMB     = 1024 * 1024
+	logDirPerm     = 0o750
+	logFilePerm    = 0o600
+)
+
+// secretRegex matches the credentials in a log line, eg:- 'password=xyz', "token": "xyz" or 'Bearer xyz'.
+var secretRegex = regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api[_-]?key|bearer)["']?\s*[:= ]\s*["']?)[^\s"',]+`)
+
+var (
+	logFile      *rotatingFile
+	logFileMutex sync.Mutex
+)
+
+// rotatingFile is a log file which is rotated once it grows beyond maxSize.
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	size    int64
+	file    *os.File
+}
+
+// DefaultLogFile returns the log file under the directory set via AI_SERVICES_LOG_DIR env, empty if not set.
+func DefaultLogFile() string {
+	dir := os.Getenv(EnvLogDir)
+	if dir == "" {
+		return ""
+	}
+
+	return filepath.Join(dir, DefaultLogFileName)
+}
+
+// SetLogFile tees all the log records to the given file in addition to the console.
+// The parent directories are created if missing and the file is rotated once it exceeds maxSizeMB.
+func SetLogFile(path string, maxSizeMB int) error {
+	if maxSizeMB <= 0 {
+		return fmt.Errorf("log file max size must be greater than 0")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), logDirPerm); err != nil {
+		return fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	rf := &rotatingFile{path: path, maxSize: int64(maxSizeMB) * bytesPerMB}
+	if err := rf.open(); err != nil {
+		return err
+	}
+
+	logFileMutex.Lock()
+	defer logFileMutex.Unlock()
+
+	if logFile != nil {
+		_ = logFile.file.Close()
+	}
+	logFile = rf
+
+	return nil
+}
+
+// CloseLogFile closes the log file, if any.
+func CloseLogFile() {
+	logFileMutex.Lock()
+	defer logFileMutex.Unlock()
+
+	if logFile != nil {
+		_ = logFile.file.Close()
+		logFile = nil
+	}
+}
+
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, logFilePerm)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	rf.file = f
+	rf.size = info.Size()
+
+	return nil
+}
+
+// rotate shifts <file>.N-1 -> <file>.N ... <file> -> <file>.1 and starts a new file.
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return err
+	}
+
+	for i := logFileBackups - 1; i > 0; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", rf.path, i), fmt.Sprintf("%s.%d", rf.path, i+1))
+	}
+
+	if err := os.Rename(rf.path, rf.path+".1"); err != nil {
+		return err
+	}
+
+	return rf.open()
+}
+
+func (rf *rotatingFile) write(line string) {
+	if rf.size+int64(len(line)) > rf.maxSize && rf.size > 0 {
+		if err := rf.rotate(); err != nil {
+			return
+		}
+	}
+
+	n, _ := rf.file.WriteString(line)
+	rf.size += int64(n)
+}
+
+// writeFile writes the log record to the log file, if one is set.
+func writeFile(level string, verbosity int, msg string) {
+	logFileMutex.Lock()
+	defer logFileMutex.Unlock()
+
+	if logFile == nil || !klog.V(klog.Level(verbosity)).Enabled() {
+		return
+	}
+
+	msg = redactSecrets(strings.TrimRight(msg, "\n"))
+	logFile.write(fmt.Sprintf("%s %-7s %s\n", time.Now().Format(time.RFC3339), strings.ToUpper(level), msg))
+}
+
+// redactSecrets masks the credentials, so that they are never persisted to the log file.
+func redactSecrets(msg string) string {
+	msg = secretRegex.ReplaceAllString(msg, "${1}******")
+
+	// mask the registry password set via env, even if it is not logged along with a key
+	for _, env := range []string{"REGISTRY_PASSWORD"} {
+		if secret := os.Getenv(env); secret != "" {
+			msg = strings.ReplaceAll(msg, secret, "******")
+		}
+	}
+
+	return msg
+}
@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Format represents the format of the log records.
+type Format string
+
+const (
+	// FormatText emits human readable log lines, this is the default.
+	FormatText Format = "text"
+	// FormatJSON emits one JSON record per log line.
+	FormatJSON Format = "json"
+)
+
+const (
+	levelInfo    = "info"
+	levelWarning = "warning"
+	levelError   = "error"
+)
+
+var (
+	format    = FormatText
+	jsonMutex sync.Mutex
+	// jsonOut is kept separate from the command output on stdout, so that '-o json' output stays parsable.
+	jsonOut io.Writer = os.Stderr
+)
+
+// record is a structured log record emitted in JSON format.
+type record struct {
+	Level  string         `json:"level"`
+	TS     string         `json:"ts"`
+	Msg    string         `json:"msg"`
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+// SetFormat sets the format of the log records.
+func SetFormat(f string) error {
+	switch Format(strings.ToLower(f)) {
+	case FormatText:
+		format = FormatText
+	case FormatJSON:
+		format = FormatJSON
+	default:
+		return fmt.Errorf("unsupported log format: %s, supported formats are: %s, %s", f, FormatText, FormatJSON)
+	}
+
+	return nil
+}
+
+// IsJSONFormat reports whether the log records are emitted in JSON format.
+func IsJSONFormat() bool {
+	return format == FormatJSON
+}
+
+func writeJSON(level string, verbosity int, msg string) {
+	if !klog.V(klog.Level(verbosity)).Enabled() {
+		return
+	}
+
+	rec := record{
+		Level: level,
+		TS:    time.Now().UTC().Format(time.RFC3339Nano),
+		Msg:   strings.TrimSpace(msg),
+	}
+	if verbosity > 0 {
+		rec.Fields = map[string]any{"verbosity": verbosity}
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+
+	jsonMutex.Lock()
+	defer jsonMutex.Unlock()
+
+	_, _ = fmt.Fprintln(jsonOut, string(data))
+}
@@ -2,6 +2,7 @@ package logger
 
 import (
 	"flag"
+	"fmt"
 
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
@@ -27,21 +28,46 @@ func InitFlags(cmd *cobra.Command) {
 
 func Flush() {
 	klog.Flush()
+	CloseLogFile()
 }
 
 func Warningln(msg string) {
+	writeFile(levelWarning, 0, msg)
+	if IsJSONFormat() {
+		writeJSON(levelWarning, 0, msg)
+
+		return
+	}
 	klog.Warningln("WARNING: ", msg)
 }
 
 func Warningf(msg string, args ...interface{}) {
+	writeFile(levelWarning, 0, fmt.Sprintf(msg, args...))
+	if IsJSONFormat() {
+		writeJSON(levelWarning, 0, fmt.Sprintf(msg, args...))
+
+		return
+	}
 	klog.Warningf("WARNING: "+msg, args...)
 }
 
 func Errorln(msg string) {
+	writeFile(levelError, 0, msg)
+	if IsJSONFormat() {
+		writeJSON(levelError, 0, msg)
+
+		return
+	}
 	klog.Errorln("ERROR: ", msg)
 }
 
 func Errorf(msg string, args ...interface{}) {
+	writeFile(levelError, 0, fmt.Sprintf(msg, args...))
+	if IsJSONFormat() {
+		writeJSON(levelError, 0, fmt.Sprintf(msg, args...))
+
+		return
+	}
 	klog.Errorf("ERROR: "+msg, args...)
 }
 
@@ -50,6 +76,12 @@ func Infoln(msg string, verbose ...int) {
 	if len(verbose) > 0 {
 		v = verbose[0]
 	}
+	writeFile(levelInfo, v, msg)
+	if IsJSONFormat() {
+		writeJSON(levelInfo, v, msg)
+
+		return
+	}
 	klog.V(klog.Level(v)).Infoln(msg)
 }
 
@@ -62,5 +94,11 @@ func Infof(msg string, args ...interface{}) {
 			args = args[:len(args)-1] // remove verbosity argument
 		}
 	}
+	writeFile(levelInfo, v, fmt.Sprintf(msg, args...))
+	if IsJSONFormat() {
+		writeJSON(levelInfo, v, fmt.Sprintf(msg, args...))
+
+		return
+	}
 	klog.V(klog.Level(v)).Infof(msg, args...)
 }
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	metricsPath           = "/metrics"
+	metricsPrefix         = "ai_services_"
+	serverShutdownTimeout = 5 * time.Second
+	readHeaderTimeout     = 10 * time.Second
+)
+
+type metricType string
+
+const (
+	counterType metricType = "counter"
+	gaugeType   metricType = "gauge"
+)
+
+// Metric is a single float value exposed on the metrics endpoint.
+type Metric struct {
+	name  string
+	help  string
+	mType metricType
+	bits  atomic.Uint64
+}
+
+var (
+	// ModelsDownloaded counts the models downloaded during the command.
+	ModelsDownloaded = newMetric("models_downloaded", "Number of models downloaded.", counterType)
+	// PodsDeployed counts the pods deployed during the command.
+	PodsDeployed = newMetric("pods_deployed", "Number of pods deployed.", counterType)
+	// ReadinessWaitSeconds accumulates the time spent waiting for the containers to become ready.
+	ReadinessWaitSeconds = newMetric("readiness_wait_seconds", "Total time spent waiting for container readiness in seconds.", counterType)
+	// SpyreCardsAllocated reports the number of Spyre cards allocated to the application.
+	SpyreCardsAllocated = newMetric("spyre_cards_allocated", "Number of Spyre cards allocated.", gaugeType)
+
+	registry = []*Metric{ModelsDownloaded, PodsDeployed, ReadinessWaitSeconds, SpyreCardsAllocated}
+
+	server   *http.Server
+	serverMu sync.Mutex
+)
+
+func newMetric(name, help string, mType metricType) *Metric {
+	return &Metric{name: metricsPrefix + name, help: help, mType: mType}
+}
+
+// Add increases the metric by the given value.
+func (m *Metric) Add(val float64) {
+	for {
+		old := m.bits.Load()
+		updated := math.Float64bits(math.Float64frombits(old) + val)
+		if m.bits.CompareAndSwap(old, updated) {
+			return
+		}
+	}
+}
+
+// Inc increases the metric by one.
+func (m *Metric) Inc() {
+	m.Add(1)
+}
+
+// Set sets the metric to the given value.
+func (m *Metric) Set(val float64) {
+	m.bits.Store(math.Float64bits(val))
+}
+
+// Value returns the current value of the metric.
+func (m *Metric) Value() float64 {
+	return math.Float64frombits(m.bits.Load())
+}
+
+// Start exposes the metrics on the given address until Stop is called.
+func Start(addr string) error {
+	serverMu.Lock()
+	defer serverMu.Unlock()
+
+	if server != nil {
+		return errors.New("metrics server is already running")
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on metrics address %s: %w", addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(metricsPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		write(w)
+	})
+
+	server = &http.Server{Handler: mux, ReadHeaderTimeout: readHeaderTimeout}
+	go func(srv *http.Server) {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Warningf("metrics server stopped: %v\n", err)
+		}
+	}(server)
+
+	logger.Infof("Serving metrics on http://%s%s\n", listener.Addr(), metricsPath, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// Stop shuts the metrics server down, it is a no-op when the server is not running.
+func Stop() {
+	serverMu.Lock()
+	defer serverMu.Unlock()
+
+	if server == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Warningf("failed to shutdown metrics server: %v\n", err)
+	}
+	server = nil
+}
+
+// write renders all the metrics in the Prometheus text exposition format.
+func write(w io.Writer) {
+	for _, m := range registry {
+		fmt.Fprintf(w, "# HELP %s %s\n", m.name, m.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", m.name, m.mType)
+		fmt.Fprintf(w, "%s %g\n", m.name, m.Value())
+	}
+}
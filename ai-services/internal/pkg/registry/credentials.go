@@ -0,0 +1,190 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// EnvRegistryURL scopes the env credentials to a single registry, when unset they apply to every registry.
+	EnvRegistryURL = "REGISTRY_URL"
+	// EnvRegistryUsername is the username used to login to the registry.
+	EnvRegistryUsername = "REGISTRY_USERNAME"
+	// EnvRegistryPassword is the password used to login to the registry.
+	EnvRegistryPassword = "REGISTRY_PASSWORD"
+	// EnvRegistryCredentialsFile overrides the path of the credentials file.
+	EnvRegistryCredentialsFile = "REGISTRY_CREDENTIALS_FILE"
+
+	// DefaultCredentialsFile is the credentials file looked up when REGISTRY_CREDENTIALS_FILE is not set.
+	DefaultCredentialsFile = "/etc/ai-services/registry-credentials.yaml"
+
+	defaultRegistry = "docker.io"
+	// group and other permission bits which should not be set on the credentials file.
+	insecureFileModeMask = 0o077
+)
+
+// ErrAuthenticationRequired is returned when the registry rejects a pull due to missing or invalid credentials.
+var ErrAuthenticationRequired = errors.New("authentication required")
+
+// Credentials holds the login details for a registry.
+type Credentials struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// credentialsFile is the format of the registry credentials file.
+//
+// Eg:-
+//
+//	registries:
+//	  icr.io:
+//	    username: iamapikey
+//	    password: <api-key>
+type credentialsFile struct {
+	Registries map[string]Credentials `yaml:"registries"`
+}
+
+// ResolveCredentials resolves the credentials for the given registry.
+// Credentials are resolved in the below order of precedence:
+//  1. REGISTRY_USERNAME and REGISTRY_PASSWORD env (scoped to REGISTRY_URL, if set)
+//  2. credentials file set via REGISTRY_CREDENTIALS_FILE env or /etc/ai-services/registry-credentials.yaml
+//  3. existing podman login for the registry
+//
+// nil is returned if no credentials are configured, in which case the existing podman login (if any) is used.
+func ResolveCredentials(registry string) (*Credentials, error) {
+	if creds := credentialsFromEnv(registry); creds != nil {
+		return creds, nil
+	}
+
+	return credentialsFromFile(registry)
+}
+
+func credentialsFromEnv(registry string) *Credentials {
+	username, password := os.Getenv(EnvRegistryUsername), os.Getenv(EnvRegistryPassword)
+	if username == "" || password == "" {
+		return nil
+	}
+
+	if url := os.Getenv(EnvRegistryURL); url != "" && url != registry {
+		return nil
+	}
+
+	return &Credentials{Username: username, Password: password}
+}
+
+func credentialsFromFile(registry string) (*Credentials, error) {
+	path := os.Getenv(EnvRegistryCredentialsFile)
+	if path == "" {
+		path = DefaultCredentialsFile
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to stat credentials file %s: %w", path, err)
+	}
+
+	if info.Mode().Perm()&insecureFileModeMask != 0 {
+		logger.Warningf("Registry credentials file %s is accessible by other users, consider restricting it with 'chmod 600'\n", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %s: %w", path, err)
+	}
+
+	var cf credentialsFile
+	if err := yaml.Unmarshal(data, &cf); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s: %w", path, err)
+	}
+
+	creds, ok := cf.Registries[registry]
+	if !ok || creds.Username == "" || creds.Password == "" {
+		return nil, nil
+	}
+
+	return &creds, nil
+}
+
+// EnsureLogin logs into the registries of the given images for which credentials are configured.
+// Registries which already have a valid podman login are left untouched.
+func EnsureLogin(images []string) error {
+	registries := make([]string, 0, len(images))
+	for _, image := range images {
+		registries = append(registries, ImageRegistry(image))
+	}
+
+	for _, registry := range utils.UniqueSlice(registries) {
+		creds, err := ResolveCredentials(registry)
+		if err != nil {
+			return err
+		}
+
+		if creds == nil {
+			continue
+		}
+
+		if user, err := LoginUser(registry); err == nil && user == creds.Username {
+			continue
+		}
+
+		logger.Infof("Logging into registry: %s\n", registry, logger.VerbosityLevelDebug)
+
+		if err := Login(registry, creds.Username, creds.Password); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImageRegistry returns the registry host of the given image reference.
+func ImageRegistry(image string) string {
+	first, _, found := strings.Cut(image, "/")
+	if !found {
+		return defaultRegistry
+	}
+
+	if strings.ContainsAny(first, ".:") || first == "localhost" {
+		return first
+	}
+
+	return defaultRegistry
+}
+
+// IsAuthError reports whether the given pull error is caused by missing or invalid registry credentials.
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{"401", "403", "unauthorized", "authentication required", "denied"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WrapAuthError converts a pull error caused by missing or invalid credentials into ErrAuthenticationRequired.
+func WrapAuthError(image string, err error) error {
+	if !IsAuthError(err) {
+		return err
+	}
+
+	return fmt.Errorf("%w for registry '%s' while pulling image %s: please login using 'ai-services registry login %s' "+
+		"or set %s/%s env: %w", ErrAuthenticationRequired, ImageRegistry(image), image, ImageRegistry(image),
+		EnvRegistryUsername, EnvRegistryPassword, err)
+}
@@ -0,0 +1,174 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const loginRetryAttempts = 3
+
+// loginRetryDelay is the delay before the first login retry, doubled after each one.
+var loginRetryDelay = 2 * time.Second
+
+var (
+	// ErrInvalidCredentials is returned when the registry rejects the given credentials.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrRegistryUnreachable is returned when the registry cannot be reached even after retrying.
+	ErrRegistryUnreachable = errors.New("registry unreachable")
+)
+
+// authFailureMarkers are the podman login error phrases which indicate that the credentials were rejected.
+// The status codes are matched along with their reason phrase, so that e.g. a port or a digest is not mistaken for one.
+var authFailureMarkers = []string{
+	"unauthorized", "invalid username/password", "authentication required", "incorrect username or password",
+	"401 unauthorized", "403 forbidden", "access denied", "requested access to the resource is denied",
+}
+
+// transientFailureMarkers are the podman login error phrases which indicate a network or server side failure.
+var transientFailureMarkers = []string{
+	"connection refused", "connection reset", "no such host", "i/o timeout", "tls handshake timeout",
+	"context deadline exceeded", "client.timeout exceeded", "temporary failure in name resolution",
+	"network is unreachable", "unexpected eof", ": eof",
+	"500 internal server error", "502 bad gateway", "503 service unavailable", "504 gateway timeout",
+}
+
+// runLogin runs a single podman login and returns its stderr on failure.
+var runLogin = func(registry, username, password string) (string, error) {
+	cmd := exec.Command("podman", "login", "--username", username, "--password-stdin", registry)
+	cmd.Stdin = strings.NewReader(password)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+
+	return strings.TrimSpace(stderr.String()), err
+}
+
+// Login logs into the given registry via podman.
+// The password is passed through stdin so that it never shows up in the process list.
+// Network and server side failures are retried with a backoff, while rejected credentials fail right away.
+func Login(registry, username, password string) error {
+	if registry == "" || username == "" || password == "" {
+		return errors.New("registry, username and password are required for registry login")
+	}
+
+	err := utils.Retry(context.Background(), loginRetryAttempts, loginRetryDelay, utils.DoubleBackoff, func() error {
+		return login(registry, username, password)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to login to registry '%s': %w", registry, err)
+	}
+
+	logger.Infof("Login to registry '%s' succeeded\n", registry, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// login attempts the login once and classifies the failure, only the transient failures are retried.
+func login(registry, username, password string) error {
+	stderr, err := runLogin(registry, username, password)
+	if err == nil {
+		return nil
+	}
+
+	msg := strings.ToLower(stderr)
+	switch {
+	case containsAny(msg, authFailureMarkers):
+		return utils.PermanentError(fmt.Errorf("%w: %s", ErrInvalidCredentials, stderr))
+	case containsAny(msg, transientFailureMarkers):
+		return fmt.Errorf("%w: %s", ErrRegistryUnreachable, stderr)
+	default:
+		return utils.PermanentError(fmt.Errorf("%w: %s", err, stderr))
+	}
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LoginUser returns the username logged into the given registry.
+// An error is returned if there is no valid login for the registry.
+func LoginUser(registry string) (string, error) {
+	out, err := exec.Command("podman", "login", "--get-login", registry).Output()
+	if err != nil {
+		return "", fmt.Errorf("not logged into registry '%s'", registry)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// authFile is the subset of the containers auth.json file needed to list the registries.
+type authFile struct {
+	Auths map[string]json.RawMessage `json:"auths"`
+}
+
+// AuthenticatedRegistries lists the registries which have credentials stored in the podman auth files.
+func AuthenticatedRegistries() ([]string, error) {
+	registries := []string{}
+
+	for _, path := range authFilePaths() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to read auth file %s: %w", path, err)
+		}
+
+		var af authFile
+		if err := json.Unmarshal(data, &af); err != nil {
+			return nil, fmt.Errorf("failed to parse auth file %s: %w", path, err)
+		}
+
+		for registry := range af.Auths {
+			if !slices.Contains(registries, registry) {
+				registries = append(registries, registry)
+			}
+		}
+	}
+
+	slices.Sort(registries)
+
+	return registries, nil
+}
+
+// authFilePaths returns the auth files looked up by podman in the order of precedence.
+func authFilePaths() []string {
+	if path := os.Getenv("REGISTRY_AUTH_FILE"); path != "" {
+		return []string{path}
+	}
+
+	paths := []string{}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		paths = append(paths, filepath.Join(dir, "containers", "auth.json"))
+	} else {
+		paths = append(paths, filepath.Join("/run/containers", strconv.Itoa(os.Getuid()), "auth.json"))
+	}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "containers", "auth.json"))
+	}
+
+	return paths
+}
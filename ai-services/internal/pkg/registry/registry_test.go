@@ -0,0 +1,142 @@
+package registry
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	loginRetryDelay = time.Millisecond
+
+	os.Exit(m.Run())
+}
+
+// fakeLogin makes runLogin fail with the given stderr messages one after the other, and succeed afterwards.
+// It returns the count of the login attempts.
+func fakeLogin(t *testing.T, failures ...string) *int {
+	t.Helper()
+
+	attempts := 0
+	orig := runLogin
+	runLogin = func(registry, username, password string) (string, error) {
+		attempts++
+		if attempts <= len(failures) {
+			return failures[attempts-1], errors.New("exit status 125")
+		}
+
+		return "", nil
+	}
+	t.Cleanup(func() { runLogin = orig })
+
+	return &attempts
+}
+
+func TestLoginClassification(t *testing.T) {
+	tests := []struct {
+		name    string
+		stderr  string
+		wantErr error
+		// wantPermanent is set if the failure is not retried.
+		wantPermanent bool
+	}{
+		{
+			name:    "unauthorized",
+			stderr:  "Error: logging into \"icr.io\": invalid username/password",
+			wantErr: ErrInvalidCredentials, wantPermanent: true,
+		},
+		{
+			name:    "401 status",
+			stderr:  "Error: authenticating creds for \"icr.io\": received unexpected HTTP status: 401 Unauthorized",
+			wantErr: ErrInvalidCredentials, wantPermanent: true,
+		},
+		{
+			name:    "403 status",
+			stderr:  "Error: authenticating creds for \"icr.io\": received unexpected HTTP status: 403 Forbidden",
+			wantErr: ErrInvalidCredentials, wantPermanent: true,
+		},
+		{
+			name:    "500 status",
+			stderr:  "Error: authenticating creds for \"icr.io\": received unexpected HTTP status: 500 Internal Server Error",
+			wantErr: ErrRegistryUnreachable,
+		},
+		{
+			name:    "503 status",
+			stderr:  "Error: authenticating creds for \"icr.io\": received unexpected HTTP status: 503 Service Unavailable",
+			wantErr: ErrRegistryUnreachable,
+		},
+		{
+			name:    "connection refused",
+			stderr:  "Error: authenticating creds for \"localhost:5000\": pinging container registry localhost:5000: Get \"https://localhost:5000/v2/\": dial tcp [::1]:5000: connect: connection refused",
+			wantErr: ErrRegistryUnreachable,
+		},
+		{
+			name:    "unexpected eof",
+			stderr:  "Error: authenticating creds for \"icr.io\": Get \"https://icr.io/v2/\": unexpected EOF",
+			wantErr: ErrRegistryUnreachable,
+		},
+		{
+			// neither the port nor the address are mistaken for a status code
+			name:          "port resembling a status code",
+			stderr:        "Error: authenticating creds for \"10.0.0.1:5001\": pinging container registry 10.0.0.1:5001: http: server gave HTTP response to HTTPS client",
+			wantPermanent: true,
+		},
+		{
+			// "denied" alone does not mean rejected credentials
+			name:          "permission denied",
+			stderr:        "Error: open /run/containers/0/auth.json: permission denied",
+			wantPermanent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := fakeLogin(t, tt.stderr, tt.stderr, tt.stderr, tt.stderr)
+
+			err := Login("icr.io", "user", "secret")
+			if err == nil {
+				t.Fatal("Login() error = nil, want a failure")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Login() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (errors.Is(err, ErrInvalidCredentials) || errors.Is(err, ErrRegistryUnreachable)) {
+				t.Errorf("Login() error = %v, want it unclassified", err)
+			}
+
+			wantAttempts := loginRetryAttempts + 1
+			if tt.wantPermanent {
+				wantAttempts = 1
+			}
+			if *attempts != wantAttempts {
+				t.Errorf("Login() attempted %d times, want %d", *attempts, wantAttempts)
+			}
+		})
+	}
+}
+
+func TestLoginRetriesTransientFailures(t *testing.T) {
+	attempts := fakeLogin(t,
+		"Error: authenticating creds for \"icr.io\": received unexpected HTTP status: 502 Bad Gateway",
+		"Error: authenticating creds for \"icr.io\": Get \"https://icr.io/v2/\": dial tcp: lookup icr.io: i/o timeout",
+	)
+
+	if err := Login("icr.io", "user", "secret"); err != nil {
+		t.Fatalf("Login() error = %v, want the login to succeed after retrying", err)
+	}
+	if *attempts != 3 {
+		t.Errorf("Login() attempted %d times, want 3", *attempts)
+	}
+}
+
+func TestLoginRequiresCredentials(t *testing.T) {
+	attempts := fakeLogin(t)
+
+	if err := Login("icr.io", "user", ""); err == nil {
+		t.Error("Login() error = nil, want the missing password to be reported")
+	}
+	if *attempts != 0 {
+		t.Errorf("Login() attempted %d times, want no attempt", *attempts)
+	}
+}
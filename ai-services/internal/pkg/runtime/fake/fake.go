@@ -0,0 +1,533 @@
+// Package fake provides an in-memory runtime.Runtime for the unit tests, so that the application logic can be
+// exercised without a podman socket or a cluster.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// Runtime is an in-memory runtime.Runtime. The tests set up its pods and containers with AddPod, the operations
+// changing them are recorded and returned by Calls. The errors of an operation are injected with Fail, keyed by the
+// operation and its argument, e.g. "StopPod:<id>". It is safe for concurrent use.
+type Runtime struct {
+	// RuntimeType is returned by Type, podman if not set.
+	RuntimeType types.RuntimeType
+	// Routes are returned by ListRoutes.
+	Routes []types.Route
+	// Logs are the log lines of the containers returned by ContainerLogTail, keyed by the container name or ID.
+	Logs map[string][]string
+
+	// Events are the past events returned by PodEvents, and StreamedEvents the new ones emitted by StreamEvents.
+	Events         []PodEvent
+	StreamedEvents []PodEvent
+
+	// OnCreatePod, if set, handles CreatePod, e.g. to add the pods of the played manifest.
+	OnCreatePod func(body io.Reader) ([]types.Pod, error)
+	// OnStartPod, if set, is called by StartPod after the pod got marked as running, e.g. to make its containers healthy.
+	OnStartPod func(r *Runtime, id string)
+	// OnInspectContainer, if set, is called by InspectContainer before the container is returned, e.g. to change
+	// its status over time.
+	OnInspectContainer func(c *types.Container)
+	// OnContainerLogs, if set, is called by ContainerLogs to display the logs of the container, e.g. by passing
+	// its Logs through the line filter of the options.
+	OnContainerLogs func(container string, opts types.LogOptions) error
+	// OnPullImage, if set, is called by PullImage outside of the lock, e.g. to hold the pull while observing the
+	// concurrent ones.
+	OnPullImage func(image string) error
+
+	mu         sync.Mutex
+	pods       []*types.Pod
+	containers map[string]*types.Container
+	errs       map[string]error
+	// failures are the remaining failures of the operations failing only a few times, refer FailTimes.
+	failures map[string]int
+	calls    []string
+}
+
+// New returns an empty podman runtime.
+func New() *Runtime {
+	return &Runtime{RuntimeType: types.RuntimeTypePodman}
+}
+
+// AddPod adds the pod along with its containers, which are referenced by the pod by ID.
+func (r *Runtime) AddPod(pod types.Pod, containers ...types.Container) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.containers == nil {
+		r.containers = map[string]*types.Container{}
+	}
+
+	pod.Containers = nil
+	for _, container := range containers {
+		c := container
+		r.containers[c.ID] = &c
+		pod.Containers = append(pod.Containers, types.Container{ID: c.ID, Name: c.Name, Status: c.Status})
+	}
+	r.pods = append(r.pods, &pod)
+}
+
+// SetContainer replaces the state of an existing container.
+func (r *Runtime) SetContainer(container types.Container) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := container
+	r.containers[c.ID] = &c
+}
+
+// Fail makes the operation fail with err, op being of the form '<Method>:<argument>'.
+func (r *Runtime) Fail(op string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.errs == nil {
+		r.errs = map[string]error{}
+	}
+	r.errs[op] = err
+	delete(r.failures, op)
+}
+
+// FailTimes makes the next n calls of the operation fail with err, the following ones succeeding, e.g. to emulate
+// a transient failure. op is of the form '<Method>:<argument>'.
+func (r *Runtime) FailTimes(op string, n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.errs == nil {
+		r.errs = map[string]error{}
+	}
+	if r.failures == nil {
+		r.failures = map[string]int{}
+	}
+	r.errs[op] = err
+	r.failures[op] = n
+}
+
+// Calls returns the recorded operations in order, of the form '<Method>:<argument>'.
+func (r *Runtime) Calls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.calls)
+}
+
+// record records the call and returns the injected error of the operation. The caller must hold mu.
+func (r *Runtime) record(method, arg string) error {
+	op := method + ":" + arg
+	r.calls = append(r.calls, op)
+
+	return r.injected(op)
+}
+
+// injected returns the injected error of the operation, if any, counting it against its remaining failures.
+// The caller must hold mu.
+func (r *Runtime) injected(op string) error {
+	err := r.errs[op]
+	if remaining, ok := r.failures[op]; ok {
+		if remaining <= 1 {
+			delete(r.errs, op)
+			delete(r.failures, op)
+		} else {
+			r.failures[op] = remaining - 1
+		}
+	}
+
+	return err
+}
+
+// findPod returns the pod with the given ID or name. The caller must hold mu.
+func (r *Runtime) findPod(nameOrID string) *types.Pod {
+	for _, pod := range r.pods {
+		if pod.ID == nameOrID || pod.Name == nameOrID {
+			return pod
+		}
+	}
+
+	return nil
+}
+
+// podView returns a copy of the pod with the current state of its containers. The caller must hold mu.
+func (r *Runtime) podView(pod *types.Pod) types.Pod {
+	view := *pod
+	view.Containers = make([]types.Container, 0, len(pod.Containers))
+	for _, ref := range pod.Containers {
+		if c, ok := r.containers[ref.ID]; ok {
+			view.Containers = append(view.Containers, types.Container{ID: c.ID, Name: c.Name, Status: c.Status})
+		}
+	}
+
+	return view
+}
+
+// matchesLabels reports whether the labels match all the label filters, either 'key' or 'key=value'.
+func matchesLabels(labels map[string]string, filters []string) bool {
+	for _, filter := range filters {
+		key, value, hasValue := strings.Cut(filter, "=")
+		got, ok := labels[key]
+		if !ok || (hasValue && got != value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Runtime) ListImages() ([]types.Image, error) {
+	return []types.Image{}, nil
+}
+
+func (r *Runtime) PullImage(image string) error {
+	r.mu.Lock()
+	err := r.record("PullImage", image)
+	onPull := r.OnPullImage
+	r.mu.Unlock()
+
+	if err != nil || onPull == nil {
+		return err
+	}
+
+	return onPull(image)
+}
+
+func (r *Runtime) RemoveImage(nameOrID string, force bool) (*types.ImageRemoveReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &types.ImageRemoveReport{}, r.record("RemoveImage", nameOrID)
+}
+
+// ListPods supports the 'label' and 'name' filters.
+func (r *Runtime) ListPods(filters map[string][]string) ([]types.Pod, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("ListPods:"); err != nil {
+		return nil, err
+	}
+
+	out := []types.Pod{}
+	for _, pod := range r.pods {
+		if !matchesLabels(pod.Labels, filters["label"]) {
+			continue
+		}
+		if names := filters["name"]; len(names) > 0 && !slices.Contains(names, pod.Name) {
+			continue
+		}
+		out = append(out, r.podView(pod))
+	}
+
+	return out, nil
+}
+
+func (r *Runtime) CreatePod(body io.Reader) ([]types.Pod, error) {
+	r.mu.Lock()
+	err := r.record("CreatePod", "")
+	onCreate := r.OnCreatePod
+	r.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	if onCreate != nil {
+		return onCreate(body)
+	}
+
+	return []types.Pod{}, nil
+}
+
+func (r *Runtime) DeletePod(id string, force *bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.record("DeletePod", id); err != nil {
+		return err
+	}
+
+	r.pods = slices.DeleteFunc(r.pods, func(pod *types.Pod) bool { return pod.ID == id || pod.Name == id })
+
+	return nil
+}
+
+func (r *Runtime) StopPod(id string) error {
+	return r.setPodState("StopPod", id, "Exited", "exited")
+}
+
+func (r *Runtime) StartPod(id string) error {
+	if err := r.setPodState("StartPod", id, "Running", "running"); err != nil {
+		return err
+	}
+
+	if r.OnStartPod != nil {
+		r.OnStartPod(r, id)
+	}
+
+	return nil
+}
+
+// setPodState records the operation and sets the status of the pod and of its containers.
+func (r *Runtime) setPodState(method, id, podStatus, containerStatus string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.record(method, id); err != nil {
+		return err
+	}
+
+	pod := r.findPod(id)
+	if pod == nil {
+		return fmt.Errorf("no such pod: %s", id)
+	}
+
+	pod.Status = podStatus
+	pod.State = podStatus
+	for _, ref := range pod.Containers {
+		if c, ok := r.containers[ref.ID]; ok && ref.ID != pod.InfraContainerID {
+			c.Status = containerStatus
+			c.Health = ""
+		}
+	}
+
+	return nil
+}
+
+func (r *Runtime) InspectPod(nameOrID string) (*types.Pod, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("InspectPod:" + nameOrID); err != nil {
+		return nil, err
+	}
+
+	pod := r.findPod(nameOrID)
+	if pod == nil {
+		return nil, fmt.Errorf("no such pod: %s", nameOrID)
+	}
+
+	view := r.podView(pod)
+
+	return &view, nil
+}
+
+func (r *Runtime) PodExists(nameOrID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("PodExists:" + nameOrID); err != nil {
+		return false, err
+	}
+
+	return r.findPod(nameOrID) != nil, nil
+}
+
+func (r *Runtime) PodLogs(nameOrID string, opts types.LogOptions) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.record("PodLogs", nameOrID)
+}
+
+// PodEvent is an event of a pod or of one of its containers.
+type PodEvent struct {
+	types.Event
+	// Pod is the name of the pod the event relates to.
+	Pod string
+}
+
+func (r *Runtime) PodEvents(podNames []string, since time.Time) ([]types.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.record("PodEvents", strings.Join(podNames, ",")); err != nil {
+		return nil, err
+	}
+
+	events := []types.Event{}
+	for _, e := range r.Events {
+		if slices.Contains(podNames, e.Pod) && (since.IsZero() || !e.Time.Before(since)) {
+			events = append(events, e.Event)
+		}
+	}
+
+	return events, nil
+}
+
+// StreamEvents emits the StreamedEvents matching the 'pod' and 'label' filters, then blocks until ctx is done.
+func (r *Runtime) StreamEvents(ctx context.Context, filters map[string][]string, handler func(types.Event)) error {
+	r.mu.Lock()
+	if err := r.record("StreamEvents", ""); err != nil {
+		r.mu.Unlock()
+
+		return err
+	}
+
+	var events []types.Event
+	for _, e := range r.StreamedEvents {
+		if pods := filters["pod"]; len(pods) > 0 && !slices.Contains(pods, e.Pod) {
+			continue
+		}
+		if labels := filters["label"]; len(labels) > 0 {
+			if pod := r.findPod(e.Pod); pod == nil || !matchesLabels(pod.Labels, labels) {
+				continue
+			}
+		}
+		events = append(events, e.Event)
+	}
+	r.mu.Unlock()
+
+	// the handler is called without holding mu, so that it may call the runtime
+	for _, e := range events {
+		handler(e)
+	}
+	<-ctx.Done()
+
+	return nil
+}
+
+func (r *Runtime) InspectContainer(nameOrID string) (*types.Container, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("InspectContainer:" + nameOrID); err != nil {
+		return nil, err
+	}
+
+	c := r.findContainer(nameOrID)
+	if c == nil {
+		return nil, fmt.Errorf("failed to inspect container: %w: %s", errdefs.ErrContainerNotFound, nameOrID)
+	}
+
+	if r.OnInspectContainer != nil {
+		r.OnInspectContainer(c)
+	}
+	container := *c
+
+	return &container, nil
+}
+
+// findContainer returns the container with the given ID or name. The caller must hold mu.
+func (r *Runtime) findContainer(nameOrID string) *types.Container {
+	if c, ok := r.containers[nameOrID]; ok {
+		return c
+	}
+
+	for _, c := range r.containers {
+		if c.Name == nameOrID {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func (r *Runtime) ContainerExists(nameOrID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("ContainerExists:" + nameOrID); err != nil {
+		return false, err
+	}
+
+	return r.findContainer(nameOrID) != nil, nil
+}
+
+func (r *Runtime) ContainerRestartCount(containerNameOrID string) (int, error) {
+	c, err := r.InspectContainer(containerNameOrID)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.RestartCount, nil
+}
+
+func (r *Runtime) ContainerStats(containerNameOrIDs []string) ([]types.ContainerStats, error) {
+	return []types.ContainerStats{}, nil
+}
+
+// WaitContainerHealthy polls the container until it is healthy, as the podman runtime does.
+func (r *Runtime) WaitContainerHealthy(ctx context.Context, containerNameOrID string, timeout time.Duration) error {
+	r.mu.Lock()
+	err := r.record("WaitContainerHealthy", containerNameOrID)
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		c, err := r.InspectContainer(containerNameOrID)
+		if err != nil {
+			return err
+		}
+		if c.Health == "healthy" {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("container %s is %s: %w", containerNameOrID, c.Health, errdefs.ErrReadinessTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func (r *Runtime) ContainerLogs(containerNameOrID string, opts types.LogOptions) error {
+	r.mu.Lock()
+	err := r.record("ContainerLogs", containerNameOrID)
+	onLogs := r.OnContainerLogs
+	r.mu.Unlock()
+
+	if err != nil || onLogs == nil {
+		return err
+	}
+
+	return onLogs(containerNameOrID, opts)
+}
+
+func (r *Runtime) ContainerLogTail(containerNameOrID string, lines int) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("ContainerLogTail:" + containerNameOrID); err != nil {
+		return nil, err
+	}
+
+	logs := r.Logs[containerNameOrID]
+
+	return slices.Clone(logs[max(len(logs)-lines, 0):]), nil
+}
+
+func (r *Runtime) ListRoutes() ([]types.Route, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.injected("ListRoutes:"); err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(r.Routes), nil
+}
+
+func (r *Runtime) DeletePVCs(appLabel string) error {
+	return nil
+}
+
+func (r *Runtime) Type() types.RuntimeType {
+	if r.RuntimeType == "" {
+		return types.RuntimeTypePodman
+	}
+
+	return r.RuntimeType
+}
@@ -1,7 +1,9 @@
 package runtime
 
 import (
+	"context"
 	"io"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
@@ -10,6 +12,7 @@ type Runtime interface {
 	// Image operations
 	ListImages() ([]types.Image, error)
 	PullImage(image string) error
+	RemoveImage(nameOrID string, force bool) (*types.ImageRemoveReport, error)
 
 	// Pod operations
 	ListPods(filters map[string][]string) ([]types.Pod, error)
@@ -17,15 +20,29 @@ type Runtime interface {
 	DeletePod(id string, force *bool) error
 	StopPod(id string) error
 	StartPod(id string) error
+	// InspectPod returns the runtime-neutral view of the pod, including its containers and published ports.
 	InspectPod(nameOrId string) (*types.Pod, error)
+	// PodExists reports whether the pod exists, an error is only returned if the check itself failed.
 	PodExists(nameOrID string) (bool, error)
-	PodLogs(nameOrID string) error
+	PodLogs(nameOrID string, opts types.LogOptions) error
+	PodEvents(podNames []string, since time.Time) ([]types.Event, error)
+	// StreamEvents calls handler for each new event matching the filters, e.g. {"pod": [names]}, until ctx is done.
+	StreamEvents(ctx context.Context, filters map[string][]string, handler func(types.Event)) error
 
 	// Container operations
 	// ListContainers(filters map[string][]string) ([]types.Container, error)
+	// InspectContainer returns the runtime-neutral view of the container, including its health and restart count.
 	InspectContainer(nameOrId string) (*types.Container, error)
+	// ContainerExists reports whether the container exists, an error is only returned if the check itself failed.
 	ContainerExists(nameOrID string) (bool, error)
-	ContainerLogs(containerNameOrID string) error
+	ContainerRestartCount(containerNameOrID string) (int, error)
+	// ContainerStats returns a single snapshot of the resource usage of the given running containers.
+	ContainerStats(containerNameOrIDs []string) ([]types.ContainerStats, error)
+	// WaitContainerHealthy waits until the container is healthy. It returns an error wrapping errdefs.ErrReadinessTimeout
+	// if it is not healthy within the timeout, the wait is aborted once ctx is done.
+	WaitContainerHealthy(ctx context.Context, containerNameOrID string, timeout time.Duration) error
+	ContainerLogs(containerNameOrID string, opts types.LogOptions) error
+	ContainerLogTail(containerNameOrID string, lines int) ([]string, error)
 
 	// Network operations
 	ListRoutes() ([]types.Route, error)
@@ -54,8 +54,9 @@ func toOpenshiftContainerList(containers []corev1.ContainerStatus) []types.Conta
 	containerList := make([]types.Container, 0, len(containers))
 	for _, cs := range containers {
 		container := &types.Container{
-			ID:   cs.ContainerID,
-			Name: cs.Name,
+			ID:           cs.ContainerID,
+			Name:         cs.Name,
+			RestartCount: int(cs.RestartCount),
 		}
 		setContainerStatus(&cs, container)
 		containerList = append(containerList, *container)
@@ -66,9 +67,10 @@ func toOpenshiftContainerList(containers []corev1.ContainerStatus) []types.Conta
 
 func toOpenShiftContainer(cs *corev1.ContainerStatus, pod *corev1.Pod) *types.Container {
 	container := &types.Container{
-		ID:          cs.ContainerID,
-		Name:        cs.Name,
-		Annotations: pod.Annotations,
+		ID:           cs.ContainerID,
+		Name:         cs.Name,
+		Annotations:  pod.Annotations,
+		RestartCount: int(cs.RestartCount),
 	}
 	setContainerStatus(cs, container)
 
@@ -111,3 +113,18 @@ func toOpenShiftRouteList(routes []routev1.Route) []types.Route {
 
 	return routeList
 }
+
+func toEvent(e *corev1.Event) types.Event {
+	eventTime := e.LastTimestamp.Time
+	if eventTime.IsZero() {
+		eventTime = e.EventTime.Time
+	}
+
+	return types.Event{
+		Time:    eventTime,
+		Type:    e.Type,
+		Action:  e.Reason,
+		Object:  e.InvolvedObject.Name,
+		Message: e.Message,
+	}
+}
@@ -9,13 +9,18 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"syscall"
+	"time"
 
 	routeclient "github.com/openshift/client-go/route/clientset/versioned"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -30,6 +35,9 @@ import (
 
 var scheme = runtime.NewScheme()
 
+// errPodNotFound is returned when no pod matches the given name prefix or ID.
+var errPodNotFound = errors.New("cannot find pod")
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(operatorsv1alpha1.AddToScheme(scheme))
@@ -37,6 +45,9 @@ func init() {
 
 const (
 	labelPartsCount = 2 // labelPartsCount is used to split label filters in the format "key=value".
+	// healthPollInterval is the interval the container is inspected at while waiting for it to be healthy.
+	healthPollInterval = 5 * time.Second
+	healthyStatus      = "healthy"
 )
 
 // OpenshiftClient implements the Runtime interface for Openshift.
@@ -125,6 +136,11 @@ func (kc *OpenshiftClient) PullImage(image string) error {
 	return nil
 }
 
+// RemoveImage is not supported on openshift as the images on the nodes are managed by kubelet.
+func (kc *OpenshiftClient) RemoveImage(nameOrID string, force bool) (*types.ImageRemoveReport, error) {
+	return nil, fmt.Errorf("removing images is not supported for openshift runtime as the images on the nodes are managed by kubelet")
+}
+
 // ListPods lists pods with optional filters.
 func (kc *OpenshiftClient) ListPods(filters map[string][]string) ([]types.Pod, error) {
 	labels := client.MatchingLabels{}
@@ -183,8 +199,11 @@ func (kc *OpenshiftClient) InspectPod(nameOrID string) (*types.Pod, error) {
 func (kc *OpenshiftClient) PodExists(nameOrID string) (bool, error) {
 	// Since OpenShift pod names have a random string added to it we cannot use Get() here.
 	_, err := getPodNameWithPrefix(kc, nameOrID)
+	if errors.Is(err, errPodNotFound) {
+		return false, nil
+	}
 	if err != nil {
-		return false, fmt.Errorf("failed to list pods: %w", err)
+		return false, err
 	}
 
 	return true, nil
@@ -205,7 +224,7 @@ func (kc *OpenshiftClient) StartPod(id string) error {
 }
 
 // PodLogs retrieves logs from a pod.
-func (kc *OpenshiftClient) PodLogs(podNameOrID string) error {
+func (kc *OpenshiftClient) PodLogs(podNameOrID string, logOpts types.LogOptions) error {
 	podName, err := getPodNameWithPrefix(kc, podNameOrID)
 	if err != nil {
 		return fmt.Errorf("failed to get the pod: %w", err)
@@ -213,10 +232,11 @@ func (kc *OpenshiftClient) PodLogs(podNameOrID string) error {
 
 	// Defaults to only container if there is one container in the pod.
 	opts := &corev1.PodLogOptions{
-		Follow: true,
+		Follow:     true,
+		Timestamps: logOpts.Timestamps,
 	}
 
-	return followLogs(kc, podName, opts)
+	return followLogs(kc, podName, opts, logOpts.LineFilter)
 }
 
 // ListContainers lists containers (returns pods' containers in Openshift).
@@ -242,7 +262,24 @@ func (kc *OpenshiftClient) InspectContainer(nameOrID string) (*types.Container,
 		}
 	}
 
-	return nil, fmt.Errorf("cannot find container: %s", nameOrID)
+	return nil, fmt.Errorf("%w: %s", errdefs.ErrContainerNotFound, nameOrID)
+}
+
+// WaitContainerHealthy waits until the container is ready by polling its status, as there is no native wait for it.
+func (kc *OpenshiftClient) WaitContainerHealthy(ctx context.Context, containerNameOrID string, timeout time.Duration) error {
+	err := utils.Poll(ctx, healthPollInterval, timeout, func() (bool, error) {
+		container, err := kc.InspectContainer(containerNameOrID)
+		if err != nil {
+			return false, err
+		}
+
+		return container.Health == healthyStatus, nil
+	})
+	if errors.Is(err, utils.ErrPollTimeout) {
+		return fmt.Errorf("%w waiting for container %s to be healthy", errdefs.ErrReadinessTimeout, containerNameOrID)
+	}
+
+	return err
 }
 
 // ContainerExists checks if a container exists.
@@ -265,8 +302,23 @@ func (kc *OpenshiftClient) ContainerExists(nameOrID string) (bool, error) {
 	return false, nil
 }
 
+// ContainerStats is not supported on openshift yet.
+func (kc *OpenshiftClient) ContainerStats(containerNameOrIDs []string) ([]types.ContainerStats, error) {
+	return nil, fmt.Errorf("container stats are not supported for openshift runtime")
+}
+
+// ContainerRestartCount returns the number of times the container has been restarted.
+func (kc *OpenshiftClient) ContainerRestartCount(containerNameOrID string) (int, error) {
+	container, err := kc.InspectContainer(containerNameOrID)
+	if err != nil {
+		return 0, err
+	}
+
+	return container.RestartCount, nil
+}
+
 // ContainerLogs retrieves logs from a specific container.
-func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string) error {
+func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string, logOpts types.LogOptions) error {
 	if containerNameOrID == "" {
 		return fmt.Errorf("container name is required to fetch logs")
 	}
@@ -282,11 +334,12 @@ func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string) error {
 		for _, container := range pod.Spec.Containers {
 			if container.Name == containerNameOrID {
 				opts := &corev1.PodLogOptions{
-					Container: containerNameOrID,
-					Follow:    true,
+					Container:  containerNameOrID,
+					Follow:     true,
+					Timestamps: logOpts.Timestamps,
 				}
 
-				return followLogs(kc, pod.Name, opts)
+				return followLogs(kc, pod.Name, opts, logOpts.LineFilter)
 			}
 		}
 	}
@@ -294,6 +347,46 @@ func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string) error {
 	return fmt.Errorf("cannot find pod for the given container")
 }
 
+// ContainerLogTail returns the last lines of the container logs without following them.
+func (kc *OpenshiftClient) ContainerLogTail(containerNameOrID string, lines int) ([]string, error) {
+	if containerNameOrID == "" {
+		return nil, fmt.Errorf("container name is required to fetch logs")
+	}
+
+	pods := &corev1.PodList{}
+	if err := kc.Client.List(kc.Ctx, pods, client.InNamespace(kc.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to check container: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			if container.Name != containerNameOrID {
+				continue
+			}
+
+			tailLines := int64(lines)
+			opts := &corev1.PodLogOptions{
+				Container: containerNameOrID,
+				TailLines: &tailLines,
+			}
+
+			data, err := kc.KubeClient.CoreV1().Pods(kc.Namespace).GetLogs(pod.Name, opts).DoRaw(kc.Ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch logs: %w", err)
+			}
+
+			output := strings.TrimRight(string(data), "\n")
+			if output == "" {
+				return nil, nil
+			}
+
+			return strings.Split(output, "\n"), nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot find pod for the given container")
+}
+
 // ListRoutes lists all routes in the namespace.
 func (kc *OpenshiftClient) ListRoutes() ([]types.Route, error) {
 	routeList, err := kc.RouteClient.RouteV1().Routes(kc.Namespace).List(kc.Ctx, metav1.ListOptions{})
@@ -343,10 +436,10 @@ func getPodNameWithPrefix(kc *OpenshiftClient, nameOrID string) (string, error)
 		}
 	}
 
-	return "", fmt.Errorf("cannot find pod: %s", nameOrID)
+	return "", fmt.Errorf("%w: %s", errPodNotFound, nameOrID)
 }
 
-func followLogs(kc *OpenshiftClient, podName string, opts *corev1.PodLogOptions) error {
+func followLogs(kc *OpenshiftClient, podName string, opts *corev1.PodLogOptions, filter func(string) (string, bool)) error {
 	// Create interrupt-aware context (Ctrl+C)
 	ctx, stop := signal.NotifyContext(kc.Ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -367,7 +460,14 @@ func followLogs(kc *OpenshiftClient, podName string, opts *corev1.PodLogOptions)
 	scanner := bufio.NewScanner(stream)
 
 	for scanner.Scan() {
-		logger.Infoln(scanner.Text())
+		line := scanner.Text()
+		if filter != nil {
+			var ok bool
+			if line, ok = filter(line); !ok {
+				continue
+			}
+		}
+		logger.Infoln(line)
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -381,3 +481,33 @@ func followLogs(kc *OpenshiftClient, podName string, opts *corev1.PodLogOptions)
 
 	return nil
 }
+
+// PodEvents returns the events of the given pods since the given time, oldest first.
+func (kc *OpenshiftClient) PodEvents(podNames []string, since time.Time) ([]types.Event, error) {
+	eventList := &corev1.EventList{}
+	if err := kc.Client.List(kc.Ctx, eventList, client.InNamespace(kc.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	events := []types.Event{}
+	for _, e := range eventList.Items {
+		if e.InvolvedObject.Kind != "Pod" || !slices.Contains(podNames, e.InvolvedObject.Name) {
+			continue
+		}
+
+		event := toEvent(&e)
+		if event.Time.Before(since) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+
+	return events, nil
+}
+
+// StreamEvents is not supported on openshift yet.
+func (kc *OpenshiftClient) StreamEvents(ctx context.Context, filters map[string][]string, handler func(types.Event)) error {
+	return fmt.Errorf("streaming events is not supported for openshift runtime")
+}
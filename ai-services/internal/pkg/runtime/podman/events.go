@@ -0,0 +1,70 @@
+package podman
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman/v5/pkg/bindings/system"
+	podmanTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// PodEvents returns the events of the given pods and their containers since the given time, oldest first.
+func (pc *PodmanClient) PodEvents(podNames []string, since time.Time) ([]types.Event, error) {
+	if len(podNames) == 0 {
+		return []types.Event{}, nil
+	}
+
+	opts := &system.EventsOptions{
+		Filters: map[string][]string{"pod": podNames},
+		Stream:  utils.BoolPtr(false),
+	}
+	if !since.IsZero() {
+		sinceStr := since.Format(time.RFC3339)
+		opts.Since = &sinceStr
+	}
+
+	// the channel is closed by the bindings once all the events are read
+	eventChan := make(chan podmanTypes.Event)
+	if err := system.Events(pc.Context, eventChan, nil, opts); err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	events := []types.Event{}
+	for e := range eventChan {
+		events = append(events, toEvent(e))
+	}
+
+	return events, nil
+}
+
+// StreamEvents calls handler for each new event matching the filters until ctx is done. The filters are the ones
+// of podman events, e.g. pod, label, type or event.
+func (pc *PodmanClient) StreamEvents(ctx context.Context, filters map[string][]string, handler func(types.Event)) error {
+	opts := &system.EventsOptions{
+		Filters: filters,
+		Stream:  utils.BoolPtr(true),
+	}
+
+	// closing the cancel channel closes the event stream, upon which the bindings close the event channel
+	cancelChan := make(chan bool)
+	stop := context.AfterFunc(ctx, func() { close(cancelChan) })
+	defer stop()
+
+	eventChan := make(chan podmanTypes.Event)
+	if err := system.Events(pc.Context, eventChan, cancelChan, opts); err != nil {
+		return fmt.Errorf("failed to stream events: %w", err)
+	}
+
+	for e := range eventChan {
+		handler(toEvent(e))
+	}
+
+	if ctx.Err() == nil {
+		return fmt.Errorf("event stream closed unexpectedly")
+	}
+
+	return nil
+}
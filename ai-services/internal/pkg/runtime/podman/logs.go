@@ -0,0 +1,247 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/containers/podman/v5/pkg/bindings/containers"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// PodLogs follows the logs of all the containers of a pod.
+// For pods with more than one container, each line is prefixed with the '[container]' name.
+func (pc *PodmanClient) PodLogs(podNameOrID string, opts types.LogOptions) error {
+	if podNameOrID == "" {
+		return errors.New("pod name or ID cannot be empty")
+	}
+
+	pod, err := pc.InspectPod(podNameOrID)
+	if err != nil {
+		return err
+	}
+
+	podContainers := make([]types.Container, 0, len(pod.Containers))
+	for _, container := range pod.Containers {
+		// skip the infra container as it does not produce any logs
+		if container.ID != pod.InfraContainerID {
+			podContainers = append(podContainers, container)
+		}
+	}
+
+	// Creating context here that listens for Ctrl+C
+	ctx, stop := signal.NotifyContext(pc.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(podContainers))
+
+	for _, container := range podContainers {
+		prefix := ""
+		if len(podContainers) > 1 {
+			prefix = "[" + container.Name + "] "
+		}
+
+		wg.Add(1)
+		go func(id, prefix string) {
+			defer wg.Done()
+			if err := pc.followContainerLogs(ctx, id, prefix, opts); err != nil {
+				errCh <- err
+			}
+		}(container.ID, prefix)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	var errs []error
+	for err := range errCh {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ContainerLogs follows the logs of a container.
+func (pc *PodmanClient) ContainerLogs(containerNameOrID string, opts types.LogOptions) error {
+	if containerNameOrID == "" {
+		return fmt.Errorf("container name or ID required to fetch logs")
+	}
+
+	// Creating context here that listens for Ctrl+C
+	ctx, stop := signal.NotifyContext(pc.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return pc.followContainerLogs(ctx, containerNameOrID, "", opts)
+}
+
+// ContainerLogTail returns the last lines of the container logs without following them.
+func (pc *PodmanClient) ContainerLogTail(containerNameOrID string, lines int) ([]string, error) {
+	if containerNameOrID == "" {
+		return nil, fmt.Errorf("container name or ID required to fetch logs")
+	}
+
+	stdoutChan := make(chan string)
+	stderrChan := make(chan string)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	var output strings.Builder
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			case chunk := <-stdoutChan:
+				output.WriteString(chunk)
+			case chunk := <-stderrChan:
+				output.WriteString(chunk)
+			}
+		}
+	}()
+
+	logOpts := &containers.LogOptions{
+		Follow: utils.BoolPtr(false),
+		Stderr: utils.BoolPtr(true),
+		Stdout: utils.BoolPtr(true),
+		Tail:   utils.StringPtr(strconv.Itoa(lines)),
+	}
+
+	err := containers.Logs(pc.Context, containerNameOrID, logOpts, stdoutChan, stderrChan)
+	close(stop)
+	<-done
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch logs for container %s: %w", containerNameOrID, err)
+	}
+
+	return lastLines(output.String(), lines), nil
+}
+
+// lastLines splits the output into lines and returns at most the last n of them.
+func lastLines(output string, n int) []string {
+	all := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(all) == 1 && all[0] == "" {
+		return nil
+	}
+	if len(all) > n {
+		all = all[len(all)-n:]
+	}
+
+	return all
+}
+
+func (pc *PodmanClient) followContainerLogs(ctx context.Context, containerNameOrID, prefix string, opts types.LogOptions) error {
+	stdoutChan := make(chan string)
+	stderrChan := make(chan string)
+
+	logOpts := &containers.LogOptions{
+		Follow:     utils.BoolPtr(true),
+		Stderr:     utils.BoolPtr(true),
+		Stdout:     utils.BoolPtr(true),
+		Timestamps: utils.BoolPtr(opts.Timestamps),
+	}
+
+	stdout := newLinePrefixer(prefix, opts.LineFilter, func(line string) { logger.Infoln(line) })
+	stderr := newLinePrefixer(prefix, opts.LineFilter, logger.Errorln)
+
+	// Channel to signal goroutine completion
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		defer stdout.Flush()
+		defer stderr.Flush()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case chunk, ok := <-stdoutChan:
+				if !ok {
+					return
+				}
+				stdout.Write(chunk)
+			case chunk, ok := <-stderrChan:
+				if !ok {
+					return
+				}
+				stderr.Write(chunk)
+			}
+		}
+	}()
+
+	err := containers.Logs(ctx, containerNameOrID, logOpts, stdoutChan, stderrChan)
+	close(stdoutChan)
+	<-done
+	if ctx.Err() == context.Canceled || ctx.Err() == context.DeadlineExceeded {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to fetch logs for container %s: %w", containerNameOrID, err)
+	}
+
+	return nil
+}
+
+// linePrefixer prefixes each line of a log stream, after applying the optional line filter.
+// The stream can deliver partial or multiple lines at once, hence the prefix is only added at line boundaries
+// and partial lines are buffered until they are complete, so that multi-line output like stack traces stays intact.
+type linePrefixer struct {
+	prefix  string
+	pending strings.Builder
+	filter  func(string) (string, bool)
+	emit    func(string)
+}
+
+func newLinePrefixer(prefix string, filter func(string) (string, bool), emit func(string)) *linePrefixer {
+	return &linePrefixer{prefix: prefix, filter: filter, emit: emit}
+}
+
+// emitLine filters the line and emits it with the prefix.
+func (l *linePrefixer) emitLine(line string) {
+	if l.filter != nil {
+		var ok bool
+		if line, ok = l.filter(line); !ok {
+			return
+		}
+	}
+
+	l.emit(l.prefix + line)
+}
+
+// Write emits all the complete lines of the chunk along with the previously buffered partial line.
+func (l *linePrefixer) Write(chunk string) {
+	l.pending.WriteString(chunk)
+	data := l.pending.String()
+
+	for {
+		i := strings.IndexByte(data, '\n')
+		if i == -1 {
+			break
+		}
+		l.emitLine(strings.TrimSuffix(data[:i], "\r"))
+		data = data[i+1:]
+	}
+
+	l.pending.Reset()
+	l.pending.WriteString(data)
+}
+
+// Flush emits the buffered partial line, if any.
+func (l *linePrefixer) Flush() {
+	if l.pending.Len() == 0 {
+		return
+	}
+
+	l.emitLine(l.pending.String())
+	l.pending.Reset()
+}
@@ -0,0 +1,111 @@
+package podman
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLinePrefixer(t *testing.T) {
+	skipDebug := func(line string) (string, bool) {
+		if strings.Contains(line, "DEBUG") {
+			return "", false
+		}
+
+		return line, true
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		filter func(string) (string, bool)
+		chunks []string
+		want   []string
+	}{
+		{
+			name:   "line per chunk",
+			prefix: "[vllm] ",
+			chunks: []string{"starting\n", "ready\n"},
+			want:   []string{"[vllm] starting", "[vllm] ready"},
+		},
+		{
+			name:   "lines split across chunks",
+			prefix: "[vllm] ",
+			chunks: []string{"sta", "rting\nrea", "dy\n"},
+			want:   []string{"[vllm] starting", "[vllm] ready"},
+		},
+		{
+			name:   "stack trace in one chunk",
+			prefix: "[ui] ",
+			chunks: []string{"Traceback (most recent call last):\n  File \"app.py\", line 1\nValueError: boom\n"},
+			want:   []string{"[ui] Traceback (most recent call last):", "[ui]   File \"app.py\", line 1", "[ui] ValueError: boom"},
+		},
+		{
+			name:   "carriage returns",
+			prefix: "[ui] ",
+			chunks: []string{"one\r\ntwo\r\n"},
+			want:   []string{"[ui] one", "[ui] two"},
+		},
+		{
+			name:   "partial line flushed",
+			prefix: "[ui] ",
+			chunks: []string{"done\nexiting"},
+			want:   []string{"[ui] done", "[ui] exiting"},
+		},
+		{
+			name:   "no prefix",
+			chunks: []string{"starting\n"},
+			want:   []string{"starting"},
+		},
+		{
+			name:   "filtered",
+			prefix: "[vllm] ",
+			filter: skipDebug,
+			chunks: []string{"DEBUG loading\nINFO ready\n", "DEBUG done"},
+			want:   []string{"[vllm] INFO ready"},
+		},
+		{
+			name:   "empty lines kept",
+			prefix: "[vllm] ",
+			chunks: []string{"a\n\nb\n"},
+			want:   []string{"[vllm] a", "[vllm] ", "[vllm] b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			l := newLinePrefixer(tt.prefix, tt.filter, func(line string) { got = append(got, line) })
+			for _, chunk := range tt.chunks {
+				l.Write(chunk)
+			}
+			l.Flush()
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("lines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		n      int
+		want   []string
+	}{
+		{name: "empty", output: "", n: 3, want: nil},
+		{name: "fewer lines", output: "a\nb\n", n: 3, want: []string{"a", "b"}},
+		{name: "more lines", output: "a\nb\nc\nd\n", n: 2, want: []string{"c", "d"}},
+		{name: "no trailing newline", output: "a\nb", n: 1, want: []string{"b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lastLines(tt.output, tt.n); !slices.Equal(got, tt.want) {
+				t.Errorf("lastLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
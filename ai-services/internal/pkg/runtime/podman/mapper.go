@@ -1,29 +1,45 @@
 package podman
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/containers/podman/v5/libpod/define"
 	podmanTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
 // toPodsList - convert podman pods to desired type.
-func toPodsList(input any) []types.Pod {
+// An unsupported input type, e.g. after the podman bindings changed their return type, is returned as an error.
+func toPodsList(input any) ([]types.Pod, error) {
 	switch val := input.(type) {
 	case []*podmanTypes.ListPodsReport:
 		out := make([]types.Pod, 0, len(val))
 		for _, r := range val {
+			containers, err := toPodContainerList(r.Containers)
+			if err != nil {
+				return nil, err
+			}
+
 			out = append(out, types.Pod{
 				ID:         r.Id,
 				Name:       r.Name,
 				Status:     r.Status,
 				Labels:     r.Labels,
-				Containers: toPodContainerList(r.Containers),
+				Containers: containers,
+				Created:    r.Created,
 			})
 		}
 
-		return out
+		return out, nil
 
 	case *podmanTypes.KubePlayReport:
+		if val == nil {
+			return []types.Pod{}, nil
+		}
+
 		out := make([]types.Pod, 0, len(val.Pods))
 		for _, r := range val.Pods {
 			out = append(out, types.Pod{
@@ -31,15 +47,18 @@ func toPodsList(input any) []types.Pod {
 			})
 		}
 
-		return out
+		return out, nil
 
 	default:
-		panic("unsupported type to do mapper to podList")
+		logger.Infof("unsupported type to do mapper to podList: %T\n", input, logger.VerbosityLevelDebug)
+
+		return nil, fmt.Errorf("unsupported type to do mapper to podList: %T", input)
 	}
 }
 
 // toPodContainerList - convert podman pod containers to desired type.
-func toPodContainerList(input any) []types.Container {
+// An unsupported input type is returned as an error, see toPodsList.
+func toPodContainerList(input any) ([]types.Container, error) {
 	switch val := input.(type) {
 	case []*podmanTypes.ListPodContainer:
 		out := make([]types.Container, 0, len(val))
@@ -51,7 +70,7 @@ func toPodContainerList(input any) []types.Container {
 			})
 		}
 
-		return out
+		return out, nil
 
 	case []define.InspectPodContainerInfo:
 		out := make([]types.Container, 0, len(val))
@@ -63,10 +82,12 @@ func toPodContainerList(input any) []types.Container {
 			})
 		}
 
-		return out
+		return out, nil
 
 	default:
-		panic("unsupported type to do mapper to pod containers list")
+		logger.Infof("unsupported type to do mapper to pod containers list: %T\n", input, logger.VerbosityLevelDebug)
+
+		return nil, fmt.Errorf("unsupported type to do mapper to pod containers list: %T", input)
 	}
 }
 
@@ -84,30 +105,55 @@ func toPodContainerList(input any) []types.Container {
 // 	return out
 // }
 
+// toEvent - convert podman event to desired type.
+func toEvent(input podmanTypes.Event) types.Event {
+	event := types.Event{
+		Time:    time.Unix(0, input.TimeNano),
+		Type:    string(input.Type),
+		Action:  string(input.Action),
+		Object:  input.Actor.Attributes["name"],
+		Message: input.HealthStatus,
+	}
+
+	if exitCode, ok := input.Actor.Attributes["containerExitCode"]; ok && event.Message == "" {
+		event.Message = "exit code: " + exitCode
+	}
+
+	return event
+}
+
 // toImageList - convert podman image type to desired type.
 func toImageList(input []*podmanTypes.ImageSummary) []types.Image {
 	out := make([]types.Image, 0, len(input))
 	for _, r := range input {
 		out = append(out, types.Image{
+			ID:          r.ID,
 			RepoTags:    r.RepoTags,
 			RepoDigests: r.RepoDigests,
+			Size:        r.Size,
+			Created:     time.Unix(r.Created, 0),
 		})
 	}
 
 	return out
 }
 
-func toPodInspectReport(input *podmanTypes.PodInspectReport) *types.Pod {
+func toPodInspectReport(input *podmanTypes.PodInspectReport) (*types.Pod, error) {
+	containers, err := toPodContainerList(input.Containers)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.Pod{
 		ID:               input.ID,
 		Name:             input.Name,
 		Labels:           input.Labels,
-		Containers:       toPodContainerList(input.Containers),
+		Containers:       containers,
 		Ports:            toPortBindings(input.InfraConfig),
 		InfraContainerID: input.InfraContainerID,
 		State:            input.State,
 		Created:          input.Created,
-	}
+	}, nil
 }
 
 func toPortBindings(infraConfig *define.InspectPodInfraConfig) map[string][]string {
@@ -126,14 +172,20 @@ func toPortBindings(infraConfig *define.InspectPodInfraConfig) map[string][]stri
 
 func toInspectContainer(input *define.InspectContainerData) *types.Container {
 	container := &types.Container{
-		ID:     input.ID,
-		Name:   input.Name,
-		Status: input.State.Status,
+		ID:           input.ID,
+		Name:         input.Name,
+		ImageID:      input.Image,
+		Status:       input.State.Status,
+		RestartCount: int(input.RestartCount),
 	}
 
-	// Set health status if available
+	// Set health status and the outcome of the last health check if available
 	if input.State.Health != nil {
 		container.Health = input.State.Health.Status
+		if log := input.State.Health.Log; len(log) > 0 {
+			last := log[len(log)-1]
+			container.HealthLog = fmt.Sprintf("exit code %d: %s", last.ExitCode, strings.TrimSpace(last.Output))
+		}
 	}
 
 	// Set annotations if available
@@ -146,5 +198,29 @@ func toInspectContainer(input *define.InspectContainerData) *types.Container {
 		container.HealthcheckStartPeriod = input.Config.Healthcheck.StartPeriod
 	}
 
+	// Set env if available, the entries are in the 'KEY=value' form
+	if input.Config != nil && len(input.Config.Env) > 0 {
+		container.Env = make(map[string]string, len(input.Config.Env))
+		for _, entry := range input.Config.Env {
+			key, value, _ := strings.Cut(entry, "=")
+			container.Env[key] = value
+		}
+	}
+
 	return container
 }
+
+// toContainerStats - convert podman container stats to desired type.
+func toContainerStats(input []define.ContainerStats) []types.ContainerStats {
+	out := make([]types.ContainerStats, 0, len(input))
+	for _, s := range input {
+		out = append(out, types.ContainerStats{
+			Name:       s.Name,
+			CPUPercent: s.CPU,
+			MemUsage:   s.MemUsage,
+			MemLimit:   s.MemLimit,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,216 @@
+package podman
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/podman/v5/libpod/define"
+	podmanTypes "github.com/containers/podman/v5/pkg/domain/entities/types"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestToPodsList(t *testing.T) {
+	created := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   any
+		want    []types.Pod
+		wantErr string
+	}{
+		{
+			name: "pod list",
+			input: []*podmanTypes.ListPodsReport{{
+				Id: "pod-id", Name: "app--vllm", Status: "Running", Created: created,
+				Labels:     map[string]string{"ai-services.io/application": "app"},
+				Containers: []*podmanTypes.ListPodContainer{{Id: "c-id", Names: "app--vllm-server", Status: "running"}},
+			}},
+			want: []types.Pod{{
+				ID: "pod-id", Name: "app--vllm", Status: "Running", Created: created,
+				Labels:     map[string]string{"ai-services.io/application": "app"},
+				Containers: []types.Container{{ID: "c-id", Name: "app--vllm-server", Status: "running"}},
+			}},
+		},
+		{name: "empty pod list", input: []*podmanTypes.ListPodsReport{}, want: []types.Pod{}},
+		{name: "nil pod list", input: []*podmanTypes.ListPodsReport(nil), want: []types.Pod{}},
+		{
+			name:  "kube play report",
+			input: &podmanTypes.KubePlayReport{Pods: []podmanTypes.PlayKubePod{{ID: "pod-1"}, {ID: "pod-2"}}},
+			want:  []types.Pod{{ID: "pod-1"}, {ID: "pod-2"}},
+		},
+		{name: "nil kube play report", input: (*podmanTypes.KubePlayReport)(nil), want: []types.Pod{}},
+		{name: "nil", input: nil, wantErr: "unsupported type to do mapper to podList: <nil>"},
+		{name: "unsupported type", input: []podmanTypes.ListPodsReport{}, wantErr: "unsupported type to do mapper to podList: []types.ListPodsReport"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toPodsList(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("toPodsList() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("toPodsList() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toPodsList() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToPodContainerList(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   any
+		want    []types.Container
+		wantErr string
+	}{
+		{
+			name:  "pod list containers",
+			input: []*podmanTypes.ListPodContainer{{Id: "c-id", Names: "app--vllm-server", Status: "running"}},
+			want:  []types.Container{{ID: "c-id", Name: "app--vllm-server", Status: "running"}},
+		},
+		{
+			name:  "pod inspect containers",
+			input: []define.InspectPodContainerInfo{{ID: "c-id", Name: "app--vllm-server", State: "running"}},
+			want:  []types.Container{{ID: "c-id", Name: "app--vllm-server", Status: "running"}},
+		},
+		{name: "nil", input: nil, wantErr: "unsupported type to do mapper to pod containers list: <nil>"},
+		{name: "unsupported type", input: []string{"c-id"}, wantErr: "unsupported type to do mapper to pod containers list: []string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toPodContainerList(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("toPodContainerList() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("toPodContainerList() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toPodContainerList() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToPodInspectReport(t *testing.T) {
+	created := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	input := &podmanTypes.PodInspectReport{InspectPodData: &define.InspectPodData{
+		ID: "pod-id", Name: "app--chat-bot", State: "Running", Created: created, InfraContainerID: "infra-id",
+		Labels: map[string]string{"ai-services.io/application": "app"},
+		InfraConfig: &define.InspectPodInfraConfig{PortBindings: map[string][]define.InspectHostPort{
+			"3000/tcp": {{HostIP: "0.0.0.0", HostPort: "8080"}},
+			"5000/tcp": {{HostPort: "5000"}, {HostPort: "5001"}},
+		}},
+		Containers: []define.InspectPodContainerInfo{{ID: "infra-id", Name: "infra", State: "running"}, {ID: "ui-id", Name: "app--chat-bot-ui", State: "running"}},
+	}}
+
+	got, err := toPodInspectReport(input)
+	if err != nil {
+		t.Fatalf("toPodInspectReport() error = %v", err)
+	}
+
+	want := &types.Pod{
+		ID: "pod-id", Name: "app--chat-bot", State: "Running", Created: created, InfraContainerID: "infra-id",
+		Labels: map[string]string{"ai-services.io/application": "app"},
+		Ports:  map[string][]string{"3000/tcp": {"8080"}, "5000/tcp": {"5000", "5001"}},
+		Containers: []types.Container{
+			{ID: "infra-id", Name: "infra", Status: "running"},
+			{ID: "ui-id", Name: "app--chat-bot-ui", Status: "running"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("toPodInspectReport() = %+v, want %+v", got, want)
+	}
+}
+
+func TestToInspectContainer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *define.InspectContainerData
+		want  *types.Container
+	}{
+		{
+			name: "minimal",
+			input: &define.InspectContainerData{
+				ID: "c-id", Name: "app--vllm-server", Image: "image-id", RestartCount: 2,
+				State: &define.InspectContainerState{Status: "running"},
+			},
+			want: &types.Container{ID: "c-id", Name: "app--vllm-server", ImageID: "image-id", Status: "running", RestartCount: 2},
+		},
+		{
+			name: "health, config and env",
+			input: &define.InspectContainerData{
+				ID: "c-id", Name: "app--vllm-server",
+				State: &define.InspectContainerState{Status: "running", Health: &define.HealthCheckResults{
+					Status: "unhealthy",
+					Log:    []define.HealthCheckLog{{ExitCode: 0, Output: "ok"}, {ExitCode: 7, Output: "connection refused\n"}},
+				}},
+				Config: &define.InspectContainerConfig{
+					Annotations: map[string]string{"ai-services.io/model": "granite"},
+					Healthcheck: &manifest.Schema2HealthConfig{StartPeriod: time.Minute},
+					Env:         []string{"PORT=8000", "ARGS=--x=1", "EMPTY"},
+				},
+			},
+			want: &types.Container{
+				ID: "c-id", Name: "app--vllm-server", Status: "running",
+				Health: "unhealthy", HealthLog: "exit code 7: connection refused",
+				Annotations:            map[string]string{"ai-services.io/model": "granite"},
+				HealthcheckStartPeriod: time.Minute,
+				Env:                    map[string]string{"PORT": "8000", "ARGS": "--x=1", "EMPTY": ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toInspectContainer(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toInspectContainer() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToContainerStats(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []define.ContainerStats
+		want  []types.ContainerStats
+	}{
+		{name: "none", want: []types.ContainerStats{}},
+		{
+			name: "usage",
+			input: []define.ContainerStats{
+				{ContainerID: "c-id", Name: "app--vllm-server", CPU: 312.5, MemUsage: 48 << 30, MemLimit: 64 << 30, PIDs: 120},
+				{ContainerID: "d-id", Name: "app--opensearch", CPU: 0, MemUsage: 1 << 30, MemLimit: 0},
+			},
+			want: []types.ContainerStats{
+				{Name: "app--vllm-server", CPUPercent: 312.5, MemUsage: 48 << 30, MemLimit: 64 << 30},
+				{Name: "app--opensearch", MemUsage: 1 << 30},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toContainerStats(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toContainerStats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,27 +5,52 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
-	"os/signal"
-	"syscall"
+	"time"
 
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/bindings"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/containers/podman/v5/pkg/bindings/images"
 	"github.com/containers/podman/v5/pkg/bindings/kube"
 	"github.com/containers/podman/v5/pkg/bindings/pods"
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
+// errWaitTimeout is the cause of an exceeded WaitContainerHealthy timeout.
+var errWaitTimeout = errors.New("wait timeout exceeded")
+
+// DefaultSocketTimeout is the default time allowed to connect to the podman socket.
+const DefaultSocketTimeout = 10 * time.Second
+
+// SocketTimeout bounds the connection to the podman socket including its retries, zero means no timeout.
+var SocketTimeout = DefaultSocketTimeout
+
+var (
+	// socketRetryInterval is the delay between the connection attempts, the socket may still be starting up
+	// right after 'bootstrap configure' enabled it. It is a variable so that the tests can shorten it.
+	socketRetryInterval = 2 * time.Second
+
+	// newConnection establishes the bindings connection, a variable so that the tests can stub the socket.
+	newConnection = bindings.NewConnection
+)
+
 type PodmanClient struct {
 	Context context.Context
 }
 
 // NewPodmanClient creates and returns a new PodmanClient instance.
 func NewPodmanClient() (*PodmanClient, error) {
+	return NewPodmanClientWithContext(context.Background())
+}
+
+// NewPodmanClientWithContext creates a PodmanClient whose calls are bound to the given context.
+func NewPodmanClientWithContext(parent context.Context) (*PodmanClient, error) {
 	// Default Podman socket URI is unix:///run/podman/podman.sock running on the local machine,
 	// but it can be overridden by the CONTAINER_HOST and CONTAINER_SSHKEY environment variable to support remote connections.
 	// Please use `podman system connection list` to see available connections.
@@ -37,7 +62,7 @@ func NewPodmanClient() (*PodmanClient, error) {
 	if v, found := os.LookupEnv("CONTAINER_HOST"); found {
 		uri = v
 	}
-	ctx, err := bindings.NewConnection(context.Background(), uri)
+	ctx, err := connect(parent, uri)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +70,64 @@ func NewPodmanClient() (*PodmanClient, error) {
 	return &PodmanClient{Context: ctx}, nil
 }
 
+// connect establishes the bindings connection, retrying until SocketTimeout so that a socket which is slow
+// to accept does not hang the command.
+func connect(parent context.Context, uri string) (context.Context, error) {
+	if SocketTimeout <= 0 {
+		return newConnection(parent, uri)
+	}
+
+	var (
+		conn    context.Context
+		connErr error
+	)
+
+	deadline := time.Now().Add(SocketTimeout)
+	err := utils.Poll(parent, socketRetryInterval, SocketTimeout, func() (bool, error) {
+		conn, connErr = connectWithTimeout(parent, uri, time.Until(deadline))
+		if connErr != nil {
+			logger.Infof("Podman socket is not ready: %v\n", connErr, logger.VerbosityLevelDebug)
+		}
+
+		return connErr == nil, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the podman socket '%s' within %s, "+
+			"make sure podman is set up using 'ai-services bootstrap configure' or increase --socket-timeout: %w", uri, SocketTimeout, errors.Join(err, connErr))
+	}
+
+	return conn, nil
+}
+
+// connectWithTimeout gives up on the connection attempt after the timeout. The bindings connection cannot be bound
+// to a context with a deadline, as the connection context is used for all the later calls, hence it is raced instead.
+func connectWithTimeout(parent context.Context, uri string, timeout time.Duration) (context.Context, error) {
+	type connection struct {
+		ctx context.Context
+		err error
+	}
+
+	connCh := make(chan connection, 1)
+	// the attempt outlives a timeout, hence it must not read newConnection once abandoned
+	newConn := newConnection
+	go func() {
+		ctx, err := newConn(parent, uri)
+		connCh <- connection{ctx: ctx, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case conn := <-connCh:
+		return conn.ctx, conn.err
+	case <-timer.C:
+		return nil, fmt.Errorf("connection attempt timed out after %s", timeout.Round(time.Millisecond))
+	case <-parent.Done():
+		return nil, parent.Err()
+	}
+}
+
 // ListImages function to list images (you can expand with more Podman functionalities).
 func (pc *PodmanClient) ListImages() ([]types.Image, error) {
 	images, err := images.List(pc.Context, nil)
@@ -66,6 +149,23 @@ func (pc *PodmanClient) PullImage(image string) error {
 	return nil
 }
 
+// RemoveImage removes the given image, force also removes the containers using it.
+func (pc *PodmanClient) RemoveImage(nameOrID string, force bool) (*types.ImageRemoveReport, error) {
+	report, errs := images.Remove(pc.Context, []string{nameOrID}, &images.RemoveOptions{Force: &force})
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to remove image %s: %w", nameOrID, errors.Join(errs...))
+	}
+
+	if report == nil {
+		return nil, fmt.Errorf("failed to remove image %s: empty report", nameOrID)
+	}
+
+	return &types.ImageRemoveReport{
+		Deleted:  report.Deleted,
+		Untagged: report.Untagged,
+	}, nil
+}
+
 func (pc *PodmanClient) ListPods(filters map[string][]string) ([]types.Pod, error) {
 	var listOpts pods.ListOptions
 
@@ -78,7 +178,7 @@ func (pc *PodmanClient) ListPods(filters map[string][]string) ([]types.Pod, erro
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
 
-	return toPodsList(podList), nil
+	return toPodsList(podList)
 }
 
 func (pc *PodmanClient) CreatePod(body io.Reader) ([]types.Pod, error) {
@@ -87,7 +187,7 @@ func (pc *PodmanClient) CreatePod(body io.Reader) ([]types.Pod, error) {
 		return nil, fmt.Errorf("failed to execute podman kube play: %w", err)
 	}
 
-	return toPodsList(kubeReport), nil
+	return toPodsList(kubeReport)
 }
 
 func (pc *PodmanClient) DeletePod(id string, force *bool) error {
@@ -102,6 +202,10 @@ func (pc *PodmanClient) DeletePod(id string, force *bool) error {
 func (pc *PodmanClient) InspectContainer(nameOrId string) (*types.Container, error) {
 	stats, err := containers.Inspect(pc.Context, nameOrId, nil)
 	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("failed to inspect container: %w: %s", errdefs.ErrContainerNotFound, nameOrId)
+		}
+
 		return nil, fmt.Errorf("failed to inspect container: %w", err)
 	}
 
@@ -112,6 +216,64 @@ func (pc *PodmanClient) InspectContainer(nameOrId string) (*types.Container, err
 	return toInspectContainer(stats), nil
 }
 
+// ContainerStats returns a single snapshot of the resource usage of the given running containers.
+func (pc *PodmanClient) ContainerStats(containerNameOrIDs []string) ([]types.ContainerStats, error) {
+	statsChan, err := containers.Stats(pc.Context, containerNameOrIDs, &containers.StatsOptions{Stream: utils.BoolPtr(false)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch container stats: %w", err)
+	}
+
+	// the channel is closed by the bindings once all the reports are read, hence read it to the end even on errors
+	stats := []types.ContainerStats{}
+	var statsErr error
+	for report := range statsChan {
+		if report.Error != nil {
+			statsErr = report.Error
+
+			continue
+		}
+		stats = append(stats, toContainerStats(report.Stats)...)
+	}
+
+	if statsErr != nil {
+		return nil, fmt.Errorf("failed to fetch container stats: %w", statsErr)
+	}
+
+	return stats, nil
+}
+
+// ContainerRestartCount returns the number of times the container has been restarted.
+func (pc *PodmanClient) ContainerRestartCount(containerNameOrID string) (int, error) {
+	container, err := pc.InspectContainer(containerNameOrID)
+	if err != nil {
+		return 0, err
+	}
+
+	return container.RestartCount, nil
+}
+
+// WaitContainerHealthy waits until the container is healthy using the native wait of podman on the healthy condition,
+// so that the wait returns as soon as the health check passes instead of polling the container.
+func (pc *PodmanClient) WaitContainerHealthy(ctx context.Context, containerNameOrID string, timeout time.Duration) error {
+	// the bindings need the connection of the client context, ctx only bounds the wait
+	waitCtx, cancel := context.WithTimeoutCause(pc.Context, timeout, errWaitTimeout)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	opts := &containers.WaitOptions{Conditions: []string{define.HealthCheckHealthy}}
+	if _, err := containers.Wait(waitCtx, containerNameOrID, opts); err != nil {
+		if errors.Is(context.Cause(waitCtx), errWaitTimeout) {
+			return fmt.Errorf("%w waiting for container %s to be healthy", errdefs.ErrReadinessTimeout, containerNameOrID)
+		}
+
+		return fmt.Errorf("failed to wait for container: %w", err)
+	}
+
+	return nil
+}
+
 // func (pc *PodmanClient) ListContainers(filters map[string][]string) ([]types.Container, error) {
 // 	var listOpts containers.ListOptions
 
@@ -171,87 +333,13 @@ func (pc *PodmanClient) InspectPod(nameOrID string) (*types.Pod, error) {
 		return nil, fmt.Errorf("failed to inspect the pod: %w", err)
 	}
 
-	return toPodInspectReport(podInspectReport), nil
-}
-
-func (pc *PodmanClient) PodLogs(podNameOrID string) error {
-	if podNameOrID == "" {
-		return errors.New("pod name or ID cannot be empty")
-	}
-
-	ctx, cancel := context.WithCancel(pc.Context)
-	defer cancel()
-
-	//nolint:godox
-	// TODO: fetch pods logs via sdk way
-	cmdExec := exec.CommandContext(pc.Context, "podman", "pod", "logs", "-f", podNameOrID)
-	cmdExec.Stdout = os.Stdout
-	cmdExec.Stderr = os.Stderr
-
-	err := cmdExec.Run()
-
-	// If context was cancelled (Ctrl+C), don't treat it as an error
-	if ctx.Err() == context.Canceled {
-		return nil
-	}
-
-	return err
+	return toPodInspectReport(podInspectReport)
 }
 
 func (pc *PodmanClient) PodExists(nameOrID string) (bool, error) {
 	return pods.Exists(pc.Context, nameOrID, nil)
 }
 
-func (pc *PodmanClient) ContainerLogs(containerNameOrID string) error {
-	if containerNameOrID == "" {
-		return fmt.Errorf("container name or ID required to fetch logs")
-	}
-
-	// Creating context here that listens for Ctrl+C
-	ctx, stop := signal.NotifyContext(pc.Context, os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
-	stdoutChan := make(chan string)
-	stderrChan := make(chan string)
-
-	opts := &containers.LogOptions{
-		Follow: utils.BoolPtr(true),
-		Stderr: utils.BoolPtr(true),
-		Stdout: utils.BoolPtr(true),
-	}
-
-	// Channel to signal goroutine completion
-	done := make(chan struct{})
-
-	go func() {
-		defer close(done)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case line, ok := <-stdoutChan:
-				if !ok {
-					return
-				}
-				logger.Infoln(line)
-			case line, ok := <-stderrChan:
-				if !ok {
-					return
-				}
-				logger.Errorln(line)
-			}
-		}
-	}()
-
-	err := containers.Logs(ctx, containerNameOrID, opts, stdoutChan, stderrChan)
-	<-done
-	if ctx.Err() == context.Canceled || ctx.Err() == context.DeadlineExceeded {
-		return nil
-	}
-
-	return err
-}
-
 func (pc *PodmanClient) ContainerExists(nameOrID string) (bool, error) {
 	return containers.Exists(pc.Context, nameOrID, nil)
 }
@@ -272,3 +360,10 @@ func (pc *PodmanClient) DeletePVCs(appLabel string) error {
 func (pc *PodmanClient) Type() types.RuntimeType {
 	return types.RuntimeTypePodman
 }
+
+// isNotFound reports whether the error of the bindings is a not found response of the podman API.
+func isNotFound(err error) bool {
+	var apiErr interface{ Code() int }
+
+	return errors.As(err, &apiErr) && apiErr.Code() == http.StatusNotFound
+}
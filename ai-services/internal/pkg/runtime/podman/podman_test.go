@@ -0,0 +1,139 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// useSocketTimeout shortens the connection timeout and the delay between the connection attempts for the test,
+// counting the attempts made through the given connection func.
+func useSocketTimeout(t *testing.T, timeout time.Duration, connFn func(ctx context.Context, uri string) (context.Context, error)) *atomic.Int32 {
+	t.Helper()
+
+	origTimeout, origInterval, origConn := SocketTimeout, socketRetryInterval, newConnection
+	t.Cleanup(func() {
+		SocketTimeout, socketRetryInterval, newConnection = origTimeout, origInterval, origConn
+	})
+
+	var attempts atomic.Int32
+	SocketTimeout = timeout
+	socketRetryInterval = 20 * time.Millisecond
+	newConnection = func(ctx context.Context, uri string) (context.Context, error) {
+		attempts.Add(1)
+
+		return connFn(ctx, uri)
+	}
+
+	return &attempts
+}
+
+// TestConnectUnreachable connects to a socket which does not exist, asserting that the connection is retried
+// until the socket timeout and then fails with an error referring to 'bootstrap configure'. The bindings take
+// about 600ms to give up on a connection attempt, hence the timeout allows for a few of them.
+func TestConnectUnreachable(t *testing.T) {
+	const timeout = 1500 * time.Millisecond
+	attempts := useSocketTimeout(t, timeout, newConnection)
+	uri := "unix://" + filepath.Join(t.TempDir(), "podman.sock")
+
+	start := time.Now()
+	_, err := connect(context.Background(), uri)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("connect() error = nil, want the socket unreachable")
+	}
+	if !errors.Is(err, utils.ErrPollTimeout) {
+		t.Errorf("connect() error = %v, want it to wrap %v", err, utils.ErrPollTimeout)
+	}
+	for _, want := range []string{uri, "within 1.5s", "ai-services bootstrap configure", "--socket-timeout"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("connect() error = %v, want it to contain %q", err, want)
+		}
+	}
+	if got := attempts.Load(); got < 2 {
+		t.Errorf("connection attempts = %d, want the connection retried until the timeout", got)
+	}
+	if elapsed < timeout || elapsed > timeout+time.Second {
+		t.Errorf("connect() took %s, want about the socket timeout of %s", elapsed, timeout)
+	}
+}
+
+// TestConnectRetry asserts that a socket which is not ready yet, e.g. right after 'bootstrap configure' enabled it,
+// is connected to once it accepts the connection.
+func TestConnectRetry(t *testing.T) {
+	type ctxKey struct{}
+
+	attempts := useSocketTimeout(t, time.Second, nil)
+	newConnection = func(ctx context.Context, uri string) (context.Context, error) {
+		if attempts.Add(1) < 3 {
+			return nil, errors.New("connection refused")
+		}
+
+		return context.WithValue(ctx, ctxKey{}, uri), nil
+	}
+
+	conn, err := connect(context.Background(), "unix:///run/podman/podman.sock")
+	if err != nil {
+		t.Fatalf("connect() error = %v", err)
+	}
+	if conn.Value(ctxKey{}) == nil {
+		t.Error("connect() did not return the established connection")
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("connection attempts = %d, want 3", got)
+	}
+}
+
+// TestConnectWithTimeout asserts that a connection attempt hanging on a socket which does not accept it is given
+// up on after the timeout, or as soon as the parent context is done.
+func TestConnectWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	useSocketTimeout(t, time.Second, func(ctx context.Context, uri string) (context.Context, error) {
+		<-release
+
+		return nil, errors.New("released")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		_, err := connectWithTimeout(context.Background(), "unix:///run/podman/podman.sock", 50*time.Millisecond)
+		if err == nil || !strings.Contains(err.Error(), "connection attempt timed out after 50ms") {
+			t.Errorf("connectWithTimeout() error = %v, want the attempt timed out", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("connectWithTimeout() took %s, want it to give up after the timeout", elapsed)
+		}
+	})
+
+	t.Run("parent cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := connectWithTimeout(ctx, "unix:///run/podman/podman.sock", time.Minute)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("connectWithTimeout() error = %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("hanging socket bounded by the socket timeout", func(t *testing.T) {
+		SocketTimeout = 100 * time.Millisecond
+
+		start := time.Now()
+		_, err := connect(context.Background(), "unix:///run/podman/podman.sock")
+		if err == nil || !strings.Contains(err.Error(), "connection attempt timed out") {
+			t.Errorf("connect() error = %v, want the attempt timed out", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("connect() took %s, want it bounded by the socket timeout of %s", elapsed, SocketTimeout)
+		}
+	})
+}
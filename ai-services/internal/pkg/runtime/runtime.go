@@ -1,14 +1,24 @@
 package runtime
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
+// baseContext is the context the runtime clients are created with.
+var baseContext = context.Background()
+
+// SetContext sets the context the runtime clients are created with, cancelling it aborts the in-flight runtime calls.
+func SetContext(ctx context.Context) {
+	baseContext = ctx
+}
+
 // RuntimeFactory creates runtime instances based on configuration.
 type RuntimeFactory struct {
 	runtimeType types.RuntimeType
@@ -36,9 +46,9 @@ func CreateRuntime(runtimeType types.RuntimeType, namespace string) (Runtime, er
 	switch runtimeType {
 	case types.RuntimeTypePodman:
 		logger.Infof("Initializing Podman runtime\n", logger.VerbosityLevelDebug)
-		client, err := podman.NewPodmanClient()
+		client, err := podman.NewPodmanClientWithContext(baseContext)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create Podman client: %w", err)
+			return nil, &errdefs.RuntimeError{Op: errdefs.OpConnect, Err: fmt.Errorf("failed to create Podman client: %w", err)}
 		}
 
 		return client, nil
@@ -47,8 +57,9 @@ func CreateRuntime(runtimeType types.RuntimeType, namespace string) (Runtime, er
 		logger.Infof("Initializing OpenShift runtime\n", logger.VerbosityLevelDebug)
 		client, err := openshift.NewOpenshiftClientWithNamespace(namespace)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create OpenShift client: %w", err)
+			return nil, &errdefs.RuntimeError{Op: errdefs.OpConnect, Err: fmt.Errorf("failed to create OpenShift client: %w", err)}
 		}
+		client.Ctx = baseContext
 
 		return client, nil
 
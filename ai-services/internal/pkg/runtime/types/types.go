@@ -40,15 +40,38 @@ type Pod struct {
 type Container struct {
 	ID                     string `json:"ID"`
 	Name                   string
+	ImageID                string
 	Status                 string
 	Health                 string
 	Annotations            map[string]string
 	HealthcheckStartPeriod time.Duration
+	RestartCount           int
+	Env                    map[string]string
+
+	// HealthLog is the outcome of the last health check run, empty if none ran yet.
+	HealthLog string
+}
+
+// ContainerStats is a snapshot of the resource usage of a container.
+type ContainerStats struct {
+	Name       string  `json:"name"`
+	CPUPercent float64 `json:"cpuPercent"`
+	MemUsage   uint64  `json:"memUsage"`
+	MemLimit   uint64  `json:"memLimit"`
 }
 
 type Image struct {
+	ID          string
 	RepoTags    []string
 	RepoDigests []string
+	Size        int64
+	Created     time.Time
+}
+
+// ImageRemoveReport describes the images removed by RemoveImage.
+type ImageRemoveReport struct {
+	Deleted  []string `json:"deleted"`
+	Untagged []string `json:"untagged"`
 }
 
 type Route struct {
@@ -56,3 +79,21 @@ type Route struct {
 	HostPort   string
 	TargetPort string
 }
+
+// Event represents an event of a pod or its containers.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+	Action  string    `json:"action"`
+	Object  string    `json:"object"`
+	Message string    `json:"message,omitempty"`
+}
+
+// LogOptions holds the options for fetching the pod and container logs.
+type LogOptions struct {
+	// Timestamps prefixes each log line with its RFC3339 timestamp.
+	Timestamps bool
+	// LineFilter, if set, is applied to each log line before it is displayed. It returns the line to display,
+	// which may span multiple lines, and whether the line is displayed at all.
+	LineFilter func(line string) (string, bool)
+}
@@ -0,0 +1,157 @@
+package specs
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+// Container scoped annotation names accepted by the annotation overrides file.
+const (
+	spyreCardsAnnotation = "spyre-cards"
+	memoryAnnotation     = "memory"
+	cpuAnnotation        = "cpu"
+)
+
+// portsAnnotationRegex accepts the comma separated '[hostPort:]containerPort' mappings of the ports annotation.
+var portsAnnotationRegex = regexp.MustCompile(`^\s*[0-9]*:?[0-9]+\s*(,\s*[0-9]*:?[0-9]+\s*)*$`)
+
+// LoadAnnotationOverrides reads the annotation overrides file and returns the annotations to set keyed by pod,
+// the pod being named without the '<app>--' prefix. The file maps either a pod to its annotations or a
+// '<pod>.<container>' to the container scoped annotations 'spyre-cards', 'memory' and 'cpu', eg:-
+//
+//	chat-bot:
+//	  ai-services.io/ports: "3001:3000,5001:5000"
+//	vllm-server.instruct:
+//	  spyre-cards: 2
+//	  memory: 64Gi
+//
+// Only the Spyre, ports and resources annotations can be overridden.
+func LoadAnnotationOverrides(path string) (map[string]map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read annotations file %s: %w", path, err)
+	}
+
+	entries := map[string]map[string]any{}
+	if err := k8syaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse annotations file %s: %w", path, err)
+	}
+
+	overrides := map[string]map[string]string{}
+	for _, entry := range slices.Sorted(maps.Keys(entries)) {
+		pod, container, _ := strings.Cut(entry, ".")
+		if pod == "" {
+			return nil, fmt.Errorf("invalid entry '%s' in annotations file, it must be '<pod>' or '<pod>.<container>'", entry)
+		}
+
+		if overrides[pod] == nil {
+			overrides[pod] = map[string]string{}
+		}
+
+		for name, rawValue := range entries[entry] {
+			value := fmt.Sprint(rawValue)
+
+			key, err := annotationKey(container, name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid annotation '%s' of '%s': %w", name, entry, err)
+			}
+
+			if err := validateAnnotationValue(key, value); err != nil {
+				return nil, fmt.Errorf("invalid annotation '%s' of '%s': %w", name, entry, err)
+			}
+
+			overrides[pod][key] = value
+		}
+	}
+
+	return overrides, nil
+}
+
+// annotationKey resolves the annotation name of a pod or container entry into the full annotation key.
+func annotationKey(container, name string) (string, error) {
+	if container != "" {
+		switch name {
+		case spyreCardsAnnotation:
+			return fmt.Sprintf("ai-services.io/%s--spyre-cards", container), nil
+		case memoryAnnotation, cpuAnnotation:
+			return constants.ResourcesAnnotationPrefix + container + "." + name, nil
+		default:
+			return "", fmt.Errorf("supported container annotations are: %s, %s, %s", spyreCardsAnnotation, memoryAnnotation, cpuAnnotation)
+		}
+	}
+
+	if name == constants.PodPortsAnnotationKey || vars.SpyreCardAnnotationRegex.MatchString(name) ||
+		strings.HasPrefix(name, constants.ResourcesAnnotationPrefix) {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("only the %s, '<container>--spyre-cards' and %s<container>.<memory|cpu> annotations can be overridden",
+		constants.PodPortsAnnotationKey, constants.ResourcesAnnotationPrefix)
+}
+
+// validateAnnotationValue validates the values which are not validated later on, the resources are validated
+// along with the ones declared by the templates.
+func validateAnnotationValue(key, value string) error {
+	switch {
+	case key == constants.PodPortsAnnotationKey:
+		if !portsAnnotationRegex.MatchString(value) {
+			return fmt.Errorf("invalid ports '%s', it must be comma separated [hostPort:]containerPort mappings", value)
+		}
+	case vars.SpyreCardAnnotationRegex.MatchString(key):
+		if count, err := strconv.Atoi(value); err != nil || count < 0 {
+			return fmt.Errorf("invalid spyre card count '%s', it must be a non negative number", value)
+		}
+	}
+
+	return nil
+}
+
+// ApplyAnnotationOverrides sets the overridden annotations of the pod on its spec, the containers the annotations
+// refer to must be part of the pod.
+func ApplyAnnotationOverrides(podSpec *models.PodSpec, appName string, overrides map[string]map[string]string) error {
+	// pods are named as '<qualified app name>--<pod>' in the templates
+	pod, ok := strings.CutPrefix(podSpec.Name, vars.QualifiedAppName(appName)+"--")
+	if !ok || len(overrides[pod]) == 0 {
+		return nil
+	}
+
+	containerNames := FetchContainerNames(*podSpec)
+	for key := range overrides[pod] {
+		container := annotationContainer(key)
+		if container != "" && !slices.Contains(containerNames, container) {
+			return fmt.Errorf("annotation '%s' refers to container '%s' which is not part of pod '%s'", key, container, pod)
+		}
+	}
+
+	if podSpec.Annotations == nil {
+		podSpec.Annotations = map[string]string{}
+	}
+	maps.Copy(podSpec.Annotations, overrides[pod])
+
+	return nil
+}
+
+// annotationContainer returns the container a container scoped annotation refers to.
+func annotationContainer(key string) string {
+	if matches := vars.SpyreCardAnnotationRegex.FindStringSubmatch(key); matches != nil {
+		return matches[1]
+	}
+
+	if name, ok := strings.CutPrefix(key, constants.ResourcesAnnotationPrefix); ok {
+		container, _, _ := strings.Cut(name, ".")
+
+		return container
+	}
+
+	return ""
+}
@@ -0,0 +1,151 @@
+package specs
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestLoadAnnotationOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]map[string]string
+		wantErr string
+	}{
+		{
+			name: "pod and container annotations",
+			content: `
+chat-bot:
+  ai-services.io/ports: "3001:3000,5001:5000"
+vllm-server:
+  ai-services.io/resources.vllm.cpu: "4"
+vllm-server.instruct:
+  spyre-cards: 2
+  memory: 64Gi
+`,
+			want: map[string]map[string]string{
+				"chat-bot": {"ai-services.io/ports": "3001:3000,5001:5000"},
+				"vllm-server": {
+					"ai-services.io/resources.vllm.cpu":        "4",
+					"ai-services.io/instruct--spyre-cards":     "2",
+					"ai-services.io/resources.instruct.memory": "64Gi",
+				},
+			},
+		},
+		{name: "empty file", content: "", want: map[string]map[string]string{}},
+		{name: "malformed yaml", content: "chat-bot: [", wantErr: "failed to parse annotations file"},
+		{name: "missing pod", content: ".instruct:\n  cpu: 2\n", wantErr: "invalid entry '.instruct'"},
+		{name: "unsupported pod annotation", content: "chat-bot:\n  ai-services.io/application: other\n", wantErr: "only the ai-services.io/ports"},
+		{name: "unsupported container annotation", content: "vllm-server.instruct:\n  gpu: 1\n", wantErr: "supported container annotations are"},
+		{name: "invalid ports", content: "chat-bot:\n  ai-services.io/ports: ui\n", wantErr: "invalid ports 'ui'"},
+		{name: "negative spyre cards", content: "vllm-server.instruct:\n  spyre-cards: -1\n", wantErr: "invalid spyre card count '-1'"},
+		{name: "non numeric spyre cards", content: "vllm-server:\n  ai-services.io/instruct--spyre-cards: two\n", wantErr: "invalid spyre card count 'two'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "annotations.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := LoadAnnotationOverrides(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("LoadAnnotationOverrides() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadAnnotationOverrides() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadAnnotationOverrides() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyAnnotationOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		podName   string
+		overrides map[string]map[string]string
+		want      map[string]string
+		wantErr   string
+	}{
+		{
+			name:    "merged over the template annotations",
+			podName: "app--vllm-server",
+			overrides: map[string]map[string]string{
+				"vllm-server": {"ai-services.io/instruct--spyre-cards": "2", "ai-services.io/resources.instruct.memory": "64Gi"},
+			},
+			want: map[string]string{
+				"ai-services.io/instruct--spyre-cards":     "2",
+				"ai-services.io/resources.instruct.memory": "64Gi",
+				"ai-services.io/ports":                     "8000",
+			},
+		},
+		{
+			name:      "namespaced pod",
+			namespace: "team",
+			podName:   "team--app--vllm-server",
+			overrides: map[string]map[string]string{"vllm-server": {"ai-services.io/ports": "8001:8000"}},
+			want:      map[string]string{"ai-services.io/instruct--spyre-cards": "1", "ai-services.io/ports": "8001:8000"},
+		},
+		{
+			name:      "other pod untouched",
+			podName:   "app--vllm-server",
+			overrides: map[string]map[string]string{"chat-bot": {"ai-services.io/ports": "3001:3000"}},
+			want:      map[string]string{"ai-services.io/instruct--spyre-cards": "1", "ai-services.io/ports": "8000"},
+		},
+		{
+			name:      "unknown container",
+			podName:   "app--vllm-server",
+			overrides: map[string]map[string]string{"vllm-server": {"ai-services.io/embed--spyre-cards": "1"}},
+			wantErr:   "container 'embed' which is not part of pod 'vllm-server'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := vars.Namespace
+			vars.Namespace = tt.namespace
+			t.Cleanup(func() { vars.Namespace = orig })
+
+			podSpec := &models.PodSpec{Pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        tt.podName,
+					Annotations: map[string]string{"ai-services.io/instruct--spyre-cards": "1", "ai-services.io/ports": "8000"},
+				},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "instruct"}}},
+			}}
+
+			err := ApplyAnnotationOverrides(podSpec, "app", tt.overrides)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ApplyAnnotationOverrides() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyAnnotationOverrides() error = %v", err)
+			}
+			if !reflect.DeepEqual(podSpec.Annotations, tt.want) {
+				t.Errorf("annotations = %v, want %v", podSpec.Annotations, tt.want)
+			}
+		})
+	}
+}
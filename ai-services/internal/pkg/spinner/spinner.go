@@ -4,16 +4,46 @@ import (
 	"context"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/yarlson/pin"
 )
 
+// asciiMarkers replaces the spinner and the glyphs by plain ASCII markers, eg:- via the --ascii flag.
+var asciiMarkers bool
+
+// UseASCIIMarkers makes the spinners log their messages with the [OK]/[FAIL]/[WARN] markers instead of animating.
+func UseASCIIMarkers() {
+	asciiMarkers = true
+}
+
+// logWithMarker logs the message prefixed with the given marker when the ASCII markers are enabled, the marker then
+// replaces the level prefix. Otherwise the message is logged using the given log func.
+func logWithMarker(marker, message string, log func(string)) {
+	if !asciiMarkers {
+		log(message)
+
+		return
+	}
+
+	logger.Infoln(marker + " " + message)
+}
+
 type Spinner struct {
 	p      *pin.Pin
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
+// New creates a spinner with the given message.
+// The spinner animation is disabled in JSON log mode, when color is disabled or when the ASCII markers are used,
+// where the messages are logged instead.
 func New(message string) *Spinner {
+	if logger.IsJSONFormat() || !utils.ColorEnabled() || asciiMarkers {
+		logger.Infoln(message)
+
+		return &Spinner{}
+	}
+
 	p := pin.New(message,
 		pin.WithDoneSymbol('✔'),
 		pin.WithDoneSymbolColor(pin.ColorGreen),
@@ -28,6 +58,9 @@ func New(message string) *Spinner {
 
 func (s *Spinner) Start(ctx context.Context) {
 	s.ctx = ctx
+	if s.p == nil {
+		return
+	}
 	s.cancel = s.p.Start(ctx)
 }
 
@@ -35,6 +68,11 @@ func (s *Spinner) Stop(message string) {
 	if s.cancel != nil {
 		s.cancel()
 	}
+	if s.p == nil {
+		logWithMarker("[OK]", message, func(msg string) { logger.Infoln(msg) })
+
+		return
+	}
 	s.p.Stop(message)
 }
 
@@ -42,10 +80,33 @@ func (s *Spinner) Fail(message string) {
 	if s.cancel != nil {
 		s.cancel()
 	}
+	if s.p == nil {
+		logWithMarker("[FAIL]", message, logger.Errorln)
+
+		return
+	}
 	s.p.Fail(message)
 }
 
+// Warn stops the spinner marking the step as passed with a warning.
+func (s *Spinner) Warn(message string) {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.p == nil {
+		logWithMarker("[WARN]", message, logger.Warningln)
+
+		return
+	}
+	s.p.Stop("Warning: " + message)
+}
+
 func (s *Spinner) UpdateMessage(message string) {
+	if s.p == nil {
+		logger.Infoln(message)
+
+		return
+	}
 	s.p.UpdateMessage(message)
 }
 
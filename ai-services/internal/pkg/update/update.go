@@ -0,0 +1,118 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// EnvCheckUpdates enables the update check when set to true, same as the --check-updates flag.
+	EnvCheckUpdates = "AI_SERVICES_CHECK_UPDATES"
+	// EnvOffline disables the update check entirely when set to true, same as the --offline flag.
+	EnvOffline = "AI_SERVICES_OFFLINE"
+	// EnvReleaseEndpoint overrides the endpoint queried for the latest release.
+	EnvReleaseEndpoint = "AI_SERVICES_RELEASE_ENDPOINT"
+
+	// DefaultReleaseEndpoint returns the latest release in the GitHub releases API format.
+	DefaultReleaseEndpoint = "https://api.github.com/repos/project-ai-services/ai-services/releases/latest"
+
+	checkTimeout = 3 * time.Second
+)
+
+var (
+	// CheckUpdates enables the check for a newer release, it is opt-in.
+	CheckUpdates = envEnabled(EnvCheckUpdates)
+	// Offline disables the update check entirely, meant for the disconnected/air-gapped hosts.
+	Offline = envEnabled(EnvOffline)
+)
+
+// release is the subset of the release returned by the release endpoint.
+type release struct {
+	TagName string `json:"tag_name"`
+}
+
+func envEnabled(env string) bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(env))
+
+	return enabled
+}
+
+// CheckInBackground checks for a newer release without blocking the caller.
+// The returned function logs a warning if a newer release exists, it waits for the check to complete
+// for at most the check timeout and never fails. It is a no-op if the check is disabled.
+func CheckInBackground(currentVersion string) func() {
+	if !CheckUpdates || Offline {
+		return func() {}
+	}
+
+	result := make(chan string, 1)
+	go func() {
+		latest, err := LatestNewerVersion(currentVersion)
+		if err != nil {
+			logger.Infof("Skipping update check: %v\n", err, logger.VerbosityLevelDebug)
+		}
+		result <- latest
+	}()
+
+	return func() {
+		select {
+		case latest := <-result:
+			if latest != "" {
+				logger.Warningf("A newer version %s is available (current: %s)\n", latest, currentVersion)
+			}
+		case <-time.After(checkTimeout):
+		}
+	}
+}
+
+// LatestNewerVersion queries the release endpoint and returns the latest version if it is newer than the current one.
+// Empty string is returned if the current version is the latest or cannot be compared, eg:- dev builds.
+func LatestNewerVersion(currentVersion string) (string, error) {
+	current, err := utils.ParseVersion(currentVersion)
+	if err != nil {
+		return "", nil
+	}
+
+	endpoint := os.Getenv(EnvReleaseEndpoint)
+	if endpoint == "" {
+		endpoint = DefaultReleaseEndpoint
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to query the release endpoint: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status from the release endpoint: %s", resp.Status)
+	}
+
+	var r release
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return "", fmt.Errorf("failed to parse the release: %w", err)
+	}
+
+	latest, err := utils.ParseVersion(r.TagName)
+	if err != nil || utils.CompareVersions(latest, current) <= 0 {
+		return "", nil
+	}
+
+	return r.TagName, nil
+}
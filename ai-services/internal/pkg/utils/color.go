@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/term"
+)
+
+// EnvNoColor disables the colored output when set to a non-empty value, see https://no-color.org.
+const EnvNoColor = "NO_COLOR"
+
+// colorEnabled is the single place deciding whether the output is styled, all the packages must honor it.
+// Color is enabled by default only if NO_COLOR env is not set and the logs (stderr) are written to a terminal.
+var colorEnabled = os.Getenv(EnvNoColor) == "" && term.IsTerminal(int(os.Stderr.Fd()))
+
+// DisableColor disables the colored output, eg:- via the --no-color flag.
+func DisableColor() {
+	colorEnabled = false
+}
+
+// ColorEnabled reports whether the output can be styled with colors.
+func ColorEnabled() bool {
+	return colorEnabled
+}
+
+// Colorize renders the text with the given foreground color (hex or ANSI code) if color is enabled.
+func Colorize(text, color string) string {
+	if !colorEnabled {
+		return text
+	}
+
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(text)
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// envNameRegex accepts the POSIX environment variable names, eg:- LOG_LEVEL, _FLAG1.
+var envNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// LoadEnvFile reads the KEY=VALUE pairs of an env file, one per line. Blank lines and lines starting with '#' are
+// ignored, and the value is taken as is up to the end of the line, i.e. neither quotes nor '$' references are processed.
+// A key defined more than once takes its last value. A malformed line fails the load with its line number.
+func LoadEnvFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read env file %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	env := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid line %d in env file %s: expected KEY=VALUE", lineNo, path)
+		}
+
+		key = strings.TrimSpace(key)
+		if !envNameRegex.MatchString(key) {
+			return nil, fmt.Errorf("invalid line %d in env file %s: '%s' is not a valid variable name", lineNo, path, key)
+		}
+		env[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read env file %s: %w", path, err)
+	}
+
+	return env, nil
+}
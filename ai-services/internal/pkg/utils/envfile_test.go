@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadEnvFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:    "pairs",
+			content: "LOG_LEVEL=debug\n_FLAG1=on\n",
+			want:    map[string]string{"LOG_LEVEL": "debug", "_FLAG1": "on"},
+		},
+		{
+			name:    "blank lines and comments",
+			content: "# endpoints\n\n  \nENDPOINT=http://10.0.0.5:8000\n  # LOG_LEVEL=debug\n",
+			want:    map[string]string{"ENDPOINT": "http://10.0.0.5:8000"},
+		},
+		{
+			name:    "value taken as is",
+			content: "OPTS=--a=1 --b=2\nQUOTED=\"x\"\nREF=$HOME\nEMPTY=\n",
+			want:    map[string]string{"OPTS": "--a=1 --b=2", "QUOTED": `"x"`, "REF": "$HOME", "EMPTY": ""},
+		},
+		{name: "spaces around the key", content: "  LOG_LEVEL =debug\n", want: map[string]string{"LOG_LEVEL": "debug"}},
+		{name: "last value wins", content: "LOG_LEVEL=info\nLOG_LEVEL=debug\n", want: map[string]string{"LOG_LEVEL": "debug"}},
+		{name: "empty", content: "", want: map[string]string{}},
+		{name: "missing separator", content: "LOG_LEVEL=debug\nFLAG\n", wantErr: "invalid line 2"},
+		{name: "invalid name", content: "1FLAG=on\n", wantErr: "invalid line 1 in env file"},
+		{name: "empty name", content: "=on\n", wantErr: "'' is not a valid variable name"},
+		{name: "export prefix", content: "export FLAG=on\n", wantErr: "'export FLAG' is not a valid variable name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.env")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := LoadEnvFile(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadEnvFile() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadEnvFile() error = %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("LoadEnvFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadEnvFile(filepath.Join(t.TempDir(), "missing.env")); err == nil || !strings.Contains(err.Error(), "failed to read env file") {
+		t.Errorf("LoadEnvFile() error = %v, want the missing file reported", err)
+	}
+}
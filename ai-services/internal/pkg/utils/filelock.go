@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+const (
+	lockDirPerm  = 0o755
+	lockFilePerm = 0o600
+)
+
+// FileLock is an exclusive advisory lock (flock) on a file, which is shared by all the processes of the host.
+// The kernel releases the lock if the process exits, hence a crashed process does not leave a stale lock behind.
+type FileLock struct {
+	file *os.File
+}
+
+// LockFile acquires the lock on the file at path, creating the file if needed. If another process holds the lock,
+// onWait is called once and the lock is retried every interval until it is acquired or ctx is done.
+func LockFile(ctx context.Context, path string, interval time.Duration, onWait func()) (*FileLock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), lockDirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create the lock directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, lockFilePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the lock file: %w", err)
+	}
+
+	waiting := false
+	err = Poll(ctx, interval, 0, func() (bool, error) {
+		err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, syscall.EWOULDBLOCK) {
+			return false, err
+		}
+
+		if !waiting && onWait != nil {
+			onWait()
+		}
+		waiting = true
+
+		return false, nil
+	})
+	if err != nil {
+		_ = file.Close()
+
+		return nil, fmt.Errorf("failed to lock '%s': %w", path, err)
+	}
+
+	return &FileLock{file: file}, nil
+}
+
+// Unlock releases the lock, it is a no-op on a nil lock.
+func (l *FileLock) Unlock() error {
+	if l == nil {
+		return nil
+	}
+
+	if err := syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN); err != nil {
+		_ = l.file.Close()
+
+		return fmt.Errorf("failed to unlock '%s': %w", l.file.Name(), err)
+	}
+
+	return l.file.Close()
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// formatFuncs are the helper functions available to the --format templates.
+var formatFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// ParseFormat parses the given Go template used to format the command output.
+// Besides the builtin template functions, 'join', 'upper' and 'lower' helpers are available.
+//
+// Eg:- '{{.PodName}} {{.Status}}' or '{{join .Containers ","}}'.
+func ParseFormat(format string) (*template.Template, error) {
+	tmpl, err := template.New("format").Funcs(formatFuncs).Option("missingkey=error").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// ExecuteFormat executes the format template against data and writes the result followed by a newline to w.
+func ExecuteFormat(w io.Writer, tmpl *template.Template, data any) error {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return fmt.Errorf("failed to execute format template: %w", err)
+	}
+
+	_, err := fmt.Fprintln(w, strings.TrimSuffix(sb.String(), "\n"))
+
+	return err
+}
+
+// sizeUnits are the binary units used by HumanSize.
+var sizeUnits = []string{"B", "KiB", "MiB", "GiB", "TiB"}
+
+// bytesPerUnit is the factor between two consecutive size units.
+const bytesPerUnit = 1024
+
+// HumanSize formats the given number of bytes into a human-readable string.
+// For Eg:- "512 B", "1.5 GiB", etc.
+func HumanSize(bytes int64) string {
+	size := float64(bytes)
+	unit := 0
+	for size >= bytesPerUnit && unit < len(sizeUnits)-1 {
+		size /= bytesPerUnit
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", bytes, sizeUnits[unit])
+	}
+
+	return fmt.Sprintf("%.1f %s", size, sizeUnits[unit])
+}
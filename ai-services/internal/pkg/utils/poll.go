@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrPollTimeout is returned by Poll when the condition is not met within the timeout.
+var ErrPollTimeout = errors.New("polling timed out")
+
+// ConditionFunc reports whether the polled condition is met, an error stops the polling right away.
+type ConditionFunc func() (bool, error)
+
+// Poll checks the condition right away and then every interval, until it is met, it returns an error,
+// the timeout elapses or the context is done. A zero timeout polls until the context is done, while a negative one
+// checks the condition just once. The last wait is shortened to the remaining time, so that Poll never overshoots the timeout.
+func Poll(ctx context.Context, interval, timeout time.Duration, condition ConditionFunc) error {
+	var deadline time.Time
+	if timeout != 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		done, err := condition()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		wait := interval
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return fmt.Errorf("%w after %s", ErrPollTimeout, timeout)
+			}
+			wait = min(wait, remaining)
+		}
+
+		if err := Sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Sleep pauses for the given duration, returning early with the context error if the context is done meanwhile.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPoll(t *testing.T) {
+	errCondition := errors.New("condition failed")
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		timeout  time.Duration
+		// metAfter is the number of the check meeting the condition, zero never meets it.
+		metAfter int
+		// failAt is the number of the check failing, zero never fails.
+		failAt     int
+		wantErr    error
+		wantChecks int
+	}{
+		{name: "met right away", interval: time.Hour, timeout: time.Second, metAfter: 1, wantChecks: 1},
+		{name: "met after a few checks", interval: time.Millisecond, timeout: time.Second, metAfter: 3, wantChecks: 3},
+		{name: "condition error stops polling", interval: time.Millisecond, timeout: time.Second, failAt: 2, wantErr: errCondition, wantChecks: 2},
+		{name: "negative timeout checks once", interval: time.Millisecond, timeout: -1, wantErr: ErrPollTimeout, wantChecks: 1},
+		{name: "zero interval polls until met", timeout: time.Second, metAfter: 5, wantChecks: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := 0
+			err := Poll(context.Background(), tt.interval, tt.timeout, func() (bool, error) {
+				checks++
+				if checks == tt.failAt {
+					return false, errCondition
+				}
+
+				return checks == tt.metAfter, nil
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Poll() error = %v, want %v", err, tt.wantErr)
+			}
+			if checks != tt.wantChecks {
+				t.Errorf("Poll() checked the condition %d times, want %d", checks, tt.wantChecks)
+			}
+		})
+	}
+}
+
+func TestPollTimeout(t *testing.T) {
+	const timeout = 20 * time.Millisecond
+
+	start := time.Now()
+	err := Poll(context.Background(), time.Hour, timeout, func() (bool, error) { return false, nil })
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, ErrPollTimeout) {
+		t.Fatalf("Poll() error = %v, want %v", err, ErrPollTimeout)
+	}
+	// the wait is shortened to the remaining time instead of the hour long interval
+	if elapsed < timeout || elapsed > time.Second {
+		t.Errorf("Poll() timed out after %s, want about %s", elapsed, timeout)
+	}
+}
+
+func TestPollContextDone(t *testing.T) {
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		checks := 0
+		err := Poll(ctx, time.Hour, 0, func() (bool, error) {
+			checks++
+			cancel()
+
+			return false, nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Poll() error = %v, want %v", err, context.Canceled)
+		}
+		if checks != 1 {
+			t.Errorf("Poll() checked the condition %d times, want 1", checks)
+		}
+	})
+
+	t.Run("cause is returned", func(t *testing.T) {
+		errCause := errors.New("interrupted")
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(errCause)
+
+		err := Poll(ctx, time.Hour, 0, func() (bool, error) { return false, nil })
+		if !errors.Is(err, errCause) {
+			t.Errorf("Poll() error = %v, want %v", err, errCause)
+		}
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := Poll(ctx, time.Millisecond, 0, func() (bool, error) { return false, nil })
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Poll() error = %v, want %v", err, context.DeadlineExceeded)
+		}
+	})
+}
+
+func TestSleep(t *testing.T) {
+	if err := Sleep(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("Sleep() error = %v, want nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	if err := Sleep(ctx, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("Sleep() error = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Sleep() returned after %s, want right away", elapsed)
+	}
+}
@@ -29,7 +29,7 @@ func NewTableWriter() *Printer {
 		BorderStyle(lipgloss.NormalBorder()).
 		BorderBottom(true).
 		Padding(0, 1).
-		Bold(true)
+		Bold(ColorEnabled())
 
 	styles.Cell = lipgloss.NewStyle().
 		Padding(0, 1)
@@ -84,13 +84,14 @@ func (p *Printer) CloseTableWriter() {
 	cols := p.model.Columns()
 	rows := collapseFirstColumn(p.model.Rows())
 
-	// Width of rows is computed here before rendering
+	// Width of rows is computed here before rendering, using the display width so that
+	// the cells with multi-byte characters like glyphs are not truncated
 	for colIdx := range cols {
-		maxLen := len(cols[colIdx].Title)
+		maxLen := lipgloss.Width(cols[colIdx].Title)
 
 		for _, row := range rows {
 			if colIdx < len(row) {
-				if l := len(row[colIdx]); l > maxLen {
+				if l := lipgloss.Width(row[colIdx]); l > maxLen {
 					maxLen = l
 				}
 			}
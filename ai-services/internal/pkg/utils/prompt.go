@@ -1,14 +1,65 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
 	"github.com/charmbracelet/huh"
+	"golang.org/x/term"
+
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-func ConfirmAction(prompt string) (bool, error) {
-	var confirmed bool
+// ErrNonInteractive is returned by ConfirmAction when stdin is not a terminal, hence the user cannot be asked.
+// The callers are expected to point to their --yes flag.
+var ErrNonInteractive = errors.New("confirmation required but stdin is not a terminal, use --yes to proceed")
+
+// confirmOptions holds the options of ConfirmAction.
+type confirmOptions struct {
+	defaultAnswer bool
+	timeout       time.Duration
+}
+
+// ConfirmOption customizes ConfirmAction.
+type ConfirmOption func(*confirmOptions)
+
+// WithDefaultAnswer preselects the given answer, which is also used if the prompt times out.
+func WithDefaultAnswer(answer bool) ConfirmOption {
+	return func(o *confirmOptions) {
+		o.defaultAnswer = answer
+	}
+}
+
+// WithTimeout answers the prompt with the default answer if the user did not answer within the given duration.
+func WithTimeout(timeout time.Duration) ConfirmOption {
+	return func(o *confirmOptions) {
+		o.timeout = timeout
+	}
+}
+
+// promptInput and promptOutput are the terminal the prompts run on, stdin and stdout if nil. They are variables so
+// that the tests can answer the prompts.
+var (
+	promptInput  io.Reader
+	promptOutput io.Writer
+)
+
+// ConfirmAction asks the user to confirm the action described by the prompt, the answer defaults to no.
+// ErrNonInteractive is returned if stdin is not a terminal, instead of blocking on it.
+func ConfirmAction(prompt string, opts ...ConfirmOption) (bool, error) {
+	options := confirmOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if !stdinIsTerminal() {
+		return false, ErrNonInteractive
+	}
+
+	confirmed := options.defaultAnswer
 
 	form := huh.NewForm(
 		huh.NewGroup(
@@ -17,8 +68,21 @@ func ConfirmAction(prompt string) (bool, error) {
 				Value(&confirmed),
 		),
 	)
+	if options.timeout > 0 {
+		form = form.WithTimeout(options.timeout)
+	}
+	if promptInput != nil {
+		form = form.WithInput(promptInput)
+	}
+	if promptOutput != nil {
+		form = form.WithOutput(promptOutput)
+	}
 
 	err := form.Run()
+	if errors.Is(err, huh.ErrTimeout) {
+		logger.Warningf("No answer within %s, proceeding with the default answer\n", options.timeout)
+		confirmed, err = options.defaultAnswer, nil
+	}
 	if err != nil {
 		return false, fmt.Errorf("failed to run confirmation prompt: %w", err)
 	}
@@ -27,3 +91,30 @@ func ConfirmAction(prompt string) (bool, error) {
 
 	return confirmed, nil
 }
+
+// stdinIsTerminal reports whether the user can be prompted on stdin, a variable so that the tests can prompt on a pipe.
+var stdinIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// SelectOption prompts the user to pick one of the given options and returns the selected value.
+func SelectOption(prompt string, options []string) (string, error) {
+	var selected string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(prompt).
+				Options(huh.NewOptions(options...)...).
+				Value(&selected),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return "", fmt.Errorf("failed to run selection prompt: %w", err)
+	}
+
+	logger.Infoln(fmt.Sprintf("%s %s", prompt, selected))
+
+	return selected, nil
+}
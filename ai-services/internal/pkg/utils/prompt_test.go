@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// answerPrompts answers the prompts with the given input, as if typed on a terminal. A nil input never answers.
+func answerPrompts(t *testing.T, terminal bool, input io.Reader) {
+	t.Helper()
+
+	isTerminal, in, out := stdinIsTerminal, promptInput, promptOutput
+	t.Cleanup(func() { stdinIsTerminal, promptInput, promptOutput = isTerminal, in, out })
+
+	if input == nil {
+		// a pipe which is never written to, so that the prompt waits for its timeout
+		r, w := io.Pipe()
+		t.Cleanup(func() { _ = w.Close() })
+		input = r
+	}
+	stdinIsTerminal = func() bool { return terminal }
+	promptInput, promptOutput = input, io.Discard
+}
+
+func TestConfirmAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		terminal bool
+		input    io.Reader
+		opts     []ConfirmOption
+		want     bool
+		wantErr  error
+	}{
+		{name: "not a terminal", input: strings.NewReader("y"), wantErr: ErrNonInteractive},
+		{name: "yes", terminal: true, input: strings.NewReader("y"), want: true},
+		{name: "no", terminal: true, input: strings.NewReader("n"), opts: []ConfirmOption{WithDefaultAnswer(true)}, want: false},
+		{
+			name: "timeout defaults to no", terminal: true,
+			opts: []ConfirmOption{WithTimeout(50 * time.Millisecond)}, want: false,
+		},
+		{
+			name: "timeout with default answer", terminal: true,
+			opts: []ConfirmOption{WithDefaultAnswer(true), WithTimeout(50 * time.Millisecond)}, want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answerPrompts(t, tt.terminal, tt.input)
+
+			got, err := ConfirmAction("Delete application 'app'?", tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ConfirmAction() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ConfirmAction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
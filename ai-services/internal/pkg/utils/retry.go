@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,39 +12,64 @@ import (
 // BackoffFunc type definition.
 type BackoffFunc func(currentDelay time.Duration) time.Duration
 
+// permanentError marks an error which is not worth retrying.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// PermanentError wraps the given error so that Retry stops and returns it right away.
+func PermanentError(err error) error {
+	return &permanentError{err: err}
+}
+
+// backoffFactor is the factor by which DoubleBackoff grows the delay.
+const backoffFactor = 2
+
+// DoubleBackoff doubles the delay after each attempt.
+func DoubleBackoff(currentDelay time.Duration) time.Duration {
+	return currentDelay * backoffFactor
+}
+
 // Retry -> retries based on the retry attempts and initialDelay time set on failure.
 // Does exponentialBackOff based on the provided BackoffFunc.
 // Set backoff func to nil, if exponentialBackoff is not required.
+// Errors wrapped using PermanentError are returned without any further attempts,
+// and the retries stop as soon as the context is done.
 func Retry(
+	ctx context.Context,
 	attempts int,
 	initialDelay time.Duration,
 	backoff BackoffFunc,
 	fn func() error,
 ) error {
 	delay := initialDelay
-	var err error
 
 	// Run the function initially and if no error do not proceed with retry attempts
-	err = fn()
-	if err == nil {
-		return nil
-	}
-
-	for i := range attempts {
-		logger.Infof("\n[Retry] Attempt %d/%d...\n", i+1, attempts, 0)
+	err := fn()
 
-		if err = fn(); err == nil {
-			return nil
+	for i := 0; err != nil; i++ {
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return permanent.err
 		}
 
 		// At Last attempt — stop
-		if i == attempts-1 {
-			break
+		if i == attempts {
+			return fmt.Errorf("retry failed after %d attempts with err: %w", attempts, err)
 		}
 
 		// Sleep till delay
 		logger.Infof("[Retry] Sleeping %v before retrying...\n", delay, logger.VerbosityLevelDebug)
-		time.Sleep(delay)
+		if sleepErr := Sleep(ctx, delay); sleepErr != nil {
+			return fmt.Errorf("retry cancelled after %d attempts: %w", i+1, errors.Join(sleepErr, err))
+		}
+
+		logger.Infof("\n[Retry] Attempt %d/%d...\n", i+1, attempts, 0)
+		err = fn()
 
 		// Apply backoff if provided
 		if backoff != nil {
@@ -50,5 +77,5 @@ func Retry(
 		}
 	}
 
-	return fmt.Errorf("retry failed after %d attempts with err: %w", attempts, err)
+	return nil
 }
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	errTransient := errors.New("transient")
+	errFatal := errors.New("fatal")
+
+	tests := []struct {
+		name     string
+		attempts int
+		// results are the errors returned by the successive calls, the calls beyond them succeed.
+		results   []error
+		wantCalls int
+		wantErr   error
+		// wantMsg is a substring of the returned error.
+		wantMsg string
+	}{
+		{name: "first call succeeds", attempts: 3, wantCalls: 1},
+		{name: "succeeds on a retry", attempts: 3, results: []error{errTransient, errTransient}, wantCalls: 3},
+		{
+			// attempts counts the retries after the initial call
+			name: "retries exhausted", attempts: 2, results: []error{errTransient, errTransient, errTransient, errTransient},
+			wantCalls: 3, wantErr: errTransient, wantMsg: "retry failed after 2 attempts",
+		},
+		{name: "zero attempts calls once", attempts: 0, results: []error{errTransient}, wantCalls: 1, wantErr: errTransient},
+		{
+			name: "permanent error stops retrying", attempts: 5, results: []error{errTransient, PermanentError(errFatal)},
+			wantCalls: 2, wantErr: errFatal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Retry(context.Background(), tt.attempts, time.Millisecond, nil, func() error {
+				calls++
+				if calls <= len(tt.results) {
+					return tt.results[calls-1]
+				}
+
+				return nil
+			})
+
+			if calls != tt.wantCalls {
+				t.Errorf("Retry() called the function %d times, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Retry() error = %v, want nil", err)
+				}
+
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Retry() error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("Retry() error = %q, want it to contain %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestRetryPermanentErrorUnwrapped(t *testing.T) {
+	errFatal := errors.New("fatal")
+
+	err := Retry(context.Background(), 3, time.Millisecond, nil, func() error { return PermanentError(errFatal) })
+
+	// the permanent marker is stripped, the caller gets the original error
+	if err != errFatal { //nolint:errorlint // the exact error is expected
+		t.Errorf("Retry() error = %v, want the unwrapped %v", err, errFatal)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	var delays []time.Duration
+	backoff := func(d time.Duration) time.Duration {
+		next := DoubleBackoff(d)
+		delays = append(delays, next)
+
+		return next
+	}
+
+	_ = Retry(context.Background(), 3, time.Millisecond, backoff, func() error { return errors.New("transient") })
+
+	want := []time.Duration{2 * time.Millisecond, 4 * time.Millisecond, 8 * time.Millisecond}
+	if len(delays) != len(want) {
+		t.Fatalf("backoff applied %d times, want %d", len(delays), len(want))
+	}
+	for i := range want {
+		if delays[i] != want[i] {
+			t.Errorf("delay %d = %s, want %s", i, delays[i], want[i])
+		}
+	}
+}
+
+func TestRetryContextDone(t *testing.T) {
+	errTransient := errors.New("transient")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := Retry(ctx, 5, time.Hour, nil, func() error {
+		calls++
+		cancel()
+
+		return errTransient
+	})
+
+	if calls != 1 {
+		t.Errorf("Retry() called the function %d times, want 1", calls)
+	}
+	// both the cancellation and the last failure are reported
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, errTransient) {
+		t.Errorf("Retry() error = %v, want it to wrap %v and %v", err, context.Canceled, errTransient)
+	}
+	if !strings.Contains(err.Error(), "retry cancelled after 1 attempts") {
+		t.Errorf("Retry() error = %q, want the number of attempts", err)
+	}
+}
@@ -3,8 +3,8 @@ package utils
 import (
 	"fmt"
 	"maps"
-	"net"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
@@ -20,6 +20,11 @@ func BoolPtr(v bool) *bool {
 	return &v
 }
 
+// StringPtr -> converts to string ptr.
+func StringPtr(v string) *string {
+	return &v
+}
+
 // FlattenArray takes a 2D slice and returns a 1D slice with all values.
 func FlattenArray[T comparable](arr [][]T) []T {
 	flatArr := []T{}
@@ -97,6 +102,35 @@ func ParseKeyValues(pairs []string) (map[string]string, error) {
 	return out, nil
 }
 
+// ExpandEnv replaces the ${VAR} or $VAR references in the given values with the values from the process environment.
+// A literal '$' can be written as '$$'. An error is returned if a referenced variable is not defined.
+func ExpandEnv(values map[string]string) error {
+	var undefined []string
+
+	for key, val := range values {
+		values[key] = os.Expand(val, func(name string) string {
+			if name == "$" {
+				return "$"
+			}
+
+			v, ok := os.LookupEnv(name)
+			if !ok {
+				undefined = append(undefined, name)
+			}
+
+			return v
+		})
+	}
+
+	if len(undefined) > 0 {
+		slices.Sort(undefined)
+
+		return fmt.Errorf("undefined environment variable(s): %s", strings.Join(slices.Compact(undefined), ", "))
+	}
+
+	return nil
+}
+
 func FileExists(path string) bool {
 	_, err := os.Stat(path)
 	if err == nil {
@@ -110,23 +144,6 @@ func FileExists(path string) bool {
 	return false
 }
 
-func GetHostIP() (string, error) {
-	addrs, err := net.InterfaceAddrs()
-	if err != nil {
-		return "", err
-	}
-
-	for _, address := range addrs {
-		if ipnet, ok := address.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
-			if ipnet.IP.To4() != nil {
-				return ipnet.IP.String(), nil
-			}
-		}
-	}
-
-	return "", nil
-}
-
 // Checks if a yaml.Node is marked as hidden via @hidden in the head comment.
 func isHidden(n *yaml.Node) bool {
 	if n == nil {
@@ -300,3 +317,53 @@ func checkParamsInValues(param string, values map[string]any) bool {
 
 	return false
 }
+
+// MergeValues deep merges the override values into values. The override keys may also be dotted paths, Eg: "ui.port".
+// Nested maps are merged key by key so that the sibling values are retained, while any other value is replaced.
+// The keys which are not declared in values are merged as well, as the values files always allowed them,
+// and returned sorted so that the caller can warn about them.
+func MergeValues(values, overrides map[string]any) []string {
+	undeclared := mergeValues(values, overrides, "")
+	slices.Sort(undeclared)
+
+	return undeclared
+}
+
+func mergeValues(values, overrides map[string]any, prefix string) []string {
+	var undeclared []string
+	for key, val := range overrides {
+		if !checkParamsInValues(key, values) {
+			undeclared = append(undeclared, prefix+key)
+			SetNestedValue(values, key, val)
+
+			continue
+		}
+
+		overrideMap, isOverrideMap := val.(map[string]any)
+		valuesMap, isValuesMap := lookupNestedValue(values, key).(map[string]any)
+		// merge into the declared map, unless it is empty and hence free form
+		if isOverrideMap && isValuesMap && len(valuesMap) > 0 {
+			undeclared = append(undeclared, mergeValues(valuesMap, overrideMap, prefix+key+".")...)
+
+			continue
+		}
+
+		SetNestedValue(values, key, val)
+	}
+
+	return undeclared
+}
+
+// lookupNestedValue returns the value at the given dotted path, the path is expected to exist.
+func lookupNestedValue(values map[string]any, dottedKey string) any {
+	var val any = values
+	for _, key := range strings.Split(dottedKey, ".") {
+		current, ok := val.(map[string]any)
+		if !ok {
+			return nil
+		}
+		val = current[key]
+	}
+
+	return val
+}
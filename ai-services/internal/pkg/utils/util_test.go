@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeValues(t *testing.T) {
+	tests := []struct {
+		name           string
+		overrides      map[string]any
+		want           map[string]any
+		wantUndeclared []string
+	}{
+		{
+			name:      "nested values are merged",
+			overrides: map[string]any{"ui": map[string]any{"port": "3000"}},
+			want: map[string]any{
+				"ui":     map[string]any{"port": "3000", "image": "ui:1"},
+				"labels": map[string]any{},
+			},
+		},
+		{
+			name:      "dotted keys",
+			overrides: map[string]any{"ui.image": "ui:2"},
+			want: map[string]any{
+				"ui":     map[string]any{"port": "", "image": "ui:2"},
+				"labels": map[string]any{},
+			},
+		},
+		{
+			name:      "free form maps are replaced",
+			overrides: map[string]any{"labels": map[string]any{"team": "a"}},
+			want: map[string]any{
+				"ui":     map[string]any{"port": "", "image": "ui:1"},
+				"labels": map[string]any{"team": "a"},
+			},
+		},
+		{
+			name:      "undeclared keys are merged and reported",
+			overrides: map[string]any{"ui": map[string]any{"prot": "3000"}, "extra": true},
+			want: map[string]any{
+				"ui":     map[string]any{"port": "", "image": "ui:1", "prot": "3000"},
+				"labels": map[string]any{},
+				"extra":  true,
+			},
+			wantUndeclared: []string{"extra", "ui.prot"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]any{
+				"ui":     map[string]any{"port": "", "image": "ui:1"},
+				"labels": map[string]any{},
+			}
+
+			undeclared := MergeValues(values, tt.overrides)
+			if !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("MergeValues() values = %v, want %v", values, tt.want)
+			}
+			if !reflect.DeepEqual(undeclared, tt.wantUndeclared) {
+				t.Errorf("MergeValues() undeclared = %v, want %v", undeclared, tt.wantUndeclared)
+			}
+		})
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("UI_PORT", "3000")
+	t.Setenv("MODEL_DIR", "/data/models")
+
+	tests := []struct {
+		name    string
+		values  map[string]string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:   "defined",
+			values: map[string]string{"ui.port": "${UI_PORT}", "vllm.dir": "$MODEL_DIR/granite"},
+			want:   map[string]string{"ui.port": "3000", "vllm.dir": "/data/models/granite"},
+		},
+		{
+			name:   "no references",
+			values: map[string]string{"ui.port": "3000"},
+			want:   map[string]string{"ui.port": "3000"},
+		},
+		{
+			name:   "literal dollar",
+			values: map[string]string{"ui.password": "pa$$word"},
+			want:   map[string]string{"ui.password": "pa$word"},
+		},
+		{
+			name:    "undefined",
+			values:  map[string]string{"ui.port": "${UNDEFINED_PORT}", "ui.host": "$UNDEFINED_HOST", "vllm.port": "${UNDEFINED_PORT}"},
+			wantErr: "undefined environment variable(s): UNDEFINED_HOST, UNDEFINED_PORT",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ExpandEnv(tt.values)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("ExpandEnv() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExpandEnv() error = %v", err)
+			}
+			if !reflect.DeepEqual(tt.values, tt.want) {
+				t.Errorf("ExpandEnv() = %v, want %v", tt.values, tt.want)
+			}
+		})
+	}
+}
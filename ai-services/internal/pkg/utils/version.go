@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseVersion parses a dotted version (Eg:- 9.6, v1.2.3) into its numeric components.
+// The leading 'v' and any pre-release/build suffix (Eg:- -rc1, +build) are ignored.
+func ParseVersion(version string) ([]int, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(v, "-+"); i != -1 {
+		v = v[:i]
+	}
+
+	if v == "" {
+		return nil, fmt.Errorf("empty version")
+	}
+
+	parts := strings.Split(v, ".")
+	nums := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version component %q", part)
+		}
+		nums = append(nums, n)
+	}
+
+	return nums, nil
+}
+
+// CompareVersions returns -1, 0 or 1 if a is lower, equal or higher than b. Missing components are treated as 0.
+func CompareVersions(a, b []int) int {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+
+		if x != y {
+			if x < y {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
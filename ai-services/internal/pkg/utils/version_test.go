@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    []int
+		wantErr bool
+	}{
+		{version: "9.6", want: []int{9, 6}},
+		{version: " 10 ", want: []int{10}},
+		{version: "v1.2.3", want: []int{1, 2, 3}},
+		{version: "1.2.3-rc1", want: []int{1, 2, 3}},
+		{version: "v1.2.3+build.5", want: []int{1, 2, 3}},
+		{version: "", wantErr: true},
+		{version: "v", wantErr: true},
+		{version: "dev", wantErr: true},
+		{version: "9.x", wantErr: true},
+		{version: "1..2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := ParseVersion(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want int
+	}{
+		{name: "equal", a: []int{9, 6}, b: []int{9, 6}, want: 0},
+		{name: "missing components are zero", a: []int{9}, b: []int{9, 0, 0}, want: 0},
+		{name: "lower minor", a: []int{9, 4}, b: []int{9, 6}, want: -1},
+		{name: "higher major", a: []int{10, 0}, b: []int{9, 6}, want: 1},
+		{name: "numeric not lexical", a: []int{1, 10}, b: []int{1, 9}, want: 1},
+		{name: "extra patch", a: []int{1, 2, 1}, b: []int{1, 2}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareVersions(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -39,8 +39,7 @@ func (r *OperatorRule) Description() string {
 	return "Validates that all operators are installed or not"
 }
 
-func (r *OperatorRule) Verify() error {
-	ctx := context.Background()
+func (r *OperatorRule) Verify(ctx context.Context) error {
 	var failed []string
 
 	checks := []struct {
@@ -0,0 +1,86 @@
+package spyrenodes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type SpyreNodesRule struct {
+	nodes int
+	cards int64
+}
+
+func NewSpyreNodesRule() *SpyreNodesRule {
+	return &SpyreNodesRule{}
+}
+
+func (r *SpyreNodesRule) Name() string {
+	return "spyre-device-plugin"
+}
+
+func (r *SpyreNodesRule) Description() string {
+	return "Validates that the Spyre device plugin advertises Spyre cards on the cluster nodes"
+}
+
+// Verify lists the cluster nodes and checks that at least one of them exposes allocatable Spyre cards.
+func (r *SpyreNodesRule) Verify(ctx context.Context) error {
+
+	client, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	nodes, cards, err := CountSpyreNodes(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if nodes == 0 {
+		return fmt.Errorf("no node advertises allocatable %s resources", constants.SpyreResourceName)
+	}
+
+	r.nodes, r.cards = nodes, cards
+
+	return nil
+}
+
+func (r *SpyreNodesRule) Message() string {
+	return fmt.Sprintf("Spyre device plugin ready (%d card(s) across %d node(s))", r.cards, r.nodes)
+}
+
+func (r *SpyreNodesRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *SpyreNodesRule) Hint() string {
+	return fmt.Sprintf("Run 'oc describe nodes' and ensure the Spyre device plugin pods in namespace %s are running and nodes list %s under Allocatable.",
+		constants.SpyreOperatorNamespace, constants.SpyreResourceName)
+}
+
+// CountSpyreNodes returns the number of nodes advertising allocatable Spyre cards and the total number of cards.
+func CountSpyreNodes(ctx context.Context, client *openshift.OpenshiftClient) (int, int64, error) {
+	nodeList := &corev1.NodeList{}
+	if err := client.Client.List(ctx, nodeList); err != nil {
+		return 0, 0, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var (
+		nodes int
+		cards int64
+	)
+
+	for _, node := range nodeList.Items {
+		qty, ok := node.Status.Allocatable[corev1.ResourceName(constants.SpyreResourceName)]
+		if !ok || qty.Value() == 0 {
+			continue
+		}
+		nodes++
+		cards += qty.Value()
+	}
+
+	return nodes, cards, nil
+}
@@ -33,8 +33,7 @@ func (r *SpyrePolicyRule) Description() string {
 }
 
 // Verify performs a direct fetch.
-func (r *SpyrePolicyRule) Verify() error {
-	ctx := context.Background()
+func (r *SpyrePolicyRule) Verify(ctx context.Context) error {
 
 	client, err := openshift.NewOpenshiftClient()
 	if err != nil {
@@ -1,6 +1,7 @@
 package numa
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 type NumaRule struct{}
@@ -24,10 +26,10 @@ func (r *NumaRule) Description() string {
 	return "Validates that the NUMA node alignment on LPAR is set to 1 for optimal performance."
 }
 
-func (r *NumaRule) Verify() error {
+func (r *NumaRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating NUMA node alignment on LPAR", logger.VerbosityLevelDebug)
 	cmd := `lscpu | grep -i "NUMA node(s)"`
-	out, err := exec.Command("bash", "-c", cmd).Output()
+	out, err := exec.CommandContext(ctx, "bash", "-c", cmd).Output()
 	if err != nil {
 		return fmt.Errorf("failed to execute lscpu command: %w", err)
 	}
@@ -60,7 +62,6 @@ func (r *NumaRule) Level() constants.ValidationLevel {
 
 func (r *NumaRule) Hint() string {
 	return fmt.Sprintf(`This tools requires numa node alignment set to 1 on LPAR. For optimal performance, ensure that all CPUs are aligned to a single NUMA node.
-For detailed instructions and best practices on NUMA configuration, please refer to %shttps://www.ibm.com/docs/aiservices?topic=installation-chip-alignment-in-lpar%s`,
-		"\033[34m",
-		"\033[0m")
+For detailed instructions and best practices on NUMA configuration, please refer to %s`,
+		utils.Colorize("https://www.ibm.com/docs/aiservices?topic=installation-chip-alignment-in-lpar", "4"))
 }
@@ -1,16 +1,24 @@
 package platform
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
-type PlatformRule struct{}
+// osReleasePath is the file the operating system is detected from, a variable so that the tests can point it elsewhere.
+var osReleasePath = "/etc/os-release"
+
+type PlatformRule struct {
+	// detectedVersion holds the RHEL version found during the last verification.
+	detectedVersion string
+}
 
 func NewPlatformRule() *PlatformRule {
 	return &PlatformRule{}
@@ -21,13 +29,13 @@ func (r *PlatformRule) Name() string {
 }
 
 func (r *PlatformRule) Description() string {
-	return "Validates that the operating system is RHEL version 9.6 or higher."
+	return fmt.Sprintf("Validates that the operating system is RHEL version %s or higher.", vars.MinRHELVersion)
 }
 
-func (r *PlatformRule) Verify() error {
+func (r *PlatformRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating operating system...", logger.VerbosityLevelDebug)
 
-	data, err := os.ReadFile("/etc/os-release")
+	data, err := os.ReadFile(osReleasePath)
 	if err != nil {
 		return err
 	}
@@ -48,16 +56,21 @@ func (r *PlatformRule) Verify() error {
 		return err
 	}
 
-	parts := strings.Split(version, ".")
-	major, _ := strconv.Atoi(parts[0])
-	minor := 0
-	if len(parts) > 1 {
-		minor, _ = strconv.Atoi(parts[1])
+	r.detectedVersion = version
+
+	detected, err := utils.ParseVersion(version)
+	if err != nil {
+		return fmt.Errorf("unable to parse RHEL version %q: %w", version, err)
 	}
 
-	// verify if version is 9.6 or higher
-	if major < 9 || (major == 9 && minor < 6) {
-		return fmt.Errorf("unsupported RHEL version: %s. Minimum required version is 9.6", version)
+	required, err := utils.ParseVersion(vars.MinRHELVersion)
+	if err != nil {
+		return fmt.Errorf("invalid minimum RHEL version %q: %w", vars.MinRHELVersion, err)
+	}
+
+	// verify if version is same as or higher than the minimum required version
+	if utils.CompareVersions(detected, required) < 0 {
+		return fmt.Errorf("unsupported RHEL version: detected %s, minimum required version is %s", version, vars.MinRHELVersion)
 	}
 
 	return nil
@@ -81,7 +94,11 @@ func fetchRhelVersion(osInfo string) (string, error) {
 }
 
 func (r *PlatformRule) Message() string {
-	return "The LPAR is running a supported version of the operating system (RHEL 9.6 or higher)."
+	if r.detectedVersion == "" {
+		return fmt.Sprintf("The LPAR is running a supported version of the operating system (RHEL %s or higher).", vars.MinRHELVersion)
+	}
+
+	return fmt.Sprintf("The LPAR is running a supported version of the operating system (detected RHEL %s, required %s or higher).", r.detectedVersion, vars.MinRHELVersion)
 }
 
 func (r *PlatformRule) Level() constants.ValidationLevel {
@@ -89,5 +106,5 @@ func (r *PlatformRule) Level() constants.ValidationLevel {
 }
 
 func (r *PlatformRule) Hint() string {
-	return "This tool requires RHEL version 9.6, please install or upgrade to a supported platform"
+	return fmt.Sprintf("This tool requires RHEL version %s or higher, please install or upgrade to a supported platform", vars.MinRHELVersion)
 }
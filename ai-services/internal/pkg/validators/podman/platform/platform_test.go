@@ -0,0 +1,63 @@
+package platform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func rhelRelease(version string) string {
+	return "NAME=\"Red Hat Enterprise Linux\"\nVERSION=\"" + version + " (Plow)\"\nID=\"rhel\"\nVERSION_ID=\"" + version + "\"\n"
+}
+
+func TestPlatformRuleVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		osRelease   string
+		minVersion  string
+		wantErr     string
+		wantMessage string
+	}{
+		{name: "minimum version", osRelease: rhelRelease("9.6"), minVersion: "9.6", wantMessage: "detected RHEL 9.6, required 9.6 or higher"},
+		{name: "newer major version", osRelease: rhelRelease("10.0"), minVersion: "9.6", wantMessage: "detected RHEL 10.0"},
+		{name: "newer minor version", osRelease: rhelRelease("9.10"), minVersion: "9.6"},
+		{name: "older version", osRelease: rhelRelease("9.4"), minVersion: "9.6", wantErr: "detected 9.4, minimum required version is 9.6"},
+		{name: "lowered minimum version", osRelease: rhelRelease("9.4"), minVersion: "9.4"},
+		{name: "raised minimum version", osRelease: rhelRelease("9.6"), minVersion: "9.6.1", wantErr: "minimum required version is 9.6.1"},
+		{name: "invalid minimum version", osRelease: rhelRelease("9.6"), minVersion: "nine", wantErr: `invalid minimum RHEL version "nine"`},
+		{name: "unparsable version", osRelease: rhelRelease("9.x"), minVersion: "9.6", wantErr: `unable to parse RHEL version "9.x"`},
+		{name: "missing version", osRelease: "ID=\"rhel\"\n", minVersion: "9.6", wantErr: "unable to determine OS version"},
+		{name: "not RHEL", osRelease: "NAME=\"Fedora Linux\"\nID=fedora\nVERSION_ID=40\n", minVersion: "9.6", wantErr: "only RHEL is supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "os-release")
+			if err := os.WriteFile(path, []byte(tt.osRelease), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			releasePath, minVersion := osReleasePath, vars.MinRHELVersion
+			t.Cleanup(func() { osReleasePath, vars.MinRHELVersion = releasePath, minVersion })
+			osReleasePath, vars.MinRHELVersion = path, tt.minVersion
+
+			rule := NewPlatformRule()
+			err := rule.Verify(context.Background())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %q", err, tt.wantErr)
+			}
+
+			if tt.wantMessage != "" && !strings.Contains(rule.Message(), tt.wantMessage) {
+				t.Errorf("Message() = %q, want %q", rule.Message(), tt.wantMessage)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package power
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"runtime"
@@ -24,7 +25,7 @@ func (r *PowerRule) Description() string {
 	return "Validates that the system is running on IBM Power11 (ppc64le)"
 }
 
-func (r *PowerRule) Verify() error {
+func (r *PowerRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating IBM Power version...", logger.VerbosityLevelDebug)
 
 	if runtime.GOARCH != "ppc64le" {
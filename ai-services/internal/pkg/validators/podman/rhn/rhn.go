@@ -1,6 +1,7 @@
 package rhn
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -23,9 +24,9 @@ func (r *RHNRule) Description() string {
 	return "Validates that the system is registered with Red Hat Network (RHN)."
 }
 
-func (r *RHNRule) Verify() error {
+func (r *RHNRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating RHN registration...", logger.VerbosityLevelDebug)
-	cmd := exec.Command("dnf", "repolist")
+	cmd := exec.CommandContext(ctx, "dnf", "repolist")
 	output, err := cmd.CombinedOutput()
 
 	// Checking the output content first, as dnf may return non-zero exit code
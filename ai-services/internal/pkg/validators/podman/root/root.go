@@ -1,6 +1,7 @@
 package root
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -22,7 +23,7 @@ func (r *RootRule) Description() string {
 	return "Validates that the current user has root privileges."
 }
 
-func (r *RootRule) Verify() error {
+func (r *RootRule) Verify(ctx context.Context) error {
 	euid := os.Geteuid()
 
 	logger.Infoln("Checking root privileges", logger.VerbosityLevelDebug)
@@ -1,9 +1,12 @@
 package servicereport
 
 import (
+	"context"
+
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 type ServiceReportRule struct{}
@@ -20,9 +23,9 @@ func (r *ServiceReportRule) Description() string {
 	return "Validates if the ServiceReport tool has been run on the LPAR."
 }
 
-func (r *ServiceReportRule) Verify() error {
+func (r *ServiceReportRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating if ServiceReport tool has run on LPAR", logger.VerbosityLevelDebug)
-	if err := helpers.RunServiceReportContainer("servicereport -v -p spyre", "validate"); err != nil {
+	if err := helpers.RunServiceReportContainer(ctx, "servicereport -v -p spyre", "validate"); err != nil {
 		return err
 	}
 
@@ -34,6 +37,11 @@ func (r *ServiceReportRule) Message() string {
 }
 
 func (r *ServiceReportRule) Level() constants.ValidationLevel {
+	// the Spyre setup is optional for CPU-only environments
+	if vars.SkipSpyre {
+		return constants.ValidationLevelWarning
+	}
+
 	return constants.ValidationLevelError
 }
 
@@ -1,6 +1,7 @@
 package spyre
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 type SpyreRule struct{}
@@ -24,10 +26,10 @@ func (r *SpyreRule) Description() string {
 	return "Validates that the IBM Spyre Accelerator is attached to the LPAR."
 }
 
-func (r *SpyreRule) Verify() error {
+func (r *SpyreRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating Spyre attachment...", logger.VerbosityLevelDebug)
 	cmd := `lspci -k -d 1014:06a7 | wc -l`
-	out, err := exec.Command("bash", "-c", cmd).Output()
+	out, err := exec.CommandContext(ctx, "bash", "-c", cmd).Output()
 	if err != nil {
 		return fmt.Errorf("❌ failed to execute lspci command %w", err)
 	}
@@ -47,6 +49,11 @@ func (r *SpyreRule) Message() string {
 }
 
 func (r *SpyreRule) Level() constants.ValidationLevel {
+	// the Spyre setup is optional for CPU-only environments
+	if vars.SkipSpyre {
+		return constants.ValidationLevelWarning
+	}
+
 	return constants.ValidationLevelError
 }
 
@@ -0,0 +1,32 @@
+package spyre
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestLevel(t *testing.T) {
+	tests := []struct {
+		name      string
+		skipSpyre bool
+		want      constants.ValidationLevel
+	}{
+		{name: "spyre required", want: constants.ValidationLevelError},
+		// a CPU-only environment only warns about the spyre setup
+		{name: "spyre skipped", skipSpyre: true, want: constants.ValidationLevelWarning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := vars.SkipSpyre
+			vars.SkipSpyre = tt.skipSpyre
+			t.Cleanup(func() { vars.SkipSpyre = orig })
+
+			if got := NewSpyreRule().Level(); got != tt.want {
+				t.Errorf("Level() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,22 +1,13 @@
 package validators
 
 import (
-	"fmt"
-	"slices"
-
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 )
 
+// ValidateAppTemplateExist validates that the application template exists, hidden templates included.
+// Use templates.ResolveTemplate instead when the canonical template name is needed.
 func ValidateAppTemplateExist(tp templates.Template, templateName string) error {
-	// Fetch all the application Template names
-	appTemplateNames, err := tp.ListApplications(true)
-	if err != nil {
-		return fmt.Errorf("failed to list templates: %w", err)
-	}
-
-	if !slices.Contains(appTemplateNames, templateName) {
-		return fmt.Errorf("application template '%s' does not exist", templateName)
-	}
+	_, err := templates.ResolveTemplate(tp, templateName)
 
-	return nil
+	return err
 }
@@ -1,11 +1,13 @@
 package validators
 
 import (
+	"context"
 	"sync"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	kubeconfig "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/kubeconfig"
 	operators "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/operators"
+	spyrenodes "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/spyrenodes"
 	spyrepolicy "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/spyrepolicy"
 	storageclass "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/storageclass"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/numa"
@@ -33,12 +35,14 @@ func init() {
 	OpenshiftRegistry.Register(kubeconfig.NewKubeconfigRule())
 	OpenshiftRegistry.Register(operators.NewOperatorRule())
 	OpenshiftRegistry.Register(spyrepolicy.NewSpyrePolicyRule())
+	OpenshiftRegistry.Register(spyrenodes.NewSpyreNodesRule())
 	OpenshiftRegistry.Register(storageclass.NewStorageClassRule())
 }
 
 // Rule defines the interface for validation rules.
 type Rule interface {
-	Verify() error
+	// Verify runs the check, it is expected to give up once the context is done.
+	Verify(ctx context.Context) error
 	Message() string
 	Name() string
 	Level() constants.ValidationLevel
@@ -0,0 +1,26 @@
+package vars
+
+import (
+	"path/filepath"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+// namespaceSeparator separates the namespace from the application name, same as the pod names from the application name.
+const namespaceSeparator = "--"
+
+// QualifiedAppName returns the application name qualified by the current namespace, i.e. '<namespace>--<app>',
+// or the plain application name in the default namespace. Unlike the application name, it is unique on the host,
+// hence it prefixes the pod names of the application as '<qualified app name>--<pod>'.
+func QualifiedAppName(appName string) string {
+	if Namespace == "" {
+		return appName
+	}
+
+	return Namespace + namespaceSeparator + appName
+}
+
+// ApplicationDir returns the host directory of the application data, named after the qualified application name.
+func ApplicationDir(appName string) string {
+	return filepath.Join(constants.ApplicationsPath, filepath.Base(QualifiedAppName(appName)))
+}
@@ -0,0 +1,30 @@
+package vars
+
+import "testing"
+
+func TestQualifiedAppName(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		wantName  string
+		wantDir   string
+	}{
+		{name: "default namespace", wantName: "rag", wantDir: "/var/lib/ai-services/applications/rag"},
+		{name: "namespace", namespace: "team-a", wantName: "team-a--rag", wantDir: "/var/lib/ai-services/applications/team-a--rag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := Namespace
+			Namespace = tt.namespace
+			t.Cleanup(func() { Namespace = orig })
+
+			if got := QualifiedAppName("rag"); got != tt.wantName {
+				t.Errorf("QualifiedAppName() = %q, want %q", got, tt.wantName)
+			}
+			if got := ApplicationDir("rag"); got != tt.wantDir {
+				t.Errorf("ApplicationDir() = %q, want %q", got, tt.wantDir)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package vars
 
 import (
+	"os"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
@@ -17,6 +19,11 @@ var (
 	SpyreCardAnnotationRegex = regexp.MustCompile(`^ai-services\.io\/([A-Za-z0-9][-A-Za-z0-9_.]*)--spyre-cards$`)
 	ToolImage                = "icr.io/ai-services/tools:0.6"
 	ModelDirectory           = "/var/lib/ai-services/models"
+	// Namespace isolates applications deployed by different users on the same host.
+	// Empty value refers to the default namespace, which preserves the behaviour of un-namespaced deployments.
+	Namespace = ""
+	// CommandTimeout is the overall deadline of the command, zero means no timeout.
+	CommandTimeout time.Duration
 )
 
 type Label string
@@ -30,6 +37,56 @@ var (
 	LparAffinityThreshold = 70
 )
 
+// MinRHELVersion is the minimum RHEL version accepted by the rhel validation rule.
+// It can be overridden via the AI_SERVICES_MIN_RHEL_VERSION env or the --min-rhel-version flag.
+var MinRHELVersion = defaultMinRHELVersion()
+
+const (
+	minRHELVersionEnv     = "AI_SERVICES_MIN_RHEL_VERSION"
+	minRHELVersionDefault = "9.6"
+)
+
+func defaultMinRHELVersion() string {
+	if v := os.Getenv(minRHELVersionEnv); v != "" {
+		return v
+	}
+
+	return minRHELVersionDefault
+}
+
+// SkipSpyre bootstraps the LPAR without the Spyre cards, for CPU-only environments. The Spyre configuration is skipped
+// and the Spyre validation rules only warn. It can be set via the AI_SERVICES_SKIP_SPYRE env or the --skip-spyre flag.
+var SkipSpyre = defaultSkipSpyre()
+
+const skipSpyreEnv = "AI_SERVICES_SKIP_SPYRE"
+
+func defaultSkipSpyre() bool {
+	skip, _ := strconv.ParseBool(os.Getenv(skipSpyreEnv))
+
+	return skip
+}
+
+// AdvertiseIP overrides the detected host IP used in the URLs of the applications, e.g. on hosts with several interfaces.
+// It can be set via the AI_SERVICES_ADVERTISE_IP env or the --advertise-ip flag.
+var AdvertiseIP = os.Getenv(advertiseIPEnv)
+
+const advertiseIPEnv = "AI_SERVICES_ADVERTISE_IP"
+
+// ReloadModulesPolicy controls the reload of the vfio kernel modules by bootstrap configure.
+type ReloadModulesPolicy string
+
+const (
+	// ReloadModulesAuto reloads the modules only if fewer spyre cards are bound to vfio-pci than attached.
+	ReloadModulesAuto ReloadModulesPolicy = "auto"
+	// ReloadModulesAlways reloads the modules unconditionally, as set by --reload-modules.
+	ReloadModulesAlways ReloadModulesPolicy = "always"
+	// ReloadModulesNever never reloads the modules, as set by --no-reload-modules.
+	ReloadModulesNever ReloadModulesPolicy = "never"
+)
+
+// ReloadModules is the policy of the vfio kernel module reload of bootstrap configure.
+var ReloadModules = ReloadModulesAuto
+
 var (
 	RetryCount    = 3
 	RetryInterval = 5 * time.Second
@@ -0,0 +1,48 @@
+package vars
+
+import "testing"
+
+func TestDefaultMinRHELVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		want string
+	}{
+		{name: "default", want: "9.6"},
+		{name: "env override", env: "10.1", want: "10.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(minRHELVersionEnv, tt.env)
+
+			if got := defaultMinRHELVersion(); got != tt.want {
+				t.Errorf("defaultMinRHELVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultSkipSpyre(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		want bool
+	}{
+		{name: "default", want: false},
+		{name: "enabled", env: "true", want: true},
+		{name: "enabled numeric", env: "1", want: true},
+		{name: "disabled", env: "false", want: false},
+		{name: "invalid", env: "yes", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(skipSpyreEnv, tt.env)
+
+			if got := defaultSkipSpyre(); got != tt.want {
+				t.Errorf("defaultSkipSpyre() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,9 @@ import (
 
 const execPerm = 0o755
 
+// envModuleRoot points the build at the ai-services module root explicitly, instead of discovering it from the current directory.
+const envModuleRoot = "AI_SERVICES_ROOT"
+
 var testBinDir string
 
 // SetTestBinDir sets the temporary directory for test binaries.
@@ -119,14 +122,9 @@ func buildAndVerifyBinary(ctx context.Context) (string, error) {
 
 // buildBinary tries make build first, then go build.
 func buildBinary(ctx context.Context, tempBinDir string) (string, error) {
-	cwd, err := os.Getwd()
+	moduleRoot, err := resolveModuleRoot()
 	if err != nil {
-		return "", fmt.Errorf("failed to get current directory: %w", err)
-	}
-
-	moduleRoot := findAIServicesRoot(cwd)
-	if moduleRoot == "" {
-		return "", fmt.Errorf("could not find ai-services module root from %s", cwd)
+		return "", err
 	}
 
 	makefilePath := filepath.Join(moduleRoot, "Makefile")
@@ -245,6 +243,29 @@ func CheckBinaryVersion(binPath string) (string, error) {
 	return "", fmt.Errorf("binary version check failed")
 }
 
+// resolveModuleRoot returns the module root set via AI_SERVICES_ROOT env, else the one found from the current directory.
+func resolveModuleRoot() (string, error) {
+	if root := strings.TrimSpace(os.Getenv(envModuleRoot)); root != "" {
+		if _, err := os.Stat(filepath.Join(root, "go.mod")); err != nil {
+			return "", fmt.Errorf("%s=%s is not the ai-services module root: %w", envModuleRoot, root, err)
+		}
+
+		return root, nil
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	moduleRoot := findAIServicesRoot(cwd)
+	if moduleRoot == "" {
+		return "", fmt.Errorf("could not find ai-services module root from %s, set %s env to point to it", cwd, envModuleRoot)
+	}
+
+	return moduleRoot, nil
+}
+
 // findAIServicesRoot locates module root via go.mod.
 func findAIServicesRoot(startPath string) string {
 	for d := startPath; d != "/" && d != ""; d = filepath.Dir(d) {
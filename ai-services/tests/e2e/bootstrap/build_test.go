@@ -0,0 +1,63 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestResolveModuleRoot asserts that the module root is taken from AI_SERVICES_ROOT env when set, and otherwise
+// found by walking up from the current directory.
+func TestResolveModuleRoot(t *testing.T) {
+	base, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	moduleRoot := filepath.Join(base, "ai-services")
+	nested := filepath.Join(moduleRoot, "tests", "e2e")
+	outside := filepath.Join(base, "outside")
+	for _, dir := range []string{nested, outside} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(moduleRoot, "go.mod"), []byte("module github.com/project-ai-services/ai-services\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		env     string
+		cwd     string
+		want    string
+		wantErr string
+	}{
+		{name: "env set", env: moduleRoot, cwd: outside, want: moduleRoot},
+		{name: "env set to a directory without go.mod", env: outside, cwd: nested, wantErr: "AI_SERVICES_ROOT=" + outside + " is not the ai-services module root"},
+		{name: "env unset within the module", cwd: nested, want: moduleRoot},
+		{name: "env unset outside the module", cwd: outside, wantErr: "set AI_SERVICES_ROOT env to point to it"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envModuleRoot, tt.env)
+			t.Chdir(tt.cwd)
+
+			got, err := resolveModuleRoot()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("resolveModuleRoot() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveModuleRoot() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveModuleRoot() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/errdefs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
@@ -140,7 +141,7 @@ func ValidateApplicationPS(output string) error {
 }
 
 func isNoPods(output string) bool {
-	return strings.Contains(output, "No Pods found")
+	return strings.Contains(output, errdefs.NoPodsFoundMessage)
 }
 
 func isMinimalPSFormat(output string) bool {
@@ -268,7 +269,7 @@ func ValidateNoPodsAfterDelete(psOutput string) error {
 		if line == "" ||
 			strings.HasPrefix(line, "APPLICATION") ||
 			strings.HasPrefix(line, "──") ||
-			strings.HasPrefix(line, "No Pods found") {
+			strings.HasPrefix(line, errdefs.NoPodsFoundMessage) {
 			continue
 		}
 
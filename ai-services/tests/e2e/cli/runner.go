@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/tests/e2e/bootstrap"
 	"github.com/project-ai-services/ai-services/tests/e2e/common"
 	"github.com/project-ai-services/ai-services/tests/e2e/config"
@@ -173,31 +174,30 @@ func waitForEndpointOK(
 	maxRetries int,
 	waitTime time.Duration,
 ) error {
-	var lastErr error
-	for i := 1; i <= maxRetries; i++ {
+	err := utils.Retry(context.Background(), maxRetries-1, waitTime, nil, func() error {
 		resp, err := client.Get(endpoint)
-		if err == nil && resp.StatusCode == http.StatusOK {
-			if cerr := resp.Body.Close(); cerr != nil {
-				logger.Warningf("[WARNING] failed to close response body for %s: %v", endpoint, cerr)
-			}
-			logger.Infof("[RAG] GET %s -> 200 OK", endpoint)
+		if err != nil {
+			logger.Infof("[RAG] Waiting for %s: %v", endpoint, err)
 
-			return nil
+			return err
+		}
+		if cerr := resp.Body.Close(); cerr != nil {
+			logger.Warningf("[WARNING] failed to close response body for %s: %v", endpoint, cerr)
 		}
-		if resp != nil {
-			if cerr := resp.Body.Close(); cerr != nil {
-				logger.Warningf("[WARNING] failed to close response body for %s: %v", endpoint, cerr)
-			}
+		if resp.StatusCode != http.StatusOK {
+			logger.Infof("[RAG] Waiting for %s: got %s", endpoint, resp.Status)
+
+			return fmt.Errorf("unexpected status: %s", resp.Status)
 		}
-		lastErr = err
-		logger.Infof(
-			"[RAG] Waiting for %s (attempt %d/%d)",
-			endpoint, i, maxRetries,
-		)
-		time.Sleep(waitTime)
+		logger.Infof("[RAG] GET %s -> 200 OK", endpoint)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("endpoint %s failed after retries: %w", endpoint, err)
 	}
 
-	return fmt.Errorf("endpoint %s failed after retries: %w", endpoint, lastErr)
+	return nil
 }
 
 // extractHostIP extracts the host IP from the CLI output using regex.
@@ -1,31 +1,21 @@
 package common
 
 import (
+	"context"
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // Retry runs a function multiple times with delay.
 func Retry(attempts int, delay time.Duration, fn func() error) error {
-	var lastErr error
-
-	for i := range attempts {
-		if err := fn(); err != nil {
-			lastErr = err
-			logger.Warningf(
-				"Retry attempt %d/%d failed: %v",
-				i+1,
-				attempts,
-				err,
-			)
-			time.Sleep(delay)
-
-			continue
+	return utils.Retry(context.Background(), attempts-1, delay, nil, func() error {
+		err := fn()
+		if err != nil {
+			logger.Warningf("Retry attempt failed: %v", err)
 		}
 
-		return nil
-	}
-
-	return lastErr
+		return err
+	})
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/tests/e2e/config"
 )
 
@@ -30,32 +31,22 @@ func WaitForAllPodsHealthy(
 		"--chat-bot",
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, corePodsTimeout)
-	defer cancel()
-
-	ticker := time.NewTicker(waitTickerInterval)
-	defer ticker.Stop()
-
 	logger.Infof("[WAIT] Waiting for core pods to be Running and Healthy")
 
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-
-		case <-ticker.C:
-			output, err := getAppStatusOutput(ctx, cfg, appName)
-			if err != nil {
-				continue
-			}
+	return utils.Poll(ctx, waitTickerInterval, corePodsTimeout, func() (bool, error) {
+		output, err := getAppStatusOutput(ctx, cfg, appName)
+		if err != nil {
+			return false, nil
+		}
 
-			if areRequiredPodsHealthy(output, appName, requiredPods) {
-				logger.Infof("[WAIT] All core pods are healthy")
+		if areRequiredPodsHealthy(output, appName, requiredPods) {
+			logger.Infof("[WAIT] All core pods are healthy")
 
-				return nil
-			}
+			return true, nil
 		}
-	}
+
+		return false, nil
+	})
 }
 
 // getAppStatusOutput fetches application pod status output.
@@ -122,39 +113,34 @@ func WaitForIngestionLogs(
 	ctx, cancel := context.WithTimeout(ctx, ingestionTimeout)
 	defer cancel()
 
-	ticker := time.NewTicker(waitTickerInterval)
-	defer ticker.Stop()
-
 	logger.Infof("[WAIT] Waiting for ingestion completion logs")
 
-	for {
-		select {
-		case <-ctx.Done():
-			return "", ctx.Err()
-
-		case <-ticker.C:
-			cmd := exec.CommandContext(
-				ctx,
-				cfg.AIServiceBin,
-				"application",
-				"logs",
-				appName,
-				"--pod",
-				podName,
-			)
-
-			out, err := cmd.CombinedOutput()
-			if err != nil {
-				continue
-			}
-
-			logs := string(out)
+	var logs string
+	err := utils.Poll(ctx, waitTickerInterval, 0, func() (bool, error) {
+		cmd := exec.CommandContext(
+			ctx,
+			cfg.AIServiceBin,
+			"application",
+			"logs",
+			appName,
+			"--pod",
+			podName,
+		)
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return false, nil
+		}
 
-			if strings.Contains(logs, "Ingestion completed successfully") {
-				logger.Infof("[WAIT] Ingestion completed successfully")
+		logs = string(out)
 
-				return logs, nil
-			}
-		}
+		return strings.Contains(logs, "Ingestion completed successfully"), nil
+	})
+	if err != nil {
+		return "", err
 	}
+
+	logger.Infof("[WAIT] Ingestion completed successfully")
+
+	return logs, nil
 }
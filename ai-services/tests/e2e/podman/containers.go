@@ -1,6 +1,7 @@
 package podman
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	ginkgo "github.com/onsi/ginkgo/v2"
 	gomega "github.com/onsi/gomega"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/tests/e2e/common"
 )
 
@@ -44,6 +46,7 @@ var (
 			`[a-f0-9]{12}\s+` + // POD ID
 			`(?P<pod>\S+)\s{2,}` + // POD NAME
 			`(?P<status>Running\s+\((?:healthy|unhealthy)\)|Created)\s{2,}` +
+			`(?P<restarts>\d+)\s{2,}` +
 			`(?P<created>\d+\s+\w+\s+ago)\s{2,}` +
 			`(?P<exposed>none|\d+(?:,\s*\d+)*)\s+`,
 	)
@@ -128,21 +131,7 @@ func waitUntil(
 	interval time.Duration,
 	condition func() (bool, error),
 ) error {
-	deadline := time.Now().Add(timeout)
-
-	for {
-		done, err := condition()
-		if err != nil {
-			return err
-		}
-		if done {
-			return nil
-		}
-		if time.Now().After(deadline) {
-			return fmt.Errorf("timeout after %s", timeout)
-		}
-		time.Sleep(interval)
-	}
+	return utils.Poll(context.Background(), interval, timeout, condition)
 }
 
 func waitForPodRunningNoCrash(appName, podName string) error {
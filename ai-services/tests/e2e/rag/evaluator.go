@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 const (
@@ -55,31 +56,29 @@ func RunWithRetry(
 	maxRetries int,
 	fn func(context.Context) (string, error),
 ) (string, error) {
-	var lastErr error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		resp, err := fn(ctx)
-		if err == nil {
-			return resp, nil
-		}
-
-		lastErr = err
-
-		if ctx.Err() != nil {
-			return "", ctx.Err()
-		}
+	var resp string
 
+	err := utils.Retry(ctx, maxRetries, retryDelayStep, linearBackoff, func() error {
+		var err error
+		resp, err = fn(ctx)
 		if errors.Is(err, ErrNonRetriable) {
-			return "", err
+			return utils.PermanentError(err)
 		}
 
-		// wait before the next attempt
-		if attempt < maxRetries {
-			time.Sleep(time.Duration(attempt+1) * 200 * time.Millisecond)
-		}
+		return err
+	})
+	if err != nil {
+		return "", err
 	}
 
-	return "", lastErr
+	return resp, nil
+}
+
+// retryDelayStep is the delay before the first retry, growing by the same step before each following one.
+const retryDelayStep = 200 * time.Millisecond
+
+func linearBackoff(currentDelay time.Duration) time.Duration {
+	return currentDelay + retryDelayStep
 }
 
 
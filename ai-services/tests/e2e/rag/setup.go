@@ -124,7 +124,7 @@ func SetupLLMAsJudge(ctx context.Context, cfg *config.Config, runID string) (err
 	logger.Infof("RH Registry login completed")
 
 	// download the model using ai services helper
-	modelErr := helpers.DownloadModel(Model, ModelPath)
+	modelErr := helpers.DownloadModel(ctx, Model, ModelPath)
 
 	if modelErr != nil {
 		logger.Errorf("error downloading LLM as Judge model %v", modelErr)